@@ -1,23 +1,26 @@
 package main
 
 import (
-	"database/sql"
+	"context"
 	"fmt"
 	"log"
 	"net/http"
+	"os"
 	"time"
 
 	"github.com/sesaquecruz/go-auth-api/config"
 	"github.com/sesaquecruz/go-auth-api/internal/entity"
+	"github.com/sesaquecruz/go-auth-api/internal/infra/database"
 	"github.com/sesaquecruz/go-auth-api/internal/infra/database/repository"
+	"github.com/sesaquecruz/go-auth-api/internal/infra/mail"
+	"github.com/sesaquecruz/go-auth-api/internal/infra/signing"
 	"github.com/sesaquecruz/go-auth-api/internal/infra/web/handler"
+	authmiddleware "github.com/sesaquecruz/go-auth-api/internal/infra/web/middleware"
 	"github.com/sesaquecruz/go-auth-api/internal/usecase"
 
 	"github.com/go-chi/chi/middleware"
 	"github.com/go-chi/chi/v5"
-	"github.com/go-chi/jwtauth"
-
-	_ "github.com/go-sql-driver/mysql"
+	"github.com/lestrrat-go/jwx/jwt"
 
 	_ "github.com/sesaquecruz/go-auth-api/docs"
 	httpSwagger "github.com/swaggo/http-swagger"
@@ -42,59 +45,211 @@ const port = "8080"
 // @in             	header
 // @name           	Authorization
 func main() {
+	if len(os.Args) > 1 {
+		runCommand(os.Args[1], os.Args[2:])
+		return
+	}
+
 	cfg, err := config.LoadConfig()
 	if err != nil {
 		panic(err)
 	}
 
-	db, err := sql.Open(
-		cfg.DBDriver,
-		fmt.Sprintf("%s:%s@tcp(%s:%s)/%s", cfg.DBUser, cfg.DBPassword, cfg.DBHost, cfg.DBPort, cfg.DBName),
-	)
+	db, err := database.NewConnection(cfg)
+	if err != nil {
+		panic(err)
+	}
+
+	if cfg.AutoMigrate {
+		err = database.MigrateUp(db, cfg)
+		if err != nil {
+			panic(err)
+		}
+	}
+
+	dialect, err := repository.DialectFor(cfg.DBDriver)
+	if err != nil {
+		panic(err)
+	}
+
+	var privateKeyPEM []byte
+	if cfg.JWTPrivateKeyFile != "" {
+		privateKeyPEM, err = os.ReadFile(cfg.JWTPrivateKeyFile)
+		if err != nil {
+			panic(err)
+		}
+	}
+
+	jwtAuth, jwtKeys, err := signing.NewJWTAuth([]byte(cfg.JWTSecret), privateKeyPEM)
 	if err != nil {
 		panic(err)
 	}
 
-	jwtAuth := jwtauth.New("HS256", []byte(cfg.JWTSecret), nil)
+	tokenValidation := make([]jwt.ValidateOption, 0)
+	if cfg.JWTIssuer != "" {
+		tokenValidation = append(tokenValidation, jwt.WithClaimValue(jwt.IssuerKey, cfg.JWTIssuer))
+	}
+	if cfg.JWTAudience != "" {
+		tokenValidation = append(tokenValidation, jwt.WithAudience(cfg.JWTAudience))
+	}
+
 	jwtExpiration := time.Duration(cfg.JWTExpSeconds) * time.Second
+	deletionGracePeriod := time.Duration(cfg.DeletionGraceSeconds) * time.Second
+	invitationExpiration := time.Duration(cfg.InvitationExpSeconds) * time.Second
+	invitationSigner := signing.NewInvitationSigner([]byte(cfg.JWTSecret))
+	apiKeyMaxExpiration := time.Duration(cfg.APIKeyMaxExpSeconds) * time.Second
+	reauthMaxAge := time.Duration(cfg.ReauthMaxAgeSeconds) * time.Second
+
+	sameSite, err := handler.ParseSameSite(cfg.CookieSameSite)
+	if err != nil {
+		panic(err)
+	}
+	cookies := handler.CookieOptions{Secure: cfg.CookieSecure, SameSite: sameSite}
+
+	forwardAuthRules, err := authmiddleware.ParseForwardAuthRules(cfg.ForwardAuthRules)
+	if err != nil {
+		panic(err)
+	}
+
+	var mailer entity.MailerInterface
+	if cfg.SMTPHost != "" {
+		mailer = mail.NewSMTPMailer(cfg.SMTPHost, cfg.SMTPPort, cfg.SMTPUser, cfg.SMTPPassword, cfg.SMTPFrom)
+	} else {
+		mailer = mail.NewLogMailer(log.Default())
+	}
 
 	userFactory := entity.NewUserFactory()
-	userRepository := repository.NewUserRepository(db)
+	userRepository := repository.NewUserRepository(db, dialect)
+	roleRepository := repository.NewRoleRepository(db, dialect)
+	tenantRepository := repository.NewTenantRepository(db, dialect)
+	organizationRepository := repository.NewOrganizationRepository(db, dialect)
+	invitationRepository := repository.NewInvitationRepository(db, dialect)
+	apiKeyRepository := repository.NewAPIKeyRepository(db, dialect)
+	sessionRepository := repository.NewSessionRepository(db, dialect)
+	transactionManager := repository.NewTransactionManager(db)
 
-	createUserUseCase := usecase.NewCreateUserUseCase(userFactory, userRepository)
-	authUserUseCase := usecase.NewAuthUserUseCase(userFactory, userRepository)
-	updateUserUseCase := usecase.NewUpdateUserUseCase(userFactory, userRepository)
-	deleteUserUseCase := usecase.NewDeleteUserUseCase(userRepository)
+	createUserUseCase := usecase.NewCreateUserUseCase(userFactory, userRepository, mailer, cfg.RegistrationConcealExisting)
+	authUserUseCase := usecase.NewAuthUserUseCase(userFactory, userRepository, roleRepository, sessionRepository)
+	reauthUserUseCase := usecase.NewReauthUserUseCase(userRepository)
+	updateUserUseCase := usecase.NewUpdateUserUseCase(userFactory, userRepository, sessionRepository, transactionManager)
+	deleteUserUseCase := usecase.NewDeleteUserUseCase(userRepository, transactionManager)
 	findUserUseCase := usecase.NewFindUserUseCase(userRepository)
+	restoreUserUseCase := usecase.NewRestoreUserUseCase(userFactory, userRepository, deletionGracePeriod)
+	purgeUsersUseCase := usecase.NewPurgeUsersUseCase(userRepository, deletionGracePeriod)
+	adminCreateUserUseCase := usecase.NewCreateUserUseCase(userFactory, userRepository, mailer, false)
+	listUsersUseCase := usecase.NewListUsersUseCase(userRepository)
+	setUserStatusUseCase := usecase.NewSetUserStatusUseCase(userRepository)
+	resetUserPasswordUseCase := usecase.NewResetUserPasswordUseCase(userFactory, userRepository, sessionRepository, mailer, transactionManager)
+	createOrganizationUseCase := usecase.NewCreateOrganizationUseCase(organizationRepository, transactionManager)
+	inviteMemberUseCase := usecase.NewInviteMemberUseCase(
+		userRepository,
+		organizationRepository,
+		invitationRepository,
+		invitationSigner,
+		mailer,
+		transactionManager,
+		invitationExpiration,
+		cfg.InvitationURL,
+	)
+	respondInvitationUseCase := usecase.NewRespondInvitationUseCase(userRepository, organizationRepository, invitationRepository, invitationSigner, transactionManager)
+	listMembersUseCase := usecase.NewListMembersUseCase(organizationRepository)
+	setMemberRoleUseCase := usecase.NewSetMemberRoleUseCase(organizationRepository, transactionManager)
+	removeMemberUseCase := usecase.NewRemoveMemberUseCase(organizationRepository, transactionManager)
+	switchOrganizationUseCase := usecase.NewSwitchOrganizationUseCase(organizationRepository)
+	createAPIKeyUseCase := usecase.NewCreateAPIKeyUseCase(roleRepository, apiKeyRepository, apiKeyMaxExpiration)
+	listAPIKeysUseCase := usecase.NewListAPIKeysUseCase(apiKeyRepository)
+	revokeAPIKeyUseCase := usecase.NewRevokeAPIKeyUseCase(apiKeyRepository)
+	authAPIKeyUseCase := usecase.NewAuthAPIKeyUseCase(userRepository, roleRepository, apiKeyRepository)
+	listSessionsUseCase := usecase.NewListSessionsUseCase(sessionRepository)
+	revokeSessionUseCase := usecase.NewRevokeSessionUseCase(sessionRepository)
 
 	userHandler := handler.NewUserHandler(
 		jwtAuth,
 		jwtExpiration,
+		cfg.JWTIssuer,
+		cfg.JWTAudience,
+		cfg.RegistrationConcealExisting,
+		cookies,
 		createUserUseCase,
 		authUserUseCase,
+		reauthUserUseCase,
 		updateUserUseCase,
 		deleteUserUseCase,
 		findUserUseCase,
+		restoreUserUseCase,
 	)
 
+	adminHandler := handler.NewAdminHandler(
+		adminCreateUserUseCase,
+		listUsersUseCase,
+		findUserUseCase,
+		setUserStatusUseCase,
+		resetUserPasswordUseCase,
+		deleteUserUseCase,
+	)
+
+	organizationHandler := handler.NewOrganizationHandler(
+		jwtAuth,
+		cookies,
+		createOrganizationUseCase,
+		inviteMemberUseCase,
+		respondInvitationUseCase,
+		listMembersUseCase,
+		setMemberRoleUseCase,
+		removeMemberUseCase,
+		switchOrganizationUseCase,
+	)
+
+	apiKeyHandler := handler.NewAPIKeyHandler(createAPIKeyUseCase, listAPIKeysUseCase, revokeAPIKeyUseCase)
+	forwardAuthHandler := handler.NewForwardAuthHandler(findUserUseCase)
+	jwksHandler := handler.NewJWKSHandler(jwtKeys)
+	sessionHandler := handler.NewSessionHandler(cookies, listSessionsUseCase, revokeSessionUseCase)
+
+	go runPurge(context.Background(), purgeUsersUseCase, time.Duration(cfg.PurgeIntervalSeconds)*time.Second)
+
 	r := chi.NewRouter()
+	if cfg.TrustProxyHeaders {
+		r.Use(middleware.RealIP)
+	}
 	r.Use(middleware.Logger)
+	r.Use(authmiddleware.ResolveTenant(tenantRepository))
 
 	authMiddlewares := chi.Chain(
-		jwtauth.Verifier(jwtAuth),
-		jwtauth.Authenticator,
+		authmiddleware.Verifier(jwtAuth, tokenValidation...),
+		authmiddleware.VerifyAPIKey(jwtAuth, authAPIKeyUseCase),
+		authmiddleware.Authenticator,
+		authmiddleware.RequireCSRF,
+		authmiddleware.RequireTokenTenant,
+		authmiddleware.RequireActiveSession(sessionRepository),
+		authmiddleware.RequireActiveUser(userRepository),
 	)
 
-	r.Route(basePath+"/login", func(r chi.Router) {
-		r.Post("/", userHandler.AuthUser)
-	})
+	adminMiddlewares := chi.Chain(
+		authmiddleware.Verifier(jwtAuth, tokenValidation...),
+		authmiddleware.VerifyAPIKey(jwtAuth, authAPIKeyUseCase),
+		authmiddleware.Authenticator,
+		authmiddleware.RequireCSRF,
+		authmiddleware.RequireTokenTenant,
+		authmiddleware.RequireActiveSession(sessionRepository),
+		authmiddleware.RequireActiveUser(userRepository),
+		authmiddleware.RequirePermission(entity.PermissionUsersAdmin),
+	)
 
-	r.Route(basePath+"/users", func(r chi.Router) {
-		r.Post("/", userHandler.CreateUser)
-		r.With(authMiddlewares...).Get("/", userHandler.FindUser)
-		r.With(authMiddlewares...).Put("/", userHandler.UpdateUser)
-		r.With(authMiddlewares...).Delete("/", userHandler.DeleteUser)
-	})
+	routes{
+		authMiddlewares:  authMiddlewares,
+		adminMiddlewares: adminMiddlewares,
+		forwardAuthRules: forwardAuthRules,
+		reauthMaxAge:     reauthMaxAge,
+		// Tokens signed with the shared secret have no public keys to publish.
+		serveJWKS:    jwtKeys.Len() > 0,
+		user:         userHandler,
+		admin:        adminHandler,
+		organization: organizationHandler,
+		apiKey:       apiKeyHandler,
+		forwardAuth:  forwardAuthHandler,
+		jwks:         jwksHandler,
+		session:      sessionHandler,
+	}.mount(r)
 
 	r.Get(
 		basePath+"/docs/*",
@@ -104,3 +259,30 @@ func main() {
 	log.Printf("server is running on port %s...\n", port)
 	http.ListenAndServe(fmt.Sprintf(":%s", port), r)
 }
+
+// runCommand runs the subcommands, which only work on the database and so only need its settings.
+func runCommand(name string, args []string) {
+	cfg, err := config.LoadDBConfig()
+	if err != nil {
+		log.Fatal(err)
+	}
+
+	db, err := database.NewConnection(cfg)
+	if err != nil {
+		log.Fatal(err)
+	}
+
+	switch name {
+	case "migrate":
+		err = runMigrate(db, cfg, args)
+	case "role":
+		err = runRole(db, cfg, args)
+	case "tenant":
+		err = runTenant(db, cfg, args)
+	default:
+		err = fmt.Errorf("unknown command %s\n%s\n%s\n%s", name, migrateUsage, roleUsage, tenantUsage)
+	}
+	if err != nil {
+		log.Fatal(err)
+	}
+}
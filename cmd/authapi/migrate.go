@@ -0,0 +1,69 @@
+package main
+
+import (
+	"database/sql"
+	"errors"
+	"fmt"
+	"log"
+	"strconv"
+
+	"github.com/sesaquecruz/go-auth-api/config"
+	"github.com/sesaquecruz/go-auth-api/internal/infra/database"
+
+	"github.com/golang-migrate/migrate/v4"
+)
+
+const migrateUsage = "usage: authapi migrate up|down [N]|version|force VERSION"
+
+func runMigrate(db *sql.DB, cfg *config.Config, args []string) error {
+	if len(args) == 0 {
+		return errors.New(migrateUsage)
+	}
+
+	m, err := database.NewMigrate(db, cfg)
+	if err != nil {
+		return err
+	}
+
+	switch args[0] {
+	case "up":
+		err = m.Up()
+	case "down":
+		steps := 1
+		if len(args) > 1 {
+			steps, err = strconv.Atoi(args[1])
+			if err != nil || steps < 1 {
+				return fmt.Errorf("invalid number of steps %s", args[1])
+			}
+		}
+		err = m.Steps(-steps)
+	case "version":
+		version, dirty, verr := m.Version()
+		if verr == migrate.ErrNilVersion {
+			log.Println("no migration applied")
+			return nil
+		}
+		if verr != nil {
+			return verr
+		}
+		log.Printf("version %d (dirty: %t)\n", version, dirty)
+	case "force":
+		if len(args) < 2 {
+			return errors.New(migrateUsage)
+		}
+		version, cerr := strconv.Atoi(args[1])
+		if cerr != nil {
+			return fmt.Errorf("invalid version %s", args[1])
+		}
+		err = m.Force(version)
+	default:
+		return errors.New(migrateUsage)
+	}
+
+	if err == migrate.ErrNoChange {
+		log.Println("no change")
+		return nil
+	}
+
+	return err
+}
@@ -0,0 +1,30 @@
+package main
+
+import (
+	"context"
+	"log"
+	"time"
+
+	"github.com/sesaquecruz/go-auth-api/internal/usecase"
+)
+
+// runPurge removes the users whose deletion grace period has passed, once at startup and then every interval.
+func runPurge(ctx context.Context, purgeUsersUseCase usecase.PurgeUsersUseCaseInterface, interval time.Duration) {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for {
+		output, err := purgeUsersUseCase.Execute(ctx)
+		if err != nil {
+			log.Printf("purge deleted users: %v", err)
+		} else if output.Purged > 0 {
+			log.Printf("purged %d deleted users", output.Purged)
+		}
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
@@ -0,0 +1,64 @@
+package main
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"log"
+
+	"github.com/sesaquecruz/go-auth-api/config"
+	"github.com/sesaquecruz/go-auth-api/internal/entity"
+	"github.com/sesaquecruz/go-auth-api/internal/infra/database/repository"
+)
+
+const roleUsage = "usage: authapi role assign|revoke EMAIL ROLE [TENANT]"
+
+func runRole(db *sql.DB, cfg *config.Config, args []string) error {
+	if len(args) != 3 && len(args) != 4 {
+		return errors.New(roleUsage)
+	}
+
+	dialect, err := repository.DialectFor(cfg.DBDriver)
+	if err != nil {
+		return err
+	}
+
+	ctx := context.Background()
+	if len(args) == 4 {
+		tenant, err := repository.NewTenantRepository(db, dialect).FindBySlug(ctx, args[3])
+		if err == sql.ErrNoRows {
+			return fmt.Errorf("tenant %s not found", args[3])
+		}
+		if err != nil {
+			return err
+		}
+		ctx = entity.WithTenant(ctx, tenant.ID)
+	}
+
+	userRepository := repository.NewUserRepository(db, dialect)
+	roleRepository := repository.NewRoleRepository(db, dialect)
+
+	user, err := userRepository.FindByEmail(ctx, args[1])
+	if err == sql.ErrNoRows {
+		return fmt.Errorf("user %s not found", args[1])
+	}
+	if err != nil {
+		return err
+	}
+
+	switch args[0] {
+	case "assign":
+		err = roleRepository.AssignToUser(ctx, user.ID, args[2])
+	case "revoke":
+		err = roleRepository.RevokeFromUser(ctx, user.ID, args[2])
+	default:
+		return errors.New(roleUsage)
+	}
+	if err != nil {
+		return err
+	}
+
+	log.Printf("%s %s role %s\n", args[0], user.Email, args[2])
+	return nil
+}
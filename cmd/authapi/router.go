@@ -0,0 +1,114 @@
+package main
+
+import (
+	"time"
+
+	"github.com/sesaquecruz/go-auth-api/internal/entity"
+	"github.com/sesaquecruz/go-auth-api/internal/infra/web/handler"
+	authmiddleware "github.com/sesaquecruz/go-auth-api/internal/infra/web/middleware"
+
+	"github.com/go-chi/chi/v5"
+)
+
+// routes are the handlers of the API along with the middlewares guarding them.
+type routes struct {
+	authMiddlewares  chi.Middlewares
+	adminMiddlewares chi.Middlewares
+	forwardAuthRules []authmiddleware.ForwardAuthRule
+	reauthMaxAge     time.Duration
+	serveJWKS        bool
+	user             *handler.UserHandler
+	admin            *handler.AdminHandler
+	organization     *handler.OrganizationHandler
+	apiKey           *handler.APIKeyHandler
+	forwardAuth      *handler.ForwardAuthHandler
+	jwks             *handler.JWKSHandler
+	session          *handler.SessionHandler
+}
+
+func (rt routes) mount(r chi.Router) {
+	// Tokens limited to reading the profile can only find the user, and log out.
+	profileWrite := authmiddleware.RequireScopes(entity.ScopeProfileWrite)
+
+	// Destructive operations need the user to have entered their password lately.
+	recentAuth := authmiddleware.RequireRecentAuth(rt.reauthMaxAge)
+
+	if rt.serveJWKS {
+		r.Get("/.well-known/jwks.json", rt.jwks.GetJWKS)
+	}
+
+	r.Route(basePath+"/login", func(r chi.Router) {
+		r.Post("/", rt.user.AuthUser)
+		r.With(rt.authMiddlewares...).With(profileWrite).Post("/reauth", rt.user.ReauthUser)
+	})
+
+	r.With(rt.authMiddlewares...).Post(basePath+"/logout", rt.session.Logout)
+
+	r.Route(basePath+"/auth", func(r chi.Router) {
+		r.With(authmiddleware.MatchForwardAuthRule(rt.forwardAuthRules)).
+			With(rt.authMiddlewares...).
+			With(authmiddleware.RequireForwardAuthRule).
+			Get("/verify", rt.forwardAuth.Verify)
+
+		// Forward auth rules only apply to forwarded requests, never to the token itself.
+		r.With(rt.authMiddlewares...).Get("/introspect", rt.forwardAuth.Introspect)
+	})
+
+	r.Route(basePath+"/token", func(r chi.Router) {
+		r.Use(rt.authMiddlewares...)
+		r.Use(profileWrite)
+		r.Post("/organization", rt.organization.SwitchOrganization)
+	})
+
+	r.Route(basePath+"/users", func(r chi.Router) {
+		r.Post("/", rt.user.CreateUser)
+		r.Post("/restore", rt.user.RestoreUser)
+		r.With(rt.authMiddlewares...).Get("/", rt.user.FindUser)
+		r.With(rt.authMiddlewares...).With(profileWrite, recentAuth).Put("/", rt.user.UpdateUser)
+		r.With(rt.authMiddlewares...).With(profileWrite, recentAuth).Delete("/", rt.user.DeleteUser)
+
+		r.Route("/api-keys", func(r chi.Router) {
+			r.Use(rt.authMiddlewares...)
+			r.Use(profileWrite)
+			r.Get("/", rt.apiKey.ListAPIKeys)
+			r.Post("/", rt.apiKey.CreateAPIKey)
+			r.Delete("/{id}", rt.apiKey.RevokeAPIKey)
+		})
+
+		r.Route("/sessions", func(r chi.Router) {
+			r.Use(rt.authMiddlewares...)
+			r.Use(profileWrite)
+			r.Get("/", rt.session.ListSessions)
+			r.Delete("/{id}", rt.session.RevokeSession)
+		})
+	})
+
+	r.Route(basePath+"/admin/users", func(r chi.Router) {
+		r.Use(rt.adminMiddlewares...)
+		r.Use(profileWrite)
+		r.Get("/", rt.admin.ListUsers)
+		r.Post("/", rt.admin.CreateUser)
+		r.Get("/{id}", rt.admin.FindUser)
+		r.Post("/{id}/suspend", rt.admin.SuspendUser)
+		r.Post("/{id}/enable", rt.admin.EnableUser)
+		r.Post("/{id}/password-reset", rt.admin.ResetUserPassword)
+		r.Delete("/{id}", rt.admin.DeleteUser)
+	})
+
+	r.Route(basePath+"/organizations", func(r chi.Router) {
+		r.Use(rt.authMiddlewares...)
+		r.Use(profileWrite)
+		r.Post("/", rt.organization.CreateOrganization)
+		r.Get("/{id}/members", rt.organization.ListMembers)
+		r.Post("/{id}/invitations", rt.organization.InviteMember)
+		r.Put("/{id}/members/{user_id}", rt.organization.SetMemberRole)
+		r.Delete("/{id}/members/{user_id}", rt.organization.RemoveMember)
+	})
+
+	r.Route(basePath+"/invitations", func(r chi.Router) {
+		r.Use(rt.authMiddlewares...)
+		r.Use(profileWrite)
+		r.Post("/accept", rt.organization.AcceptInvitation)
+		r.Post("/decline", rt.organization.DeclineInvitation)
+	})
+}
@@ -0,0 +1,143 @@
+package main
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/sesaquecruz/go-auth-api/internal/entity"
+	"github.com/sesaquecruz/go-auth-api/internal/infra/web/handler"
+	authmiddleware "github.com/sesaquecruz/go-auth-api/internal/infra/web/middleware"
+	"github.com/sesaquecruz/go-auth-api/internal/usecase"
+
+	"github.com/go-chi/chi/v5"
+	"github.com/go-chi/jwtauth"
+	"github.com/golang/mock/gomock"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func newTestRouter(jwtAuth *jwtauth.JWTAuth, user *handler.UserHandler, rules ...authmiddleware.ForwardAuthRule) http.Handler {
+	authMiddlewares := chi.Chain(authmiddleware.Verifier(jwtAuth), authmiddleware.Authenticator)
+
+	r := chi.NewRouter()
+	routes{
+		authMiddlewares:  authMiddlewares,
+		adminMiddlewares: authMiddlewares,
+		forwardAuthRules: rules,
+		reauthMaxAge:     5 * time.Minute,
+		user:             user,
+		admin:            &handler.AdminHandler{},
+		organization:     &handler.OrganizationHandler{},
+		apiKey:           &handler.APIKeyHandler{},
+		forwardAuth:      &handler.ForwardAuthHandler{},
+		jwks:             &handler.JWKSHandler{},
+		session:          &handler.SessionHandler{},
+	}.mount(r)
+	return r
+}
+
+func readOnlyToken(t *testing.T, jwtAuth *jwtauth.JWTAuth) string {
+	_, token, err := jwtAuth.Encode(map[string]interface{}{
+		"sub":       uuid.NewString(),
+		"sid":       uuid.NewString(),
+		"scope":     entity.ScopeProfileRead,
+		"auth_time": time.Now().Unix(),
+		"exp":       jwtauth.ExpireIn(time.Minute),
+	})
+	require.Nil(t, err)
+	return token
+}
+
+func Test_Routes_RequireProfileWrite(t *testing.T) {
+	jwtAuth := jwtauth.New("HS256", []byte("secret"), nil)
+	router := newTestRouter(jwtAuth, &handler.UserHandler{})
+	token := readOnlyToken(t, jwtAuth)
+	id := uuid.NewString()
+
+	routes := []struct {
+		method string
+		path   string
+	}{
+		{http.MethodPost, basePath + "/login/reauth"},
+		{http.MethodPost, basePath + "/token/organization"},
+		{http.MethodPut, basePath + "/users"},
+		{http.MethodDelete, basePath + "/users"},
+		{http.MethodGet, basePath + "/users/api-keys"},
+		{http.MethodPost, basePath + "/users/api-keys"},
+		{http.MethodDelete, basePath + "/users/api-keys/" + id},
+		{http.MethodGet, basePath + "/users/sessions"},
+		{http.MethodDelete, basePath + "/users/sessions/" + id},
+		{http.MethodGet, basePath + "/admin/users"},
+		{http.MethodPost, basePath + "/admin/users"},
+		{http.MethodGet, basePath + "/admin/users/" + id},
+		{http.MethodPost, basePath + "/admin/users/" + id + "/suspend"},
+		{http.MethodPost, basePath + "/admin/users/" + id + "/enable"},
+		{http.MethodPost, basePath + "/admin/users/" + id + "/password-reset"},
+		{http.MethodDelete, basePath + "/admin/users/" + id},
+		{http.MethodPost, basePath + "/organizations"},
+		{http.MethodGet, basePath + "/organizations/" + id + "/members"},
+		{http.MethodPost, basePath + "/organizations/" + id + "/invitations"},
+		{http.MethodPut, basePath + "/organizations/" + id + "/members/" + id},
+		{http.MethodDelete, basePath + "/organizations/" + id + "/members/" + id},
+		{http.MethodPost, basePath + "/invitations/accept"},
+		{http.MethodPost, basePath + "/invitations/decline"},
+	}
+
+	for _, route := range routes {
+		t.Run(route.method+" "+route.path, func(t *testing.T) {
+			req := httptest.NewRequest(route.method, route.path, nil)
+			req.Header.Set("Authorization", "Bearer "+token)
+			rr := httptest.NewRecorder()
+			router.ServeHTTP(rr, req)
+
+			assert.Equal(t, http.StatusForbidden, rr.Code)
+			assert.Contains(t, rr.Header().Get("WWW-Authenticate"), `error="insufficient_scope"`)
+		})
+	}
+}
+
+func Test_Routes_ProfileReadFindsUser(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	findUserUseCase := usecase.NewMockFindUserUseCaseInterface(ctrl)
+	findUserUseCase.EXPECT().
+		Execute(gomock.Any(), gomock.Any()).
+		Return(&usecase.FindUserUseCaseOutputDTO{Email: "user@mail.com", Version: 1}, nil).
+		Times(1)
+
+	jwtAuth := jwtauth.New("HS256", []byte("secret"), nil)
+	router := newTestRouter(jwtAuth, &handler.UserHandler{FindUserUseCase: findUserUseCase})
+
+	req := httptest.NewRequest(http.MethodGet, basePath+"/users", nil)
+	req.Header.Set("Authorization", "Bearer "+readOnlyToken(t, jwtAuth))
+	rr := httptest.NewRecorder()
+	router.ServeHTTP(rr, req)
+
+	assert.Equal(t, http.StatusOK, rr.Code)
+}
+
+func Test_Routes_IntrospectIgnoresForwardAuthRules(t *testing.T) {
+	jwtAuth := jwtauth.New("HS256", []byte("secret"), nil)
+	rules := []authmiddleware.ForwardAuthRule{{Prefix: "/", Access: authmiddleware.ForwardAuthPublic}}
+	router := newTestRouter(jwtAuth, &handler.UserHandler{}, rules...)
+
+	req := httptest.NewRequest(http.MethodGet, basePath+"/auth/verify", nil)
+	rr := httptest.NewRecorder()
+	router.ServeHTTP(rr, req)
+	assert.Equal(t, http.StatusOK, rr.Code)
+
+	for _, token := range []string{"", "invalid"} {
+		req = httptest.NewRequest(http.MethodGet, basePath+"/auth/introspect", nil)
+		req.Header.Set("X-Forwarded-Uri", "/")
+		if token != "" {
+			req.Header.Set("Authorization", "Bearer "+token)
+		}
+		rr = httptest.NewRecorder()
+		router.ServeHTTP(rr, req)
+		assert.Equal(t, http.StatusUnauthorized, rr.Code)
+	}
+}
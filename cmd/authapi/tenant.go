@@ -0,0 +1,43 @@
+package main
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"log"
+
+	"github.com/sesaquecruz/go-auth-api/config"
+	"github.com/sesaquecruz/go-auth-api/internal/entity"
+	"github.com/sesaquecruz/go-auth-api/internal/infra/database/repository"
+)
+
+const tenantUsage = "usage: authapi tenant create SLUG NAME [HOST]"
+
+func runTenant(db *sql.DB, cfg *config.Config, args []string) error {
+	if len(args) < 3 || len(args) > 4 || args[0] != "create" {
+		return errors.New(tenantUsage)
+	}
+
+	dialect, err := repository.DialectFor(cfg.DBDriver)
+	if err != nil {
+		return err
+	}
+
+	var host string
+	if len(args) == 4 {
+		host = args[3]
+	}
+
+	tenant, err := entity.NewTenant(args[1], args[2], host)
+	if err != nil {
+		return err
+	}
+
+	err = repository.NewTenantRepository(db, dialect).Save(context.Background(), *tenant)
+	if err != nil {
+		return err
+	}
+
+	log.Printf("create tenant %s %s\n", tenant.Slug, tenant.ID)
+	return nil
+}
@@ -5,20 +5,52 @@ import (
 	"os"
 	"reflect"
 	"strconv"
+	"strings"
 )
 
 type Config struct {
-	DBDriver      string `env:"DB_DRIVER"`
-	DBHost        string `env:"DB_HOST"`
-	DBPort        string `env:"DB_PORT"`
-	DBName        string `env:"DB_NAME"`
-	DBUser        string `env:"DB_USER"`
-	DBPassword    string `env:"DB_PASSWORD"`
-	JWTSecret     string `env:"JWT_SECRET"`
-	JWTExpSeconds int64  `env:"JWT_EXP_SECONDS"`
+	DBDriver                    string `env:"DB_DRIVER"`
+	DBHost                      string `env:"DB_HOST" default:""`
+	DBPort                      string `env:"DB_PORT" default:""`
+	DBName                      string `env:"DB_NAME"`
+	DBUser                      string `env:"DB_USER" default:""`
+	DBPassword                  string `env:"DB_PASSWORD" default:""`
+	DBSSLMode                   string `env:"DB_SSL_MODE" default:"disable"`
+	AutoMigrate                 bool   `env:"AUTO_MIGRATE" default:"false"`
+	JWTSecret                   string `env:"JWT_SECRET"`
+	JWTExpSeconds               int64  `env:"JWT_EXP_SECONDS"`
+	JWTPrivateKeyFile           string `env:"JWT_PRIVATE_KEY_FILE" default:""`
+	JWTIssuer                   string `env:"JWT_ISSUER" default:""`
+	JWTAudience                 string `env:"JWT_AUDIENCE" default:""`
+	TrustProxyHeaders           bool   `env:"TRUST_PROXY_HEADERS" default:"false"`
+	RegistrationConcealExisting bool   `env:"REGISTRATION_CONCEAL_EXISTING" default:"false"`
+	SMTPHost                    string `env:"SMTP_HOST" default:""`
+	SMTPPort                    string `env:"SMTP_PORT" default:"587"`
+	SMTPUser                    string `env:"SMTP_USER" default:""`
+	SMTPPassword                string `env:"SMTP_PASSWORD" default:""`
+	SMTPFrom                    string `env:"SMTP_FROM" default:"no-reply@localhost"`
+	DeletionGraceSeconds        int64  `env:"DELETION_GRACE_SECONDS" default:"2592000"`
+	PurgeIntervalSeconds        int64  `env:"PURGE_INTERVAL_SECONDS" default:"3600"`
+	InvitationExpSeconds        int64  `env:"INVITATION_EXP_SECONDS" default:"604800"`
+	InvitationURL               string `env:"INVITATION_URL" default:"http://localhost:8080/invitations"`
+	APIKeyMaxExpSeconds         int64  `env:"API_KEY_MAX_EXP_SECONDS" default:"7776000"`
+	ReauthMaxAgeSeconds         int64  `env:"REAUTH_MAX_AGE_SECONDS" default:"300"`
+	CookieSecure                bool   `env:"COOKIE_SECURE" default:"true"`
+	CookieSameSite              string `env:"COOKIE_SAME_SITE" default:"strict"`
+	ForwardAuthRules            string `env:"FORWARD_AUTH_RULES" default:""`
 }
 
 func LoadConfig() (*Config, error) {
+	return load(func(string) bool { return true })
+}
+
+// LoadDBConfig loads only the DB_ settings, for the commands that work on the database without running
+// the server, leaving the other settings zero.
+func LoadDBConfig() (*Config, error) {
+	return load(func(varName string) bool { return strings.HasPrefix(varName, "DB_") })
+}
+
+func load(wanted func(varName string) bool) (*Config, error) {
 	config := Config{}
 
 	elements := reflect.ValueOf(&config).Elem()
@@ -28,9 +60,16 @@ func LoadConfig() (*Config, error) {
 		field := elements.Field(i)
 
 		varName := types.Field(i).Tag.Get("env")
+		if !wanted(varName) {
+			continue
+		}
+
 		varValue, ok := os.LookupEnv(varName)
 		if !ok {
-			return nil, fmt.Errorf("%s was not found", varName)
+			varValue, ok = types.Field(i).Tag.Lookup("default")
+			if !ok {
+				return nil, fmt.Errorf("%s was not found", varName)
+			}
 		}
 
 		switch field.Kind() {
@@ -42,6 +81,12 @@ func LoadConfig() (*Config, error) {
 				return nil, err
 			}
 			field.SetInt(int64(intValue))
+		case reflect.Bool:
+			boolValue, err := strconv.ParseBool(varValue)
+			if err != nil {
+				return nil, err
+			}
+			field.SetBool(boolValue)
 		default:
 			return nil, fmt.Errorf("fail to covert %s", varName)
 		}
@@ -0,0 +1,25 @@
+package config
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func Test_LoadDBConfig(t *testing.T) {
+	t.Setenv("DB_DRIVER", "sqlite")
+	t.Setenv("DB_NAME", "auth.db")
+	t.Setenv("JWT_EXP_SECONDS", "invalid")
+
+	cfg, err := LoadDBConfig()
+	require.Nil(t, err)
+	assert.Equal(t, "sqlite", cfg.DBDriver)
+	assert.Equal(t, "auth.db", cfg.DBName)
+	assert.Equal(t, "disable", cfg.DBSSLMode)
+	assert.Zero(t, cfg.JWTExpSeconds)
+	assert.Empty(t, cfg.SMTPPort)
+
+	_, err = LoadConfig()
+	assert.NotNil(t, err)
+}
@@ -0,0 +1,74 @@
+package entity
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func Test_NewAPIKey(t *testing.T) {
+	userID := uuid.New()
+
+	key, plain, err := NewAPIKey(userID, " ci ", []string{"users:read", "users:admin", "users:read"}, time.Hour)
+	require.Nil(t, err)
+	assert.NotEqual(t, uuid.Nil, key.ID)
+	assert.Equal(t, userID, key.UserID)
+	assert.Equal(t, "ci", key.Name)
+	assert.Equal(t, []string{"users:admin", "users:read"}, key.Scopes)
+	assert.Equal(t, key.CreatedAt.Add(time.Hour), key.ExpiresAt)
+	assert.True(t, strings.HasPrefix(plain, "ak_"+key.Prefix+"_"))
+	assert.NotContains(t, key.SecretHash, strings.TrimPrefix(plain, "ak_"+key.Prefix+"_"))
+
+	prefix, secret, err := ParseAPIKey(plain)
+	require.Nil(t, err)
+	assert.Equal(t, key.Prefix, prefix)
+	assert.True(t, key.Matches(secret))
+	assert.False(t, key.Matches(secret+"x"))
+
+	_, other, err := NewAPIKey(userID, "ci", nil, time.Hour)
+	require.Nil(t, err)
+	assert.NotEqual(t, plain, other)
+}
+
+func Test_NewAPIKey_WhenDataIsInvalid(t *testing.T) {
+	for _, name := range []string{"", " ", strings.Repeat("a", 101)} {
+		_, _, err := NewAPIKey(uuid.New(), name, nil, time.Hour)
+		assert.ErrorIs(t, err, ErrAPIKeyInvalidName)
+	}
+
+	for _, scope := range []string{"", "users read"} {
+		_, _, err := NewAPIKey(uuid.New(), "ci", []string{scope}, time.Hour)
+		assert.ErrorIs(t, err, ErrAPIKeyInvalidScope)
+	}
+}
+
+func Test_ParseAPIKey_WhenKeyIsMalformed(t *testing.T) {
+	for _, key := range []string{"", "abc", "ak_", "ak_0123456789ab", "ak_0123456789ab_", "ak_0123_secret", "xx_0123456789ab_secret"} {
+		_, _, err := ParseAPIKey(key)
+		assert.ErrorIs(t, err, ErrAPIKeyMalformed, key)
+	}
+}
+
+func Test_APIKey_IsActive(t *testing.T) {
+	key, _, err := NewAPIKey(uuid.New(), "ci", nil, time.Hour)
+	require.Nil(t, err)
+
+	assert.True(t, key.IsActive(key.CreatedAt))
+	assert.False(t, key.IsActive(key.ExpiresAt))
+
+	revokedAt := key.CreatedAt
+	key.RevokedAt = &revokedAt
+	assert.False(t, key.IsActive(key.CreatedAt))
+}
+
+func Test_APIKey_GrantedPermissions(t *testing.T) {
+	key, _, err := NewAPIKey(uuid.New(), "ci", []string{"users:read", "users:write"}, time.Hour)
+	require.Nil(t, err)
+
+	assert.Equal(t, []string{"users:read"}, key.GrantedPermissions([]string{PermissionUsersAdmin, "users:read"}))
+	assert.Empty(t, key.GrantedPermissions(nil))
+}
@@ -0,0 +1,141 @@
+package entity
+
+import (
+	"strings"
+	"unicode/utf8"
+
+	"golang.org/x/net/idna"
+)
+
+const (
+	emailMaxLen      = 254
+	emailLocalMaxLen = 64
+	emailLabelMaxLen = 63
+)
+
+const emailAtext = "!#$%&'*+-/=?^_`{|}~"
+
+// NormalizeEmail validates an address against the RFC 5322 addr-spec grammar and returns it
+// with surrounding spaces removed and the domain lowercased in its ASCII (punycode) form.
+// The local part keeps its case. Domain literals such as user@[127.0.0.1] are not accepted.
+func NormalizeEmail(email string) (string, error) {
+	email = strings.TrimSpace(email)
+
+	at := strings.LastIndexByte(email, '@')
+	if at <= 0 || at == len(email)-1 {
+		return "", ErrUserInvalidEmail
+	}
+
+	local, domain := email[:at], email[at+1:]
+	if len(local) > emailLocalMaxLen || !isValidEmailLocalPart(local) {
+		return "", ErrUserInvalidEmail
+	}
+
+	domain, err := idna.Lookup.ToASCII(domain)
+	if err != nil || !isValidEmailDomain(domain) {
+		return "", ErrUserInvalidEmail
+	}
+
+	normalized := local + "@" + strings.ToLower(domain)
+	if len(normalized) > emailMaxLen {
+		return "", ErrUserInvalidEmail
+	}
+
+	return normalized, nil
+}
+
+// EmailLookupKey returns the case-insensitive identity of an address, used to find and
+// deduplicate accounts.
+func EmailLookupKey(email string) string {
+	normalized, err := NormalizeEmail(email)
+	if err != nil {
+		normalized = strings.TrimSpace(email)
+	}
+	return strings.ToLower(normalized)
+}
+
+func IsValidEmail(email string) bool {
+	_, err := NormalizeEmail(email)
+	return err == nil
+}
+
+func isValidEmailLocalPart(local string) bool {
+	if strings.HasPrefix(local, `"`) {
+		return isValidEmailQuotedString(local)
+	}
+	return isValidEmailDotAtom(local)
+}
+
+func isValidEmailDotAtom(s string) bool {
+	for _, atom := range strings.Split(s, ".") {
+		if atom == "" {
+			return false
+		}
+		for _, c := range atom {
+			if !isEmailAtext(c) {
+				return false
+			}
+		}
+	}
+	return true
+}
+
+func isValidEmailQuotedString(s string) bool {
+	if len(s) < 2 || !strings.HasSuffix(s, `"`) {
+		return false
+	}
+
+	content := s[1 : len(s)-1]
+	for i := 0; i < len(content); i++ {
+		c := content[i]
+		switch {
+		case c == '\\':
+			i++
+			if i == len(content) || (content[i] < ' ' && content[i] != '\t') || content[i] == 0x7f {
+				return false
+			}
+		case c == '"':
+			return false
+		case c < ' ' && c != '\t', c == 0x7f:
+			return false
+		}
+	}
+
+	return utf8.ValidString(content)
+}
+
+func isEmailAtext(c rune) bool {
+	switch {
+	case c >= 'a' && c <= 'z', c >= 'A' && c <= 'Z', c >= '0' && c <= '9':
+		return true
+	case c >= utf8.RuneSelf:
+		return c != utf8.RuneError
+	default:
+		return strings.ContainsRune(emailAtext, c)
+	}
+}
+
+func isValidEmailDomain(domain string) bool {
+	labels := strings.Split(domain, ".")
+	if len(labels) < 2 {
+		return false
+	}
+
+	for _, label := range labels {
+		if label == "" || len(label) > emailLabelMaxLen {
+			return false
+		}
+		if label[0] == '-' || label[len(label)-1] == '-' {
+			return false
+		}
+		for i := 0; i < len(label); i++ {
+			c := label[i]
+			if !(c >= 'a' && c <= 'z' || c >= 'A' && c <= 'Z' || c >= '0' && c <= '9' || c == '-') {
+				return false
+			}
+		}
+	}
+
+	tld := labels[len(labels)-1]
+	return len(tld) >= 2 && strings.Trim(tld, "0123456789") != ""
+}
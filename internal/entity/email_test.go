@@ -0,0 +1,76 @@
+package entity
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func Test_Email_NormalizeEmail(t *testing.T) {
+	valid := map[string]string{
+		"user@mail.com":                "user@mail.com",
+		"  user@mail.com ":             "user@mail.com",
+		"User@Mail.COM":                "User@mail.com",
+		"user+tag@mail.com":            "user+tag@mail.com",
+		"first.last@sub.mail.com":      "first.last@sub.mail.com",
+		"o'brien@mail.com":             "o'brien@mail.com",
+		"user@mail-server.com":         "user@mail-server.com",
+		`"john doe"@mail.com`:          `"john doe"@mail.com`,
+		`"john\"doe"@mail.com`:         `"john\"doe"@mail.com`,
+		`"user@local"@mail.com`:        `"user@local"@mail.com`,
+		"user@münchen.de":              "user@xn--mnchen-3ya.de",
+		"user@MÜNCHEN.de":              "user@xn--mnchen-3ya.de",
+		"josé@mail.com":                "josé@mail.com",
+		"user@xn--mnchen-3ya.de":       "user@xn--mnchen-3ya.de",
+		"#!$%&'*+-/=?^_`{}|~@mail.com": "#!$%&'*+-/=?^_`{}|~@mail.com",
+	}
+	for input, expected := range valid {
+		email, err := NormalizeEmail(input)
+		assert.Nil(t, err, input)
+		assert.Equal(t, expected, email, input)
+	}
+
+	invalid := []string{
+		"",
+		"user",
+		"@mail.com",
+		"user@",
+		"usermail.com",
+		"user@mailcom",
+		"user@mail.c",
+		"user@mail.123",
+		"user@.mail.com",
+		"user@mail..com",
+		"user@-mail.com",
+		"user@mail_server.com",
+		"user@[127.0.0.1]",
+		".user@mail.com",
+		"user.@mail.com",
+		"us..er@mail.com",
+		"us er@mail.com",
+		"us(er@mail.com",
+		`"user@mail.com`,
+		`"us"er"@mail.com`,
+		strings.Repeat("a", 65) + "@mail.com",
+		"user@" + strings.Repeat("a", 64) + ".com",
+		"user@" + strings.Repeat(strings.Repeat("a", 60)+".", 5) + "com",
+	}
+	for _, input := range invalid {
+		email, err := NormalizeEmail(input)
+		assert.ErrorIs(t, err, ErrUserInvalidEmail, input)
+		assert.Empty(t, email, input)
+	}
+}
+
+func Test_Email_EmailLookupKey(t *testing.T) {
+	assert.Equal(t, "user@mail.com", EmailLookupKey("user@mail.com"))
+	assert.Equal(t, "user@mail.com", EmailLookupKey(" User@MAIL.com "))
+	assert.Equal(t, "user+tag@xn--mnchen-3ya.de", EmailLookupKey("User+Tag@München.de"))
+	assert.Equal(t, "not-an-email", EmailLookupKey(" Not-An-Email "))
+}
+
+func Test_Email_IsValidEmail(t *testing.T) {
+	assert.True(t, IsValidEmail("user+tag@mail.com"))
+	assert.False(t, IsValidEmail("user@mailcom"))
+}
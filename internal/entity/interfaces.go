@@ -2,6 +2,8 @@ package entity
 
 import (
 	"context"
+	"errors"
+	"time"
 
 	"github.com/google/uuid"
 )
@@ -11,10 +13,110 @@ type UserFactoryInterface interface {
 	GetUser(id string, email string, password string) (*User, error)
 }
 
+var (
+	ErrUserEmailAlreadyExists = errors.New("email already exists")
+	ErrUserVersionConflict    = errors.New("version conflict")
+)
+
+// UserRepositoryInterface only finds users that are not deleted, except for FindDeletedByEmail.
+// Delete keeps the row, and its email, until Purge removes it.
+// Every method but Purge is scoped to the tenant of ctx (see TenantFromContext): Save stores the user
+// in it, emails are unique within it, and users of other tenants are never found nor changed.
 type UserRepositoryInterface interface {
 	Save(ctx context.Context, user User) error
 	FindById(ctx context.Context, id uuid.UUID) (*User, error)
 	FindByEmail(ctx context.Context, email string) (*User, error)
 	Update(ctx context.Context, user User) error
+	Delete(ctx context.Context, id uuid.UUID, version int64, at time.Time) error
+	RecordLogin(ctx context.Context, id uuid.UUID, at time.Time, ip string) error
+	FindDeletedByEmail(ctx context.Context, email string) (*User, error)
+	Restore(ctx context.Context, id uuid.UUID, version int64, at time.Time) error
+	Purge(ctx context.Context, deletedBefore time.Time) (int64, error)
+	SetStatus(ctx context.Context, id uuid.UUID, status UserStatus, at time.Time) error
+	List(ctx context.Context, filter UserListFilter) (*UserPage, error)
+}
+
+type TenantRepositoryInterface interface {
+	Save(ctx context.Context, tenant Tenant) error
+	FindById(ctx context.Context, id uuid.UUID) (*Tenant, error)
+	FindBySlug(ctx context.Context, slug string) (*Tenant, error)
+	FindByHost(ctx context.Context, host string) (*Tenant, error)
+}
+
+var ErrMembershipAlreadyExists = errors.New("membership already exists")
+
+// OrganizationRepositoryInterface is scoped to the tenant of ctx like UserRepositoryInterface: Save stores
+// the organization in it, and organizations of other tenants, with their members, are never found.
+type OrganizationRepositoryInterface interface {
+	Save(ctx context.Context, organization Organization) error
+	FindById(ctx context.Context, id uuid.UUID) (*Organization, error)
+	AddMember(ctx context.Context, membership Membership) error
+	FindMember(ctx context.Context, organizationID uuid.UUID, userID uuid.UUID) (*Membership, error)
+	ListMembers(ctx context.Context, organizationID uuid.UUID) ([]Membership, error)
+	SetMemberRole(ctx context.Context, organizationID uuid.UUID, userID uuid.UUID, role OrganizationRole) error
+	RemoveMember(ctx context.Context, organizationID uuid.UUID, userID uuid.UUID) error
+}
+
+// InvitationRepositoryInterface only finds invitations to organizations of the tenant of ctx.
+type InvitationRepositoryInterface interface {
+	Save(ctx context.Context, invitation Invitation) error
+	FindById(ctx context.Context, id uuid.UUID) (*Invitation, error)
 	Delete(ctx context.Context, id uuid.UUID) error
 }
+
+var (
+	ErrInvitationInvalidToken = errors.New("invalid invitation")
+	ErrInvitationExpired      = errors.New("invitation expired")
+)
+
+// InvitationSignerInterface turns an invitation into a token for its invite link, and back into
+// the invitation ID, failing with ErrInvitationInvalidToken or ErrInvitationExpired.
+type InvitationSignerInterface interface {
+	Sign(invitation Invitation) (string, error)
+	Verify(token string) (uuid.UUID, error)
+}
+
+// APIKeyRepositoryInterface only finds keys of users of the tenant of ctx. FindByUser leaves out revoked keys,
+// and Revoke fails with sql.ErrNoRows when the user has no such key left to revoke.
+type APIKeyRepositoryInterface interface {
+	Save(ctx context.Context, key APIKey) error
+	FindByPrefix(ctx context.Context, prefix string) (*APIKey, error)
+	FindByUser(ctx context.Context, userID uuid.UUID) ([]APIKey, error)
+	Revoke(ctx context.Context, id uuid.UUID, userID uuid.UUID, at time.Time) error
+	RecordUse(ctx context.Context, id uuid.UUID, at time.Time) error
+}
+
+// SessionRepositoryInterface only finds sessions of users of the tenant of ctx. FindActiveByUser leaves out
+// sessions revoked or expired at the given time, and Revoke fails with sql.ErrNoRows when the user has no such
+// session left to revoke.
+type SessionRepositoryInterface interface {
+	Save(ctx context.Context, session Session) error
+	FindById(ctx context.Context, id uuid.UUID) (*Session, error)
+	FindActiveByUser(ctx context.Context, userID uuid.UUID, at time.Time) ([]Session, error)
+	Touch(ctx context.Context, id uuid.UUID, at time.Time) error
+	Revoke(ctx context.Context, id uuid.UUID, userID uuid.UUID, at time.Time) error
+	RevokeByUser(ctx context.Context, userID uuid.UUID, at time.Time) error
+}
+
+var (
+	ErrRoleAlreadyExists = errors.New("role already exists")
+	ErrRoleNotFound      = errors.New("role not found")
+)
+
+type RoleRepositoryInterface interface {
+	Save(ctx context.Context, role Role) error
+	FindByName(ctx context.Context, name string) (*Role, error)
+	FindByUserId(ctx context.Context, userID uuid.UUID) ([]Role, error)
+	AssignToUser(ctx context.Context, userID uuid.UUID, name string) error
+	RevokeFromUser(ctx context.Context, userID uuid.UUID, name string) error
+}
+
+// TransactionManagerInterface runs fn as a unit of work: the repository calls made with the ctx passed
+// to fn are committed together when it returns nil and rolled back when it returns an error.
+type TransactionManagerInterface interface {
+	Do(ctx context.Context, fn func(ctx context.Context) error) error
+}
+
+type MailerInterface interface {
+	Send(ctx context.Context, to string, subject string, body string) error
+}
@@ -7,6 +7,7 @@ package entity
 import (
 	context "context"
 	reflect "reflect"
+	time "time"
 
 	gomock "github.com/golang/mock/gomock"
 	uuid "github.com/google/uuid"
@@ -89,17 +90,17 @@ func (m *MockUserRepositoryInterface) EXPECT() *MockUserRepositoryInterfaceMockR
 }
 
 // Delete mocks base method.
-func (m *MockUserRepositoryInterface) Delete(ctx context.Context, id uuid.UUID) error {
+func (m *MockUserRepositoryInterface) Delete(ctx context.Context, id uuid.UUID, version int64, at time.Time) error {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "Delete", ctx, id)
+	ret := m.ctrl.Call(m, "Delete", ctx, id, version, at)
 	ret0, _ := ret[0].(error)
 	return ret0
 }
 
 // Delete indicates an expected call of Delete.
-func (mr *MockUserRepositoryInterfaceMockRecorder) Delete(ctx, id interface{}) *gomock.Call {
+func (mr *MockUserRepositoryInterfaceMockRecorder) Delete(ctx, id, version, at interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Delete", reflect.TypeOf((*MockUserRepositoryInterface)(nil).Delete), ctx, id)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Delete", reflect.TypeOf((*MockUserRepositoryInterface)(nil).Delete), ctx, id, version, at)
 }
 
 // FindByEmail mocks base method.
@@ -132,6 +133,79 @@ func (mr *MockUserRepositoryInterfaceMockRecorder) FindById(ctx, id interface{})
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindById", reflect.TypeOf((*MockUserRepositoryInterface)(nil).FindById), ctx, id)
 }
 
+// FindDeletedByEmail mocks base method.
+func (m *MockUserRepositoryInterface) FindDeletedByEmail(ctx context.Context, email string) (*User, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindDeletedByEmail", ctx, email)
+	ret0, _ := ret[0].(*User)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindDeletedByEmail indicates an expected call of FindDeletedByEmail.
+func (mr *MockUserRepositoryInterfaceMockRecorder) FindDeletedByEmail(ctx, email interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindDeletedByEmail", reflect.TypeOf((*MockUserRepositoryInterface)(nil).FindDeletedByEmail), ctx, email)
+}
+
+// List mocks base method.
+func (m *MockUserRepositoryInterface) List(ctx context.Context, filter UserListFilter) (*UserPage, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "List", ctx, filter)
+	ret0, _ := ret[0].(*UserPage)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// List indicates an expected call of List.
+func (mr *MockUserRepositoryInterfaceMockRecorder) List(ctx, filter interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "List", reflect.TypeOf((*MockUserRepositoryInterface)(nil).List), ctx, filter)
+}
+
+// Purge mocks base method.
+func (m *MockUserRepositoryInterface) Purge(ctx context.Context, deletedBefore time.Time) (int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Purge", ctx, deletedBefore)
+	ret0, _ := ret[0].(int64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Purge indicates an expected call of Purge.
+func (mr *MockUserRepositoryInterfaceMockRecorder) Purge(ctx, deletedBefore interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Purge", reflect.TypeOf((*MockUserRepositoryInterface)(nil).Purge), ctx, deletedBefore)
+}
+
+// RecordLogin mocks base method.
+func (m *MockUserRepositoryInterface) RecordLogin(ctx context.Context, id uuid.UUID, at time.Time, ip string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RecordLogin", ctx, id, at, ip)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// RecordLogin indicates an expected call of RecordLogin.
+func (mr *MockUserRepositoryInterfaceMockRecorder) RecordLogin(ctx, id, at, ip interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RecordLogin", reflect.TypeOf((*MockUserRepositoryInterface)(nil).RecordLogin), ctx, id, at, ip)
+}
+
+// Restore mocks base method.
+func (m *MockUserRepositoryInterface) Restore(ctx context.Context, id uuid.UUID, version int64, at time.Time) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Restore", ctx, id, version, at)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Restore indicates an expected call of Restore.
+func (mr *MockUserRepositoryInterfaceMockRecorder) Restore(ctx, id, version, at interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Restore", reflect.TypeOf((*MockUserRepositoryInterface)(nil).Restore), ctx, id, version, at)
+}
+
 // Save mocks base method.
 func (m *MockUserRepositoryInterface) Save(ctx context.Context, user User) error {
 	m.ctrl.T.Helper()
@@ -146,6 +220,20 @@ func (mr *MockUserRepositoryInterfaceMockRecorder) Save(ctx, user interface{}) *
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Save", reflect.TypeOf((*MockUserRepositoryInterface)(nil).Save), ctx, user)
 }
 
+// SetStatus mocks base method.
+func (m *MockUserRepositoryInterface) SetStatus(ctx context.Context, id uuid.UUID, status UserStatus, at time.Time) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SetStatus", ctx, id, status, at)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SetStatus indicates an expected call of SetStatus.
+func (mr *MockUserRepositoryInterfaceMockRecorder) SetStatus(ctx, id, status, at interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetStatus", reflect.TypeOf((*MockUserRepositoryInterface)(nil).SetStatus), ctx, id, status, at)
+}
+
 // Update mocks base method.
 func (m *MockUserRepositoryInterface) Update(ctx context.Context, user User) error {
 	m.ctrl.T.Helper()
@@ -159,3 +247,701 @@ func (mr *MockUserRepositoryInterfaceMockRecorder) Update(ctx, user interface{})
 	mr.mock.ctrl.T.Helper()
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Update", reflect.TypeOf((*MockUserRepositoryInterface)(nil).Update), ctx, user)
 }
+
+// MockTenantRepositoryInterface is a mock of TenantRepositoryInterface interface.
+type MockTenantRepositoryInterface struct {
+	ctrl     *gomock.Controller
+	recorder *MockTenantRepositoryInterfaceMockRecorder
+}
+
+// MockTenantRepositoryInterfaceMockRecorder is the mock recorder for MockTenantRepositoryInterface.
+type MockTenantRepositoryInterfaceMockRecorder struct {
+	mock *MockTenantRepositoryInterface
+}
+
+// NewMockTenantRepositoryInterface creates a new mock instance.
+func NewMockTenantRepositoryInterface(ctrl *gomock.Controller) *MockTenantRepositoryInterface {
+	mock := &MockTenantRepositoryInterface{ctrl: ctrl}
+	mock.recorder = &MockTenantRepositoryInterfaceMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockTenantRepositoryInterface) EXPECT() *MockTenantRepositoryInterfaceMockRecorder {
+	return m.recorder
+}
+
+// FindByHost mocks base method.
+func (m *MockTenantRepositoryInterface) FindByHost(ctx context.Context, host string) (*Tenant, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindByHost", ctx, host)
+	ret0, _ := ret[0].(*Tenant)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindByHost indicates an expected call of FindByHost.
+func (mr *MockTenantRepositoryInterfaceMockRecorder) FindByHost(ctx, host interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindByHost", reflect.TypeOf((*MockTenantRepositoryInterface)(nil).FindByHost), ctx, host)
+}
+
+// FindById mocks base method.
+func (m *MockTenantRepositoryInterface) FindById(ctx context.Context, id uuid.UUID) (*Tenant, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindById", ctx, id)
+	ret0, _ := ret[0].(*Tenant)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindById indicates an expected call of FindById.
+func (mr *MockTenantRepositoryInterfaceMockRecorder) FindById(ctx, id interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindById", reflect.TypeOf((*MockTenantRepositoryInterface)(nil).FindById), ctx, id)
+}
+
+// FindBySlug mocks base method.
+func (m *MockTenantRepositoryInterface) FindBySlug(ctx context.Context, slug string) (*Tenant, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindBySlug", ctx, slug)
+	ret0, _ := ret[0].(*Tenant)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindBySlug indicates an expected call of FindBySlug.
+func (mr *MockTenantRepositoryInterfaceMockRecorder) FindBySlug(ctx, slug interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindBySlug", reflect.TypeOf((*MockTenantRepositoryInterface)(nil).FindBySlug), ctx, slug)
+}
+
+// Save mocks base method.
+func (m *MockTenantRepositoryInterface) Save(ctx context.Context, tenant Tenant) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Save", ctx, tenant)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Save indicates an expected call of Save.
+func (mr *MockTenantRepositoryInterfaceMockRecorder) Save(ctx, tenant interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Save", reflect.TypeOf((*MockTenantRepositoryInterface)(nil).Save), ctx, tenant)
+}
+
+// MockOrganizationRepositoryInterface is a mock of OrganizationRepositoryInterface interface.
+type MockOrganizationRepositoryInterface struct {
+	ctrl     *gomock.Controller
+	recorder *MockOrganizationRepositoryInterfaceMockRecorder
+}
+
+// MockOrganizationRepositoryInterfaceMockRecorder is the mock recorder for MockOrganizationRepositoryInterface.
+type MockOrganizationRepositoryInterfaceMockRecorder struct {
+	mock *MockOrganizationRepositoryInterface
+}
+
+// NewMockOrganizationRepositoryInterface creates a new mock instance.
+func NewMockOrganizationRepositoryInterface(ctrl *gomock.Controller) *MockOrganizationRepositoryInterface {
+	mock := &MockOrganizationRepositoryInterface{ctrl: ctrl}
+	mock.recorder = &MockOrganizationRepositoryInterfaceMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockOrganizationRepositoryInterface) EXPECT() *MockOrganizationRepositoryInterfaceMockRecorder {
+	return m.recorder
+}
+
+// AddMember mocks base method.
+func (m *MockOrganizationRepositoryInterface) AddMember(ctx context.Context, membership Membership) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AddMember", ctx, membership)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// AddMember indicates an expected call of AddMember.
+func (mr *MockOrganizationRepositoryInterfaceMockRecorder) AddMember(ctx, membership interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AddMember", reflect.TypeOf((*MockOrganizationRepositoryInterface)(nil).AddMember), ctx, membership)
+}
+
+// FindById mocks base method.
+func (m *MockOrganizationRepositoryInterface) FindById(ctx context.Context, id uuid.UUID) (*Organization, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindById", ctx, id)
+	ret0, _ := ret[0].(*Organization)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindById indicates an expected call of FindById.
+func (mr *MockOrganizationRepositoryInterfaceMockRecorder) FindById(ctx, id interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindById", reflect.TypeOf((*MockOrganizationRepositoryInterface)(nil).FindById), ctx, id)
+}
+
+// FindMember mocks base method.
+func (m *MockOrganizationRepositoryInterface) FindMember(ctx context.Context, organizationID, userID uuid.UUID) (*Membership, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindMember", ctx, organizationID, userID)
+	ret0, _ := ret[0].(*Membership)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindMember indicates an expected call of FindMember.
+func (mr *MockOrganizationRepositoryInterfaceMockRecorder) FindMember(ctx, organizationID, userID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindMember", reflect.TypeOf((*MockOrganizationRepositoryInterface)(nil).FindMember), ctx, organizationID, userID)
+}
+
+// ListMembers mocks base method.
+func (m *MockOrganizationRepositoryInterface) ListMembers(ctx context.Context, organizationID uuid.UUID) ([]Membership, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListMembers", ctx, organizationID)
+	ret0, _ := ret[0].([]Membership)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListMembers indicates an expected call of ListMembers.
+func (mr *MockOrganizationRepositoryInterfaceMockRecorder) ListMembers(ctx, organizationID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListMembers", reflect.TypeOf((*MockOrganizationRepositoryInterface)(nil).ListMembers), ctx, organizationID)
+}
+
+// RemoveMember mocks base method.
+func (m *MockOrganizationRepositoryInterface) RemoveMember(ctx context.Context, organizationID, userID uuid.UUID) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RemoveMember", ctx, organizationID, userID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// RemoveMember indicates an expected call of RemoveMember.
+func (mr *MockOrganizationRepositoryInterfaceMockRecorder) RemoveMember(ctx, organizationID, userID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RemoveMember", reflect.TypeOf((*MockOrganizationRepositoryInterface)(nil).RemoveMember), ctx, organizationID, userID)
+}
+
+// Save mocks base method.
+func (m *MockOrganizationRepositoryInterface) Save(ctx context.Context, organization Organization) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Save", ctx, organization)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Save indicates an expected call of Save.
+func (mr *MockOrganizationRepositoryInterfaceMockRecorder) Save(ctx, organization interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Save", reflect.TypeOf((*MockOrganizationRepositoryInterface)(nil).Save), ctx, organization)
+}
+
+// SetMemberRole mocks base method.
+func (m *MockOrganizationRepositoryInterface) SetMemberRole(ctx context.Context, organizationID, userID uuid.UUID, role OrganizationRole) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SetMemberRole", ctx, organizationID, userID, role)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SetMemberRole indicates an expected call of SetMemberRole.
+func (mr *MockOrganizationRepositoryInterfaceMockRecorder) SetMemberRole(ctx, organizationID, userID, role interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetMemberRole", reflect.TypeOf((*MockOrganizationRepositoryInterface)(nil).SetMemberRole), ctx, organizationID, userID, role)
+}
+
+// MockInvitationRepositoryInterface is a mock of InvitationRepositoryInterface interface.
+type MockInvitationRepositoryInterface struct {
+	ctrl     *gomock.Controller
+	recorder *MockInvitationRepositoryInterfaceMockRecorder
+}
+
+// MockInvitationRepositoryInterfaceMockRecorder is the mock recorder for MockInvitationRepositoryInterface.
+type MockInvitationRepositoryInterfaceMockRecorder struct {
+	mock *MockInvitationRepositoryInterface
+}
+
+// NewMockInvitationRepositoryInterface creates a new mock instance.
+func NewMockInvitationRepositoryInterface(ctrl *gomock.Controller) *MockInvitationRepositoryInterface {
+	mock := &MockInvitationRepositoryInterface{ctrl: ctrl}
+	mock.recorder = &MockInvitationRepositoryInterfaceMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockInvitationRepositoryInterface) EXPECT() *MockInvitationRepositoryInterfaceMockRecorder {
+	return m.recorder
+}
+
+// Delete mocks base method.
+func (m *MockInvitationRepositoryInterface) Delete(ctx context.Context, id uuid.UUID) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Delete", ctx, id)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Delete indicates an expected call of Delete.
+func (mr *MockInvitationRepositoryInterfaceMockRecorder) Delete(ctx, id interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Delete", reflect.TypeOf((*MockInvitationRepositoryInterface)(nil).Delete), ctx, id)
+}
+
+// FindById mocks base method.
+func (m *MockInvitationRepositoryInterface) FindById(ctx context.Context, id uuid.UUID) (*Invitation, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindById", ctx, id)
+	ret0, _ := ret[0].(*Invitation)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindById indicates an expected call of FindById.
+func (mr *MockInvitationRepositoryInterfaceMockRecorder) FindById(ctx, id interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindById", reflect.TypeOf((*MockInvitationRepositoryInterface)(nil).FindById), ctx, id)
+}
+
+// Save mocks base method.
+func (m *MockInvitationRepositoryInterface) Save(ctx context.Context, invitation Invitation) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Save", ctx, invitation)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Save indicates an expected call of Save.
+func (mr *MockInvitationRepositoryInterfaceMockRecorder) Save(ctx, invitation interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Save", reflect.TypeOf((*MockInvitationRepositoryInterface)(nil).Save), ctx, invitation)
+}
+
+// MockInvitationSignerInterface is a mock of InvitationSignerInterface interface.
+type MockInvitationSignerInterface struct {
+	ctrl     *gomock.Controller
+	recorder *MockInvitationSignerInterfaceMockRecorder
+}
+
+// MockInvitationSignerInterfaceMockRecorder is the mock recorder for MockInvitationSignerInterface.
+type MockInvitationSignerInterfaceMockRecorder struct {
+	mock *MockInvitationSignerInterface
+}
+
+// NewMockInvitationSignerInterface creates a new mock instance.
+func NewMockInvitationSignerInterface(ctrl *gomock.Controller) *MockInvitationSignerInterface {
+	mock := &MockInvitationSignerInterface{ctrl: ctrl}
+	mock.recorder = &MockInvitationSignerInterfaceMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockInvitationSignerInterface) EXPECT() *MockInvitationSignerInterfaceMockRecorder {
+	return m.recorder
+}
+
+// Sign mocks base method.
+func (m *MockInvitationSignerInterface) Sign(invitation Invitation) (string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Sign", invitation)
+	ret0, _ := ret[0].(string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Sign indicates an expected call of Sign.
+func (mr *MockInvitationSignerInterfaceMockRecorder) Sign(invitation interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Sign", reflect.TypeOf((*MockInvitationSignerInterface)(nil).Sign), invitation)
+}
+
+// Verify mocks base method.
+func (m *MockInvitationSignerInterface) Verify(token string) (uuid.UUID, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Verify", token)
+	ret0, _ := ret[0].(uuid.UUID)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Verify indicates an expected call of Verify.
+func (mr *MockInvitationSignerInterfaceMockRecorder) Verify(token interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Verify", reflect.TypeOf((*MockInvitationSignerInterface)(nil).Verify), token)
+}
+
+// MockAPIKeyRepositoryInterface is a mock of APIKeyRepositoryInterface interface.
+type MockAPIKeyRepositoryInterface struct {
+	ctrl     *gomock.Controller
+	recorder *MockAPIKeyRepositoryInterfaceMockRecorder
+}
+
+// MockAPIKeyRepositoryInterfaceMockRecorder is the mock recorder for MockAPIKeyRepositoryInterface.
+type MockAPIKeyRepositoryInterfaceMockRecorder struct {
+	mock *MockAPIKeyRepositoryInterface
+}
+
+// NewMockAPIKeyRepositoryInterface creates a new mock instance.
+func NewMockAPIKeyRepositoryInterface(ctrl *gomock.Controller) *MockAPIKeyRepositoryInterface {
+	mock := &MockAPIKeyRepositoryInterface{ctrl: ctrl}
+	mock.recorder = &MockAPIKeyRepositoryInterfaceMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockAPIKeyRepositoryInterface) EXPECT() *MockAPIKeyRepositoryInterfaceMockRecorder {
+	return m.recorder
+}
+
+// FindByPrefix mocks base method.
+func (m *MockAPIKeyRepositoryInterface) FindByPrefix(ctx context.Context, prefix string) (*APIKey, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindByPrefix", ctx, prefix)
+	ret0, _ := ret[0].(*APIKey)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindByPrefix indicates an expected call of FindByPrefix.
+func (mr *MockAPIKeyRepositoryInterfaceMockRecorder) FindByPrefix(ctx, prefix interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindByPrefix", reflect.TypeOf((*MockAPIKeyRepositoryInterface)(nil).FindByPrefix), ctx, prefix)
+}
+
+// FindByUser mocks base method.
+func (m *MockAPIKeyRepositoryInterface) FindByUser(ctx context.Context, userID uuid.UUID) ([]APIKey, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindByUser", ctx, userID)
+	ret0, _ := ret[0].([]APIKey)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindByUser indicates an expected call of FindByUser.
+func (mr *MockAPIKeyRepositoryInterfaceMockRecorder) FindByUser(ctx, userID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindByUser", reflect.TypeOf((*MockAPIKeyRepositoryInterface)(nil).FindByUser), ctx, userID)
+}
+
+// RecordUse mocks base method.
+func (m *MockAPIKeyRepositoryInterface) RecordUse(ctx context.Context, id uuid.UUID, at time.Time) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RecordUse", ctx, id, at)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// RecordUse indicates an expected call of RecordUse.
+func (mr *MockAPIKeyRepositoryInterfaceMockRecorder) RecordUse(ctx, id, at interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RecordUse", reflect.TypeOf((*MockAPIKeyRepositoryInterface)(nil).RecordUse), ctx, id, at)
+}
+
+// Revoke mocks base method.
+func (m *MockAPIKeyRepositoryInterface) Revoke(ctx context.Context, id, userID uuid.UUID, at time.Time) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Revoke", ctx, id, userID, at)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Revoke indicates an expected call of Revoke.
+func (mr *MockAPIKeyRepositoryInterfaceMockRecorder) Revoke(ctx, id, userID, at interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Revoke", reflect.TypeOf((*MockAPIKeyRepositoryInterface)(nil).Revoke), ctx, id, userID, at)
+}
+
+// Save mocks base method.
+func (m *MockAPIKeyRepositoryInterface) Save(ctx context.Context, key APIKey) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Save", ctx, key)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Save indicates an expected call of Save.
+func (mr *MockAPIKeyRepositoryInterfaceMockRecorder) Save(ctx, key interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Save", reflect.TypeOf((*MockAPIKeyRepositoryInterface)(nil).Save), ctx, key)
+}
+
+// MockSessionRepositoryInterface is a mock of SessionRepositoryInterface interface.
+type MockSessionRepositoryInterface struct {
+	ctrl     *gomock.Controller
+	recorder *MockSessionRepositoryInterfaceMockRecorder
+}
+
+// MockSessionRepositoryInterfaceMockRecorder is the mock recorder for MockSessionRepositoryInterface.
+type MockSessionRepositoryInterfaceMockRecorder struct {
+	mock *MockSessionRepositoryInterface
+}
+
+// NewMockSessionRepositoryInterface creates a new mock instance.
+func NewMockSessionRepositoryInterface(ctrl *gomock.Controller) *MockSessionRepositoryInterface {
+	mock := &MockSessionRepositoryInterface{ctrl: ctrl}
+	mock.recorder = &MockSessionRepositoryInterfaceMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockSessionRepositoryInterface) EXPECT() *MockSessionRepositoryInterfaceMockRecorder {
+	return m.recorder
+}
+
+// FindActiveByUser mocks base method.
+func (m *MockSessionRepositoryInterface) FindActiveByUser(ctx context.Context, userID uuid.UUID, at time.Time) ([]Session, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindActiveByUser", ctx, userID, at)
+	ret0, _ := ret[0].([]Session)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindActiveByUser indicates an expected call of FindActiveByUser.
+func (mr *MockSessionRepositoryInterfaceMockRecorder) FindActiveByUser(ctx, userID, at interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindActiveByUser", reflect.TypeOf((*MockSessionRepositoryInterface)(nil).FindActiveByUser), ctx, userID, at)
+}
+
+// FindById mocks base method.
+func (m *MockSessionRepositoryInterface) FindById(ctx context.Context, id uuid.UUID) (*Session, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindById", ctx, id)
+	ret0, _ := ret[0].(*Session)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindById indicates an expected call of FindById.
+func (mr *MockSessionRepositoryInterfaceMockRecorder) FindById(ctx, id interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindById", reflect.TypeOf((*MockSessionRepositoryInterface)(nil).FindById), ctx, id)
+}
+
+// Revoke mocks base method.
+func (m *MockSessionRepositoryInterface) Revoke(ctx context.Context, id, userID uuid.UUID, at time.Time) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Revoke", ctx, id, userID, at)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Revoke indicates an expected call of Revoke.
+func (mr *MockSessionRepositoryInterfaceMockRecorder) Revoke(ctx, id, userID, at interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Revoke", reflect.TypeOf((*MockSessionRepositoryInterface)(nil).Revoke), ctx, id, userID, at)
+}
+
+// RevokeByUser mocks base method.
+func (m *MockSessionRepositoryInterface) RevokeByUser(ctx context.Context, userID uuid.UUID, at time.Time) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RevokeByUser", ctx, userID, at)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// RevokeByUser indicates an expected call of RevokeByUser.
+func (mr *MockSessionRepositoryInterfaceMockRecorder) RevokeByUser(ctx, userID, at interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RevokeByUser", reflect.TypeOf((*MockSessionRepositoryInterface)(nil).RevokeByUser), ctx, userID, at)
+}
+
+// Save mocks base method.
+func (m *MockSessionRepositoryInterface) Save(ctx context.Context, session Session) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Save", ctx, session)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Save indicates an expected call of Save.
+func (mr *MockSessionRepositoryInterfaceMockRecorder) Save(ctx, session interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Save", reflect.TypeOf((*MockSessionRepositoryInterface)(nil).Save), ctx, session)
+}
+
+// Touch mocks base method.
+func (m *MockSessionRepositoryInterface) Touch(ctx context.Context, id uuid.UUID, at time.Time) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Touch", ctx, id, at)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Touch indicates an expected call of Touch.
+func (mr *MockSessionRepositoryInterfaceMockRecorder) Touch(ctx, id, at interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Touch", reflect.TypeOf((*MockSessionRepositoryInterface)(nil).Touch), ctx, id, at)
+}
+
+// MockRoleRepositoryInterface is a mock of RoleRepositoryInterface interface.
+type MockRoleRepositoryInterface struct {
+	ctrl     *gomock.Controller
+	recorder *MockRoleRepositoryInterfaceMockRecorder
+}
+
+// MockRoleRepositoryInterfaceMockRecorder is the mock recorder for MockRoleRepositoryInterface.
+type MockRoleRepositoryInterfaceMockRecorder struct {
+	mock *MockRoleRepositoryInterface
+}
+
+// NewMockRoleRepositoryInterface creates a new mock instance.
+func NewMockRoleRepositoryInterface(ctrl *gomock.Controller) *MockRoleRepositoryInterface {
+	mock := &MockRoleRepositoryInterface{ctrl: ctrl}
+	mock.recorder = &MockRoleRepositoryInterfaceMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockRoleRepositoryInterface) EXPECT() *MockRoleRepositoryInterfaceMockRecorder {
+	return m.recorder
+}
+
+// AssignToUser mocks base method.
+func (m *MockRoleRepositoryInterface) AssignToUser(ctx context.Context, userID uuid.UUID, name string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AssignToUser", ctx, userID, name)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// AssignToUser indicates an expected call of AssignToUser.
+func (mr *MockRoleRepositoryInterfaceMockRecorder) AssignToUser(ctx, userID, name interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AssignToUser", reflect.TypeOf((*MockRoleRepositoryInterface)(nil).AssignToUser), ctx, userID, name)
+}
+
+// FindByName mocks base method.
+func (m *MockRoleRepositoryInterface) FindByName(ctx context.Context, name string) (*Role, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindByName", ctx, name)
+	ret0, _ := ret[0].(*Role)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindByName indicates an expected call of FindByName.
+func (mr *MockRoleRepositoryInterfaceMockRecorder) FindByName(ctx, name interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindByName", reflect.TypeOf((*MockRoleRepositoryInterface)(nil).FindByName), ctx, name)
+}
+
+// FindByUserId mocks base method.
+func (m *MockRoleRepositoryInterface) FindByUserId(ctx context.Context, userID uuid.UUID) ([]Role, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindByUserId", ctx, userID)
+	ret0, _ := ret[0].([]Role)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindByUserId indicates an expected call of FindByUserId.
+func (mr *MockRoleRepositoryInterfaceMockRecorder) FindByUserId(ctx, userID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindByUserId", reflect.TypeOf((*MockRoleRepositoryInterface)(nil).FindByUserId), ctx, userID)
+}
+
+// RevokeFromUser mocks base method.
+func (m *MockRoleRepositoryInterface) RevokeFromUser(ctx context.Context, userID uuid.UUID, name string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RevokeFromUser", ctx, userID, name)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// RevokeFromUser indicates an expected call of RevokeFromUser.
+func (mr *MockRoleRepositoryInterfaceMockRecorder) RevokeFromUser(ctx, userID, name interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RevokeFromUser", reflect.TypeOf((*MockRoleRepositoryInterface)(nil).RevokeFromUser), ctx, userID, name)
+}
+
+// Save mocks base method.
+func (m *MockRoleRepositoryInterface) Save(ctx context.Context, role Role) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Save", ctx, role)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Save indicates an expected call of Save.
+func (mr *MockRoleRepositoryInterfaceMockRecorder) Save(ctx, role interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Save", reflect.TypeOf((*MockRoleRepositoryInterface)(nil).Save), ctx, role)
+}
+
+// MockTransactionManagerInterface is a mock of TransactionManagerInterface interface.
+type MockTransactionManagerInterface struct {
+	ctrl     *gomock.Controller
+	recorder *MockTransactionManagerInterfaceMockRecorder
+}
+
+// MockTransactionManagerInterfaceMockRecorder is the mock recorder for MockTransactionManagerInterface.
+type MockTransactionManagerInterfaceMockRecorder struct {
+	mock *MockTransactionManagerInterface
+}
+
+// NewMockTransactionManagerInterface creates a new mock instance.
+func NewMockTransactionManagerInterface(ctrl *gomock.Controller) *MockTransactionManagerInterface {
+	mock := &MockTransactionManagerInterface{ctrl: ctrl}
+	mock.recorder = &MockTransactionManagerInterfaceMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockTransactionManagerInterface) EXPECT() *MockTransactionManagerInterfaceMockRecorder {
+	return m.recorder
+}
+
+// Do mocks base method.
+func (m *MockTransactionManagerInterface) Do(ctx context.Context, fn func(context.Context) error) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Do", ctx, fn)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Do indicates an expected call of Do.
+func (mr *MockTransactionManagerInterfaceMockRecorder) Do(ctx, fn interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Do", reflect.TypeOf((*MockTransactionManagerInterface)(nil).Do), ctx, fn)
+}
+
+// MockMailerInterface is a mock of MailerInterface interface.
+type MockMailerInterface struct {
+	ctrl     *gomock.Controller
+	recorder *MockMailerInterfaceMockRecorder
+}
+
+// MockMailerInterfaceMockRecorder is the mock recorder for MockMailerInterface.
+type MockMailerInterfaceMockRecorder struct {
+	mock *MockMailerInterface
+}
+
+// NewMockMailerInterface creates a new mock instance.
+func NewMockMailerInterface(ctrl *gomock.Controller) *MockMailerInterface {
+	mock := &MockMailerInterface{ctrl: ctrl}
+	mock.recorder = &MockMailerInterfaceMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockMailerInterface) EXPECT() *MockMailerInterfaceMockRecorder {
+	return m.recorder
+}
+
+// Send mocks base method.
+func (m *MockMailerInterface) Send(ctx context.Context, to, subject, body string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Send", ctx, to, subject, body)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Send indicates an expected call of Send.
+func (mr *MockMailerInterfaceMockRecorder) Send(ctx, to, subject, body interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Send", reflect.TypeOf((*MockMailerInterface)(nil).Send), ctx, to, subject, body)
+}
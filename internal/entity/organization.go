@@ -0,0 +1,120 @@
+package entity
+
+import (
+	"errors"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+var (
+	ErrOrganizationInvalidName = errors.New("invalid name")
+	ErrOrganizationInvalidRole = errors.New("invalid role")
+)
+
+const organizationNameMaxLen = 100
+
+// OrganizationRole is the role of a member within an organization, unrelated to the roles of Role.
+type OrganizationRole string
+
+const (
+	OrganizationRoleOwner  OrganizationRole = "owner"
+	OrganizationRoleAdmin  OrganizationRole = "admin"
+	OrganizationRoleMember OrganizationRole = "member"
+)
+
+func (r OrganizationRole) IsValid() bool {
+	return r == OrganizationRoleOwner || r == OrganizationRoleAdmin || r == OrganizationRoleMember
+}
+
+// CanManage tells whether a member with the role can invite, remove or change the role of a member
+// with the other role, or grant it. Owners manage everyone, admins manage admins and members.
+func (r OrganizationRole) CanManage(other OrganizationRole) bool {
+	switch r {
+	case OrganizationRoleOwner:
+		return true
+	case OrganizationRoleAdmin:
+		return other != OrganizationRoleOwner
+	default:
+		return false
+	}
+}
+
+// Organization is a team of users within a tenant.
+type Organization struct {
+	ID        uuid.UUID
+	TenantID  uuid.UUID
+	Name      string
+	CreatedAt time.Time
+}
+
+func NewOrganization(name string) (*Organization, error) {
+	name = strings.TrimSpace(name)
+	if name == "" || len(name) > organizationNameMaxLen {
+		return nil, ErrOrganizationInvalidName
+	}
+
+	id, err := uuid.NewRandom()
+	if err != nil {
+		return nil, err
+	}
+
+	return &Organization{ID: id, Name: name, CreatedAt: Now()}, nil
+}
+
+type Membership struct {
+	OrganizationID uuid.UUID
+	UserID         uuid.UUID
+	Role           OrganizationRole
+	CreatedAt      time.Time
+}
+
+// Invitation lets the user with Email join an organization with Role until ExpiresAt.
+type Invitation struct {
+	ID             uuid.UUID
+	OrganizationID uuid.UUID
+	Email          string
+	Role           OrganizationRole
+	InvitedBy      uuid.UUID
+	ExpiresAt      time.Time
+	CreatedAt      time.Time
+}
+
+func NewInvitation(organizationID uuid.UUID, email string, role OrganizationRole, invitedBy uuid.UUID, ttl time.Duration) (*Invitation, error) {
+	email, err := NormalizeEmail(email)
+	if err != nil {
+		return nil, ErrUserInvalidEmail
+	}
+
+	if !role.IsValid() {
+		return nil, ErrOrganizationInvalidRole
+	}
+
+	id, err := uuid.NewRandom()
+	if err != nil {
+		return nil, err
+	}
+
+	now := Now()
+	invitation := &Invitation{
+		ID:             id,
+		OrganizationID: organizationID,
+		Email:          email,
+		Role:           role,
+		InvitedBy:      invitedBy,
+		ExpiresAt:      now.Add(ttl),
+		CreatedAt:      now,
+	}
+
+	return invitation, nil
+}
+
+// IsFor tells whether the invitation was sent to email.
+func (i *Invitation) IsFor(email string) bool {
+	return EmailLookupKey(i.Email) == EmailLookupKey(email)
+}
+
+func (i *Invitation) IsExpired(at time.Time) bool {
+	return !at.Before(i.ExpiresAt)
+}
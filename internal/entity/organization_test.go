@@ -0,0 +1,62 @@
+package entity
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+)
+
+func Test_OrganizationRole_CanManage(t *testing.T) {
+	roles := []OrganizationRole{OrganizationRoleOwner, OrganizationRoleAdmin, OrganizationRoleMember}
+	for _, role := range roles {
+		assert.True(t, role.IsValid())
+		assert.True(t, OrganizationRoleOwner.CanManage(role))
+		assert.False(t, OrganizationRoleMember.CanManage(role))
+	}
+
+	assert.False(t, OrganizationRoleAdmin.CanManage(OrganizationRoleOwner))
+	assert.True(t, OrganizationRoleAdmin.CanManage(OrganizationRoleAdmin))
+	assert.True(t, OrganizationRoleAdmin.CanManage(OrganizationRoleMember))
+	assert.False(t, OrganizationRole("guest").IsValid())
+}
+
+func Test_NewOrganization(t *testing.T) {
+	organization, err := NewOrganization(" Team ")
+	assert.Nil(t, err)
+	assert.NotEqual(t, uuid.Nil, organization.ID)
+	assert.Equal(t, "Team", organization.Name)
+	assert.False(t, organization.CreatedAt.IsZero())
+
+	for _, name := range []string{"", " ", strings.Repeat("a", 101)} {
+		_, err = NewOrganization(name)
+		assert.ErrorIs(t, err, ErrOrganizationInvalidName)
+	}
+}
+
+func Test_NewInvitation(t *testing.T) {
+	organizationID := uuid.New()
+	invitedBy := uuid.New()
+
+	invitation, err := NewInvitation(organizationID, "User@Mail.com", OrganizationRoleAdmin, invitedBy, time.Hour)
+	assert.Nil(t, err)
+	assert.NotEqual(t, uuid.Nil, invitation.ID)
+	assert.Equal(t, organizationID, invitation.OrganizationID)
+	assert.Equal(t, "User@mail.com", invitation.Email)
+	assert.Equal(t, OrganizationRoleAdmin, invitation.Role)
+	assert.Equal(t, invitedBy, invitation.InvitedBy)
+	assert.Equal(t, invitation.CreatedAt.Add(time.Hour), invitation.ExpiresAt)
+
+	assert.True(t, invitation.IsFor("user@MAIL.com"))
+	assert.False(t, invitation.IsFor("other@mail.com"))
+	assert.False(t, invitation.IsExpired(invitation.CreatedAt))
+	assert.True(t, invitation.IsExpired(invitation.ExpiresAt))
+
+	_, err = NewInvitation(organizationID, "user", OrganizationRoleMember, invitedBy, time.Hour)
+	assert.ErrorIs(t, err, ErrUserInvalidEmail)
+
+	_, err = NewInvitation(organizationID, "user@mail.com", "guest", invitedBy, time.Hour)
+	assert.ErrorIs(t, err, ErrOrganizationInvalidRole)
+}
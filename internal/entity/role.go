@@ -0,0 +1,37 @@
+package entity
+
+import "sort"
+
+const PermissionUsersAdmin = "users:admin"
+
+type Role struct {
+	Name        string
+	Description string
+	Permissions []string
+}
+
+// RoleNames returns the names of roles in order.
+func RoleNames(roles []Role) []string {
+	names := make([]string, 0, len(roles))
+	for _, role := range roles {
+		names = append(names, role.Name)
+	}
+	sort.Strings(names)
+	return names
+}
+
+// Permissions returns the permissions granted by any of roles, sorted and without duplicates.
+func Permissions(roles []Role) []string {
+	seen := make(map[string]struct{})
+	permissions := make([]string, 0)
+	for _, role := range roles {
+		for _, permission := range role.Permissions {
+			if _, ok := seen[permission]; !ok {
+				seen[permission] = struct{}{}
+				permissions = append(permissions, permission)
+			}
+		}
+	}
+	sort.Strings(permissions)
+	return permissions
+}
@@ -0,0 +1,22 @@
+package entity
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func Test_Role_RoleNames(t *testing.T) {
+	roles := []Role{{Name: "support"}, {Name: "admin"}}
+	assert.Equal(t, []string{"admin", "support"}, RoleNames(roles))
+	assert.Equal(t, []string{}, RoleNames(nil))
+}
+
+func Test_Role_Permissions(t *testing.T) {
+	roles := []Role{
+		{Name: "support", Permissions: []string{"users:read"}},
+		{Name: "admin", Permissions: []string{"users:read", PermissionUsersAdmin}},
+	}
+	assert.Equal(t, []string{PermissionUsersAdmin, "users:read"}, Permissions(roles))
+	assert.Equal(t, []string{}, Permissions(nil))
+}
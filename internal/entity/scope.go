@@ -0,0 +1,41 @@
+package entity
+
+import (
+	"errors"
+	"sort"
+	"strings"
+)
+
+// Scopes limit what a token may be used for, whatever the roles of its user.
+const (
+	ScopeProfileRead  = "profile:read"
+	ScopeProfileWrite = "profile:write"
+)
+
+var ErrInvalidScope = errors.New("invalid scope")
+
+var scopes = []string{ScopeProfileRead, ScopeProfileWrite}
+
+// ParseScope reads a space separated list of scopes, sorted and without duplicates.
+// An empty list stands for every scope.
+func ParseScope(scope string) ([]string, error) {
+	fields := strings.Fields(scope)
+	if len(fields) == 0 {
+		return append([]string(nil), scopes...), nil
+	}
+
+	seen := make(map[string]struct{}, len(fields))
+	parsed := make([]string, 0, len(fields))
+	for _, field := range fields {
+		i := sort.SearchStrings(scopes, field)
+		if i == len(scopes) || scopes[i] != field {
+			return nil, ErrInvalidScope
+		}
+		if _, ok := seen[field]; !ok {
+			seen[field] = struct{}{}
+			parsed = append(parsed, field)
+		}
+	}
+	sort.Strings(parsed)
+	return parsed, nil
+}
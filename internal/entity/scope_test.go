@@ -0,0 +1,25 @@
+package entity
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func Test_ParseScope(t *testing.T) {
+	parsed, err := ParseScope("")
+	assert.Nil(t, err)
+	assert.Equal(t, []string{ScopeProfileRead, ScopeProfileWrite}, parsed)
+
+	parsed, err = ParseScope(" profile:write  profile:read profile:write ")
+	assert.Nil(t, err)
+	assert.Equal(t, []string{ScopeProfileRead, ScopeProfileWrite}, parsed)
+
+	parsed, err = ParseScope(ScopeProfileRead)
+	assert.Nil(t, err)
+	assert.Equal(t, []string{ScopeProfileRead}, parsed)
+
+	parsed, err = ParseScope("profile:read users:admin")
+	assert.Nil(t, parsed)
+	assert.Equal(t, ErrInvalidScope, err)
+}
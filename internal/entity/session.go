@@ -0,0 +1,56 @@
+package entity
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+const sessionUserAgentMaxLen = 255
+
+// SessionTouchInterval is how stale the last seen time of a session gets before it is recorded again,
+// which spares a write on every request.
+const SessionTouchInterval = time.Minute
+
+// Session is a login of a user from a device. It is the family of the tokens issued from that login,
+// which carry its ID in the "sid" claim, and it expires along with them.
+type Session struct {
+	ID         uuid.UUID
+	UserID     uuid.UUID
+	UserAgent  string
+	IP         string
+	ExpiresAt  time.Time
+	LastSeenAt time.Time
+	RevokedAt  *time.Time
+	CreatedAt  time.Time
+}
+
+func NewSession(userID uuid.UUID, userAgent string, ip string, ttl time.Duration) (*Session, error) {
+	id, err := uuid.NewRandom()
+	if err != nil {
+		return nil, err
+	}
+
+	if runes := []rune(userAgent); len(runes) > sessionUserAgentMaxLen {
+		userAgent = string(runes[:sessionUserAgentMaxLen])
+	}
+
+	now := Now()
+	return &Session{
+		ID:         id,
+		UserID:     userID,
+		UserAgent:  userAgent,
+		IP:         ip,
+		ExpiresAt:  now.Add(ttl),
+		LastSeenAt: now,
+		CreatedAt:  now,
+	}, nil
+}
+
+func (s *Session) IsActive(at time.Time) bool {
+	return s.RevokedAt == nil && at.Before(s.ExpiresAt)
+}
+
+func (s *Session) NeedsTouch(at time.Time) bool {
+	return at.Sub(s.LastSeenAt) >= SessionTouchInterval
+}
@@ -0,0 +1,49 @@
+package entity
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func Test_NewSession(t *testing.T) {
+	userID := uuid.New()
+
+	session, err := NewSession(userID, "curl/8.0", "127.0.0.1", time.Hour)
+	require.Nil(t, err)
+	assert.NotEqual(t, uuid.Nil, session.ID)
+	assert.Equal(t, userID, session.UserID)
+	assert.Equal(t, "curl/8.0", session.UserAgent)
+	assert.Equal(t, "127.0.0.1", session.IP)
+	assert.Equal(t, session.CreatedAt, session.LastSeenAt)
+	assert.Equal(t, session.CreatedAt.Add(time.Hour), session.ExpiresAt)
+	assert.Nil(t, session.RevokedAt)
+
+	session, err = NewSession(userID, strings.Repeat("é", 300), "", time.Hour)
+	require.Nil(t, err)
+	assert.Equal(t, strings.Repeat("é", 255), session.UserAgent)
+}
+
+func Test_Session_IsActive(t *testing.T) {
+	session, err := NewSession(uuid.New(), "", "", time.Hour)
+	require.Nil(t, err)
+
+	assert.True(t, session.IsActive(session.CreatedAt))
+	assert.False(t, session.IsActive(session.ExpiresAt))
+
+	at := session.CreatedAt
+	session.RevokedAt = &at
+	assert.False(t, session.IsActive(session.CreatedAt))
+}
+
+func Test_Session_NeedsTouch(t *testing.T) {
+	session, err := NewSession(uuid.New(), "", "", time.Hour)
+	require.Nil(t, err)
+
+	assert.False(t, session.NeedsTouch(session.LastSeenAt.Add(SessionTouchInterval-time.Second)))
+	assert.True(t, session.NeedsTouch(session.LastSeenAt.Add(SessionTouchInterval)))
+}
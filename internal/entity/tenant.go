@@ -0,0 +1,75 @@
+package entity
+
+import (
+	"context"
+	"errors"
+	"regexp"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// DefaultTenantID is the tenant created by the migrations. Requests that name no tenant, and the
+// users that existed before tenants, belong to it.
+var DefaultTenantID = uuid.MustParse("00000000-0000-0000-0000-000000000001")
+
+var (
+	ErrTenantInvalidSlug   = errors.New("invalid slug")
+	ErrTenantInvalidName   = errors.New("invalid name")
+	ErrTenantAlreadyExists = errors.New("tenant already exists")
+
+	slugPattern = regexp.MustCompile(`^[a-z0-9]([a-z0-9-]{0,61}[a-z0-9])?$`)
+)
+
+const tenantNameMaxLen = 100
+
+// Tenant is a workspace with its own users. Host, when set, is the request host that selects it.
+type Tenant struct {
+	ID        uuid.UUID
+	Slug      string
+	Name      string
+	Host      string
+	CreatedAt time.Time
+}
+
+func NewTenant(slug string, name string, host string) (*Tenant, error) {
+	if !slugPattern.MatchString(slug) {
+		return nil, ErrTenantInvalidSlug
+	}
+
+	name = strings.TrimSpace(name)
+	if name == "" || len(name) > tenantNameMaxLen {
+		return nil, ErrTenantInvalidName
+	}
+
+	id, err := uuid.NewRandom()
+	if err != nil {
+		return nil, err
+	}
+
+	tenant := &Tenant{
+		ID:        id,
+		Slug:      slug,
+		Name:      name,
+		Host:      strings.ToLower(strings.TrimSpace(host)),
+		CreatedAt: Now(),
+	}
+
+	return tenant, nil
+}
+
+type tenantKey struct{}
+
+// WithTenant returns a copy of ctx scoped to the tenant with the given id.
+func WithTenant(ctx context.Context, id uuid.UUID) context.Context {
+	return context.WithValue(ctx, tenantKey{}, id)
+}
+
+// TenantFromContext returns the tenant ctx is scoped to, or DefaultTenantID.
+func TenantFromContext(ctx context.Context) uuid.UUID {
+	if id, ok := ctx.Value(tenantKey{}).(uuid.UUID); ok {
+		return id
+	}
+	return DefaultTenantID
+}
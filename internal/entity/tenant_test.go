@@ -0,0 +1,40 @@
+package entity
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+)
+
+func Test_NewTenant(t *testing.T) {
+	tenant, err := NewTenant("acme", " Acme Inc ", "Auth.Acme.com")
+	assert.Nil(t, err)
+	assert.NotEqual(t, uuid.Nil, tenant.ID)
+	assert.Equal(t, "acme", tenant.Slug)
+	assert.Equal(t, "Acme Inc", tenant.Name)
+	assert.Equal(t, "auth.acme.com", tenant.Host)
+	assert.False(t, tenant.CreatedAt.IsZero())
+}
+
+func Test_NewTenant_WhenDataIsInvalid(t *testing.T) {
+	for _, slug := range []string{"", "Acme", "-acme", "acme-", "ac_me", strings.Repeat("a", 64)} {
+		_, err := NewTenant(slug, "Acme", "")
+		assert.ErrorIs(t, err, ErrTenantInvalidSlug, slug)
+	}
+
+	for _, name := range []string{"", "  ", strings.Repeat("a", 101)} {
+		_, err := NewTenant("acme", name, "")
+		assert.ErrorIs(t, err, ErrTenantInvalidName, name)
+	}
+}
+
+func Test_TenantFromContext(t *testing.T) {
+	ctx := context.Background()
+	assert.Equal(t, DefaultTenantID, TenantFromContext(ctx))
+
+	id := uuid.New()
+	assert.Equal(t, id, TenantFromContext(WithTenant(ctx, id)))
+}
@@ -3,6 +3,8 @@ package entity
 import (
 	"errors"
 	"regexp"
+	"sync"
+	"time"
 
 	"github.com/google/uuid"
 	"golang.org/x/crypto/bcrypt"
@@ -13,12 +15,54 @@ var (
 	ErrUserInvalidEmail    = errors.New("invalid email")
 	ErrUserInvalidPassword = errors.New("invalid password")
 
-	emailPattern    = regexp.MustCompile(`^[a-zA-Z0-9._]+@[a-zA-Z0-9.-]+?\.[a-zA-Z]{2,}$`)
 	passwordPattern = regexp.MustCompile(`\$2[ayb]\$.{56}$`)
 )
 
 const passwordMinLen = 5
 
+type UserStatus string
+
+const (
+	UserStatusActive              UserStatus = "active"
+	UserStatusSuspended           UserStatus = "suspended"
+	UserStatusLocked              UserStatus = "locked"
+	UserStatusPendingVerification UserStatus = "pending_verification"
+)
+
+var (
+	ErrUserSuspended           = errors.New("account suspended")
+	ErrUserLocked              = errors.New("account locked")
+	ErrUserPendingVerification = errors.New("account pending verification")
+)
+
+func (s UserStatus) IsValid() bool {
+	switch s {
+	case UserStatusActive, UserStatusSuspended, UserStatusLocked, UserStatusPendingVerification:
+		return true
+	default:
+		return false
+	}
+}
+
+// Err returns why a user with the status cannot authenticate, or nil when it is active.
+func (s UserStatus) Err() error {
+	switch s {
+	case UserStatusActive:
+		return nil
+	case UserStatusLocked:
+		return ErrUserLocked
+	case UserStatusPendingVerification:
+		return ErrUserPendingVerification
+	default:
+		return ErrUserSuspended
+	}
+}
+
+var (
+	dummyPasswordHash     []byte
+	dummyPasswordHashOnce sync.Once
+)
+
 type UserFactory struct{}
 
 func NewUserFactory() *UserFactory {
@@ -31,7 +75,8 @@ func (f *UserFactory) NewUser(email string, password string) (*User, error) {
 		return nil, err
 	}
 
-	if !emailPattern.MatchString(email) {
+	email, err = NormalizeEmail(email)
+	if err != nil {
 		return nil, ErrUserInvalidEmail
 	}
 
@@ -44,10 +89,16 @@ func (f *UserFactory) NewUser(email string, password string) (*User, error) {
 		return nil, err
 	}
 
+	now := Now()
 	user := &User{
-		ID:       id,
-		Email:    email,
-		Password: string(hash),
+		ID:                id,
+		Email:             email,
+		Password:          string(hash),
+		CreatedAt:         now,
+		UpdatedAt:         now,
+		PasswordChangedAt: now,
+		Status:            UserStatusActive,
+		Version:           1,
 	}
 
 	return user, nil
@@ -59,7 +110,8 @@ func (f *UserFactory) GetUser(id string, email string, password string) (*User,
 		return nil, ErrUserInvalidID
 	}
 
-	if !emailPattern.MatchString(email) {
+	email, err = NormalizeEmail(email)
+	if err != nil {
 		return nil, ErrUserInvalidEmail
 	}
 
@@ -82,16 +134,25 @@ func (f *UserFactory) GetUser(id string, email string, password string) (*User,
 }
 
 type User struct {
-	ID       uuid.UUID
-	Email    string
-	Password string
+	ID                uuid.UUID
+	TenantID          uuid.UUID
+	Email             string
+	Password          string
+	CreatedAt         time.Time
+	UpdatedAt         time.Time
+	PasswordChangedAt time.Time
+	LastLoginAt       *time.Time
+	LastLoginIP       string
+	DeletedAt         *time.Time
+	Status            UserStatus
+	Version           int64
 }
 
 func (u *User) Validate() error {
 	if u.ID == uuid.Nil {
 		return ErrUserInvalidID
 	}
-	if !emailPattern.MatchString(u.Email) {
+	if !IsValidEmail(u.Email) {
 		return ErrUserInvalidEmail
 	}
 	if !passwordPattern.MatchString(u.Password) {
@@ -103,3 +164,17 @@ func (u *User) Validate() error {
 func (u *User) VerifyPassword(password string) error {
 	return bcrypt.CompareHashAndPassword([]byte(u.Password), []byte(password))
 }
+
+// SimulatePasswordVerification does the same work as VerifyPassword against a fixed hash.
+// It is used when no user matches the credentials, so the response time does not reveal it.
+func SimulatePasswordVerification(password string) {
+	dummyPasswordHashOnce.Do(func() {
+		dummyPasswordHash, _ = bcrypt.GenerateFromPassword([]byte("dummy-password"), bcrypt.DefaultCost)
+	})
+	bcrypt.CompareHashAndPassword(dummyPasswordHash, []byte(password))
+}
+
+// Now returns the current time in UTC with the microsecond precision the databases keep.
+func Now() time.Time {
+	return time.Now().UTC().Truncate(time.Microsecond)
+}
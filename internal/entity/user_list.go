@@ -0,0 +1,97 @@
+package entity
+
+import (
+	"encoding/base64"
+	"errors"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+var ErrUserInvalidCursor = errors.New("invalid cursor")
+
+// UserCursor is the position of a user in the (CreatedAt, ID) order used to list users.
+type UserCursor struct {
+	CreatedAt time.Time
+	ID        uuid.UUID
+}
+
+func NewUserCursor(user User) UserCursor {
+	return UserCursor{CreatedAt: user.CreatedAt, ID: user.ID}
+}
+
+// Less orders users by creation time, then ID.
+func (c UserCursor) Less(other UserCursor) bool {
+	if !c.CreatedAt.Equal(other.CreatedAt) {
+		return c.CreatedAt.Before(other.CreatedAt)
+	}
+	return c.ID.String() < other.ID.String()
+}
+
+// String encodes the cursor as an opaque token for clients.
+func (c UserCursor) String() string {
+	raw := c.CreatedAt.UTC().Format(time.RFC3339Nano) + "|" + c.ID.String()
+	return base64.RawURLEncoding.EncodeToString([]byte(raw))
+}
+
+func ParseUserCursor(token string) (*UserCursor, error) {
+	raw, err := base64.RawURLEncoding.DecodeString(token)
+	if err != nil {
+		return nil, ErrUserInvalidCursor
+	}
+
+	createdAt, id, ok := strings.Cut(string(raw), "|")
+	if !ok {
+		return nil, ErrUserInvalidCursor
+	}
+
+	cursor := &UserCursor{}
+	if cursor.CreatedAt, err = time.Parse(time.RFC3339Nano, createdAt); err != nil {
+		return nil, ErrUserInvalidCursor
+	}
+	if cursor.ID, err = uuid.Parse(id); err != nil {
+		return nil, ErrUserInvalidCursor
+	}
+
+	return cursor, nil
+}
+
+// UserListFilter selects the users that are not deleted, whose email starts with EmailPrefix
+// (case-insensitively) and whose status is one of Statuses, when they are set. After skips
+// the users up to and including the cursor, and a positive Limit caps the page size.
+type UserListFilter struct {
+	EmailPrefix string
+	Statuses    []UserStatus
+	After       *UserCursor
+	Limit       int
+}
+
+// UserPage holds a page of users. Next is the cursor of the last one when there are more,
+// and Total counts every user matching the filter regardless of After and Limit.
+type UserPage struct {
+	Users []User
+	Next  *UserCursor
+	Total int64
+}
+
+// EmailLookupPrefix returns the prefix of email lookup keys matched by EmailPrefix.
+func (f UserListFilter) EmailLookupPrefix() string {
+	return strings.ToLower(strings.TrimSpace(f.EmailPrefix))
+}
+
+// Matches tells whether user passes the email and status filters.
+func (f UserListFilter) Matches(user User) bool {
+	if !strings.HasPrefix(EmailLookupKey(user.Email), f.EmailLookupPrefix()) {
+		return false
+	}
+	if len(f.Statuses) == 0 {
+		return true
+	}
+	for _, status := range f.Statuses {
+		if user.Status == status {
+			return true
+		}
+	}
+	return false
+}
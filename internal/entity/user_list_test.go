@@ -0,0 +1,43 @@
+package entity
+
+import (
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+)
+
+func Test_UserCursor_StringAndParse(t *testing.T) {
+	cursor := UserCursor{CreatedAt: Now(), ID: uuid.New()}
+
+	parsed, err := ParseUserCursor(cursor.String())
+	assert.Nil(t, err)
+	assert.True(t, cursor.CreatedAt.Equal(parsed.CreatedAt))
+	assert.Equal(t, cursor.ID, parsed.ID)
+
+	for _, token := range []string{"", "not base64!", "bm8tc2VwYXJhdG9y", cursor.String()[:10]} {
+		_, err = ParseUserCursor(token)
+		assert.ErrorIs(t, err, ErrUserInvalidCursor, token)
+	}
+}
+
+func Test_UserCursor_Less(t *testing.T) {
+	now := Now()
+	id1 := uuid.MustParse("00000000-0000-0000-0000-000000000001")
+	id2 := uuid.MustParse("00000000-0000-0000-0000-000000000002")
+
+	assert.True(t, UserCursor{CreatedAt: now, ID: id2}.Less(UserCursor{CreatedAt: now.Add(time.Second), ID: id1}))
+	assert.True(t, UserCursor{CreatedAt: now, ID: id1}.Less(UserCursor{CreatedAt: now, ID: id2}))
+	assert.False(t, UserCursor{CreatedAt: now, ID: id1}.Less(UserCursor{CreatedAt: now, ID: id1}))
+}
+
+func Test_UserListFilter_Matches(t *testing.T) {
+	user := User{Email: "John.Doe@Mail.com", Status: UserStatusSuspended}
+
+	assert.True(t, UserListFilter{}.Matches(user))
+	assert.True(t, UserListFilter{EmailPrefix: " JOHN.d"}.Matches(user))
+	assert.False(t, UserListFilter{EmailPrefix: "jane"}.Matches(user))
+	assert.True(t, UserListFilter{Statuses: []UserStatus{UserStatusActive, UserStatusSuspended}}.Matches(user))
+	assert.False(t, UserListFilter{Statuses: []UserStatus{UserStatusActive}}.Matches(user))
+}
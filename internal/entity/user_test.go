@@ -2,6 +2,7 @@ package entity
 
 import (
 	"testing"
+	"time"
 
 	"github.com/google/uuid"
 	"github.com/stretchr/testify/assert"
@@ -24,6 +25,16 @@ func Test_User_NewUser(t *testing.T) {
 	assert.NotEqual(t, user.ID, uuid.Nil)
 	assert.Equal(t, user.Email, email)
 	assert.Nil(t, bcrypt.CompareHashAndPassword([]byte(user.Password), []byte(password)))
+	assert.False(t, user.CreatedAt.IsZero())
+	assert.Equal(t, user.CreatedAt, user.UpdatedAt)
+	assert.Equal(t, user.CreatedAt, user.PasswordChangedAt)
+	assert.Nil(t, user.LastLoginAt)
+	assert.Equal(t, int64(1), user.Version)
+	assert.Equal(t, UserStatusActive, user.Status)
+
+	user, err = userFactory.NewUser(" user+tag@Mail.COM ", password)
+	assert.Nil(t, err)
+	assert.Equal(t, "user+tag@mail.com", user.Email)
 
 	user, err = userFactory.NewUser("user@mailcom", password)
 	assert.Nil(t, user)
@@ -62,3 +73,33 @@ func Test_User_VerifyPassword(t *testing.T) {
 	assert.Error(t, user.VerifyPassword("123456"))
 	assert.Nil(t, user.VerifyPassword("12345"))
 }
+
+func Test_User_SimulatePasswordVerification(t *testing.T) {
+	assert.NotPanics(t, func() { SimulatePasswordVerification("12345") })
+	assert.NotNil(t, dummyPasswordHash)
+	assert.Nil(t, bcrypt.CompareHashAndPassword(dummyPasswordHash, []byte("dummy-password")))
+}
+
+func Test_User_Now(t *testing.T) {
+	now := Now()
+	assert.Equal(t, time.UTC, now.Location())
+	assert.Zero(t, now.Nanosecond()%int(time.Microsecond))
+	assert.WithinDuration(t, time.Now(), now, time.Second)
+}
+
+func Test_UserStatus_IsValid(t *testing.T) {
+	assert.True(t, UserStatusActive.IsValid())
+	assert.True(t, UserStatusSuspended.IsValid())
+	assert.True(t, UserStatusLocked.IsValid())
+	assert.True(t, UserStatusPendingVerification.IsValid())
+	assert.False(t, UserStatus("").IsValid())
+	assert.False(t, UserStatus("deleted").IsValid())
+}
+
+func Test_UserStatus_Err(t *testing.T) {
+	assert.Nil(t, UserStatusActive.Err())
+	assert.ErrorIs(t, UserStatusSuspended.Err(), ErrUserSuspended)
+	assert.ErrorIs(t, UserStatusLocked.Err(), ErrUserLocked)
+	assert.ErrorIs(t, UserStatusPendingVerification.Err(), ErrUserPendingVerification)
+	assert.ErrorIs(t, UserStatus("unknown").Err(), ErrUserSuspended)
+}
@@ -0,0 +1,42 @@
+package database
+
+import (
+	"database/sql"
+	"fmt"
+	"net/url"
+
+	"github.com/sesaquecruz/go-auth-api/config"
+
+	_ "github.com/go-sql-driver/mysql"
+	_ "github.com/lib/pq"
+	_ "modernc.org/sqlite"
+)
+
+func DataSourceName(cfg *config.Config) (string, error) {
+	switch cfg.DBDriver {
+	case "mysql":
+		return fmt.Sprintf("%s:%s@tcp(%s:%s)/%s?multiStatements=true&parseTime=true&loc=UTC", cfg.DBUser, cfg.DBPassword, cfg.DBHost, cfg.DBPort, cfg.DBName), nil
+	case "postgres":
+		dsn := url.URL{
+			Scheme:   "postgres",
+			User:     url.UserPassword(cfg.DBUser, cfg.DBPassword),
+			Host:     fmt.Sprintf("%s:%s", cfg.DBHost, cfg.DBPort),
+			Path:     cfg.DBName,
+			RawQuery: url.Values{"sslmode": {cfg.DBSSLMode}}.Encode(),
+		}
+		return dsn.String(), nil
+	case "sqlite":
+		return fmt.Sprintf("%s?_pragma=foreign_keys(1)&_pragma=busy_timeout(5000)&_pragma=journal_mode(WAL)&_time_format=sqlite&_txlock=immediate", cfg.DBName), nil
+	default:
+		return "", fmt.Errorf("unsupported database driver %s", cfg.DBDriver)
+	}
+}
+
+func NewConnection(cfg *config.Config) (*sql.DB, error) {
+	dsn, err := DataSourceName(cfg)
+	if err != nil {
+		return nil, err
+	}
+
+	return sql.Open(cfg.DBDriver, dsn)
+}
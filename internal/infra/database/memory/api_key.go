@@ -0,0 +1,122 @@
+package memory
+
+import (
+	"context"
+	"database/sql"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/sesaquecruz/go-auth-api/internal/entity"
+)
+
+type storedAPIKey struct {
+	tenantID uuid.UUID
+	key      entity.APIKey
+}
+
+type APIKeyRepository struct {
+	mu   sync.RWMutex
+	keys map[uuid.UUID]storedAPIKey
+}
+
+func NewAPIKeyRepository() *APIKeyRepository {
+	return &APIKeyRepository{
+		keys: make(map[uuid.UUID]storedAPIKey),
+	}
+}
+
+// Save stores the key under the tenant of ctx, which is the tenant of its user.
+func (r *APIKeyRepository) Save(ctx context.Context, key entity.APIKey) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	for id, stored := range r.keys {
+		if id == key.ID || stored.key.Prefix == key.Prefix {
+			return ErrDuplicateKey
+		}
+	}
+
+	key.Scopes = append([]string{}, key.Scopes...)
+	r.keys[key.ID] = storedAPIKey{tenantID: entity.TenantFromContext(ctx), key: key}
+	return nil
+}
+
+func (r *APIKeyRepository) FindByPrefix(ctx context.Context, prefix string) (*entity.APIKey, error) {
+	keys := r.find(ctx, func(key entity.APIKey) bool { return key.Prefix == prefix })
+	if len(keys) == 0 {
+		return nil, sql.ErrNoRows
+	}
+
+	return &keys[0], nil
+}
+
+func (r *APIKeyRepository) FindByUser(ctx context.Context, userID uuid.UUID) ([]entity.APIKey, error) {
+	return r.find(ctx, func(key entity.APIKey) bool { return key.UserID == userID && key.RevokedAt == nil }), nil
+}
+
+func (r *APIKeyRepository) Revoke(ctx context.Context, id uuid.UUID, userID uuid.UUID, at time.Time) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	stored, ok := r.keys[id]
+	if !ok || stored.tenantID != entity.TenantFromContext(ctx) || stored.key.UserID != userID || stored.key.RevokedAt != nil {
+		return sql.ErrNoRows
+	}
+
+	stored.key.RevokedAt = &at
+	r.keys[id] = stored
+	return nil
+}
+
+func (r *APIKeyRepository) RecordUse(ctx context.Context, id uuid.UUID, at time.Time) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	stored, ok := r.keys[id]
+	if ok {
+		stored.key.LastUsedAt = &at
+		r.keys[id] = stored
+	}
+	return nil
+}
+
+func (r *APIKeyRepository) Snapshot() func() {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	keys := make(map[uuid.UUID]storedAPIKey, len(r.keys))
+	for id, stored := range r.keys {
+		keys[id] = stored
+	}
+
+	return func() {
+		r.mu.Lock()
+		defer r.mu.Unlock()
+		r.keys = keys
+	}
+}
+
+func (r *APIKeyRepository) find(ctx context.Context, match func(entity.APIKey) bool) []entity.APIKey {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	keys := make([]entity.APIKey, 0)
+	for _, stored := range r.keys {
+		if stored.tenantID == entity.TenantFromContext(ctx) && match(stored.key) {
+			key := stored.key
+			key.Scopes = append([]string{}, key.Scopes...)
+			keys = append(keys, key)
+		}
+	}
+
+	sort.Slice(keys, func(i, j int) bool {
+		if !keys[i].CreatedAt.Equal(keys[j].CreatedAt) {
+			return keys[i].CreatedAt.Before(keys[j].CreatedAt)
+		}
+		return keys[i].ID.String() < keys[j].ID.String()
+	})
+
+	return keys
+}
@@ -0,0 +1,24 @@
+package memory
+
+import (
+	"testing"
+
+	"github.com/sesaquecruz/go-auth-api/internal/entity"
+	"github.com/sesaquecruz/go-auth-api/internal/infra/database/repositorytest"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/suite"
+)
+
+func TestSuite_APIKeyRepository(t *testing.T) {
+	suite.Run(t, &repositorytest.APIKeyRepositorySuite{
+		NewRepositories: func() (entity.UserRepositoryInterface, entity.TenantRepositoryInterface, entity.APIKeyRepositoryInterface) {
+			return NewUserRepository(), NewTenantRepository(), NewAPIKeyRepository()
+		},
+	})
+}
+
+func Test_APIKeyRepository_NewAPIKeyRepository(t *testing.T) {
+	apiKeyRepository := NewAPIKeyRepository()
+	assert.NotNil(t, apiKeyRepository)
+	assert.NotNil(t, apiKeyRepository.keys)
+}
@@ -0,0 +1,78 @@
+package memory
+
+import (
+	"context"
+	"database/sql"
+	"sync"
+
+	"github.com/google/uuid"
+	"github.com/sesaquecruz/go-auth-api/internal/entity"
+)
+
+type storedInvitation struct {
+	tenantID   uuid.UUID
+	invitation entity.Invitation
+}
+
+type InvitationRepository struct {
+	mu          sync.RWMutex
+	invitations map[uuid.UUID]storedInvitation
+}
+
+func NewInvitationRepository() *InvitationRepository {
+	return &InvitationRepository{
+		invitations: make(map[uuid.UUID]storedInvitation),
+	}
+}
+
+// Save stores the invitation under the tenant of ctx, which is the tenant of its organization.
+func (r *InvitationRepository) Save(ctx context.Context, invitation entity.Invitation) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	if _, ok := r.invitations[invitation.ID]; ok {
+		return ErrDuplicateKey
+	}
+
+	r.invitations[invitation.ID] = storedInvitation{tenantID: entity.TenantFromContext(ctx), invitation: invitation}
+	return nil
+}
+
+func (r *InvitationRepository) FindById(ctx context.Context, id uuid.UUID) (*entity.Invitation, error) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	stored, ok := r.invitations[id]
+	if !ok || stored.tenantID != entity.TenantFromContext(ctx) {
+		return nil, sql.ErrNoRows
+	}
+
+	return &stored.invitation, nil
+}
+
+func (r *InvitationRepository) Delete(ctx context.Context, id uuid.UUID) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	stored, ok := r.invitations[id]
+	if ok && stored.tenantID == entity.TenantFromContext(ctx) {
+		delete(r.invitations, id)
+	}
+	return nil
+}
+
+func (r *InvitationRepository) Snapshot() func() {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	invitations := make(map[uuid.UUID]storedInvitation, len(r.invitations))
+	for id, stored := range r.invitations {
+		invitations[id] = stored
+	}
+
+	return func() {
+		r.mu.Lock()
+		defer r.mu.Unlock()
+		r.invitations = invitations
+	}
+}
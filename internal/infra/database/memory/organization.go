@@ -0,0 +1,155 @@
+package memory
+
+import (
+	"context"
+	"database/sql"
+	"sort"
+	"sync"
+
+	"github.com/google/uuid"
+	"github.com/sesaquecruz/go-auth-api/internal/entity"
+)
+
+type membershipKey struct {
+	organizationID uuid.UUID
+	userID         uuid.UUID
+}
+
+type OrganizationRepository struct {
+	mu            sync.RWMutex
+	organizations map[uuid.UUID]entity.Organization
+	members       map[membershipKey]entity.Membership
+}
+
+func NewOrganizationRepository() *OrganizationRepository {
+	return &OrganizationRepository{
+		organizations: make(map[uuid.UUID]entity.Organization),
+		members:       make(map[membershipKey]entity.Membership),
+	}
+}
+
+func (r *OrganizationRepository) Save(ctx context.Context, organization entity.Organization) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	if _, ok := r.organizations[organization.ID]; ok {
+		return ErrDuplicateKey
+	}
+
+	organization.TenantID = entity.TenantFromContext(ctx)
+	r.organizations[organization.ID] = organization
+	return nil
+}
+
+func (r *OrganizationRepository) FindById(ctx context.Context, id uuid.UUID) (*entity.Organization, error) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	if !r.visible(ctx, id) {
+		return nil, sql.ErrNoRows
+	}
+
+	organization := r.organizations[id]
+	return &organization, nil
+}
+
+func (r *OrganizationRepository) AddMember(ctx context.Context, membership entity.Membership) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	key := membershipKey{membership.OrganizationID, membership.UserID}
+	if _, ok := r.members[key]; ok {
+		return entity.ErrMembershipAlreadyExists
+	}
+
+	r.members[key] = membership
+	return nil
+}
+
+func (r *OrganizationRepository) FindMember(ctx context.Context, organizationID uuid.UUID, userID uuid.UUID) (*entity.Membership, error) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	member, ok := r.members[membershipKey{organizationID, userID}]
+	if !ok || !r.visible(ctx, organizationID) {
+		return nil, sql.ErrNoRows
+	}
+
+	return &member, nil
+}
+
+func (r *OrganizationRepository) ListMembers(ctx context.Context, organizationID uuid.UUID) ([]entity.Membership, error) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	members := make([]entity.Membership, 0)
+	if !r.visible(ctx, organizationID) {
+		return members, nil
+	}
+	for key, member := range r.members {
+		if key.organizationID == organizationID {
+			members = append(members, member)
+		}
+	}
+
+	sort.Slice(members, func(i, j int) bool {
+		if !members[i].CreatedAt.Equal(members[j].CreatedAt) {
+			return members[i].CreatedAt.Before(members[j].CreatedAt)
+		}
+		return members[i].UserID.String() < members[j].UserID.String()
+	})
+
+	return members, nil
+}
+
+func (r *OrganizationRepository) SetMemberRole(ctx context.Context, organizationID uuid.UUID, userID uuid.UUID, role entity.OrganizationRole) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	key := membershipKey{organizationID, userID}
+	member, ok := r.members[key]
+	if !ok || !r.visible(ctx, organizationID) {
+		return nil
+	}
+
+	member.Role = role
+	r.members[key] = member
+	return nil
+}
+
+func (r *OrganizationRepository) RemoveMember(ctx context.Context, organizationID uuid.UUID, userID uuid.UUID) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	if r.visible(ctx, organizationID) {
+		delete(r.members, membershipKey{organizationID, userID})
+	}
+	return nil
+}
+
+func (r *OrganizationRepository) Snapshot() func() {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	organizations := make(map[uuid.UUID]entity.Organization, len(r.organizations))
+	for id, organization := range r.organizations {
+		organizations[id] = organization
+	}
+	members := make(map[membershipKey]entity.Membership, len(r.members))
+	for key, member := range r.members {
+		members[key] = member
+	}
+
+	return func() {
+		r.mu.Lock()
+		defer r.mu.Unlock()
+		r.organizations = organizations
+		r.members = members
+	}
+}
+
+// visible tells whether the organization with id belongs to the tenant of ctx.
+func (r *OrganizationRepository) visible(ctx context.Context, id uuid.UUID) bool {
+	organization, ok := r.organizations[id]
+	return ok && organization.TenantID == entity.TenantFromContext(ctx)
+}
@@ -0,0 +1,31 @@
+package memory
+
+import (
+	"testing"
+
+	"github.com/sesaquecruz/go-auth-api/internal/entity"
+	"github.com/sesaquecruz/go-auth-api/internal/infra/database/repositorytest"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/suite"
+)
+
+func TestSuite_OrganizationRepository(t *testing.T) {
+	suite.Run(t, &repositorytest.OrganizationRepositorySuite{
+		NewRepositories: func() (entity.UserRepositoryInterface, entity.TenantRepositoryInterface, entity.OrganizationRepositoryInterface, entity.InvitationRepositoryInterface) {
+			return NewUserRepository(), NewTenantRepository(), NewOrganizationRepository(), NewInvitationRepository()
+		},
+	})
+}
+
+func Test_OrganizationRepository_NewOrganizationRepository(t *testing.T) {
+	organizationRepository := NewOrganizationRepository()
+	assert.NotNil(t, organizationRepository)
+	assert.NotNil(t, organizationRepository.organizations)
+	assert.NotNil(t, organizationRepository.members)
+}
+
+func Test_InvitationRepository_NewInvitationRepository(t *testing.T) {
+	invitationRepository := NewInvitationRepository()
+	assert.NotNil(t, invitationRepository)
+	assert.NotNil(t, invitationRepository.invitations)
+}
@@ -0,0 +1,113 @@
+package memory
+
+import (
+	"context"
+	"database/sql"
+	"sort"
+	"sync"
+
+	"github.com/google/uuid"
+	"github.com/sesaquecruz/go-auth-api/internal/entity"
+)
+
+type RoleRepository struct {
+	mu        sync.RWMutex
+	roles     map[string]entity.Role
+	userRoles map[uuid.UUID]map[string]struct{}
+}
+
+func NewRoleRepository() *RoleRepository {
+	return &RoleRepository{
+		roles:     make(map[string]entity.Role),
+		userRoles: make(map[uuid.UUID]map[string]struct{}),
+	}
+}
+
+func (r *RoleRepository) Save(ctx context.Context, role entity.Role) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	if _, ok := r.roles[role.Name]; ok {
+		return entity.ErrRoleAlreadyExists
+	}
+
+	role.Permissions = append([]string{}, role.Permissions...)
+	sort.Strings(role.Permissions)
+	r.roles[role.Name] = role
+	return nil
+}
+
+func (r *RoleRepository) FindByName(ctx context.Context, name string) (*entity.Role, error) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	role, ok := r.roles[name]
+	if !ok {
+		return nil, sql.ErrNoRows
+	}
+
+	role.Permissions = append([]string{}, role.Permissions...)
+	return &role, nil
+}
+
+func (r *RoleRepository) FindByUserId(ctx context.Context, userID uuid.UUID) ([]entity.Role, error) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	roles := make([]entity.Role, 0, len(r.userRoles[userID]))
+	for name := range r.userRoles[userID] {
+		role := r.roles[name]
+		role.Permissions = append([]string{}, role.Permissions...)
+		roles = append(roles, role)
+	}
+	sort.Slice(roles, func(i, j int) bool { return roles[i].Name < roles[j].Name })
+
+	return roles, nil
+}
+
+func (r *RoleRepository) AssignToUser(ctx context.Context, userID uuid.UUID, name string) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	if _, ok := r.roles[name]; !ok {
+		return entity.ErrRoleNotFound
+	}
+	if r.userRoles[userID] == nil {
+		r.userRoles[userID] = make(map[string]struct{})
+	}
+
+	r.userRoles[userID][name] = struct{}{}
+	return nil
+}
+
+func (r *RoleRepository) RevokeFromUser(ctx context.Context, userID uuid.UUID, name string) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	delete(r.userRoles[userID], name)
+	return nil
+}
+
+func (r *RoleRepository) Snapshot() func() {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	roles := make(map[string]entity.Role, len(r.roles))
+	for name, role := range r.roles {
+		roles[name] = role
+	}
+	userRoles := make(map[uuid.UUID]map[string]struct{}, len(r.userRoles))
+	for id, names := range r.userRoles {
+		userRoles[id] = make(map[string]struct{}, len(names))
+		for name := range names {
+			userRoles[id][name] = struct{}{}
+		}
+	}
+
+	return func() {
+		r.mu.Lock()
+		defer r.mu.Unlock()
+		r.roles = roles
+		r.userRoles = userRoles
+	}
+}
@@ -0,0 +1,25 @@
+package memory
+
+import (
+	"testing"
+
+	"github.com/sesaquecruz/go-auth-api/internal/entity"
+	"github.com/sesaquecruz/go-auth-api/internal/infra/database/repositorytest"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/suite"
+)
+
+func TestSuite_RoleRepository(t *testing.T) {
+	suite.Run(t, &repositorytest.RoleRepositorySuite{
+		NewRepositories: func() (entity.UserRepositoryInterface, entity.RoleRepositoryInterface) {
+			return NewUserRepository(), NewRoleRepository()
+		},
+	})
+}
+
+func Test_RoleRepository_NewRoleRepository(t *testing.T) {
+	roleRepository := NewRoleRepository()
+	assert.NotNil(t, roleRepository)
+	assert.NotNil(t, roleRepository.roles)
+	assert.NotNil(t, roleRepository.userRoles)
+}
@@ -0,0 +1,130 @@
+package memory
+
+import (
+	"context"
+	"database/sql"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/sesaquecruz/go-auth-api/internal/entity"
+)
+
+type storedSession struct {
+	tenantID uuid.UUID
+	session  entity.Session
+}
+
+type SessionRepository struct {
+	mu       sync.RWMutex
+	sessions map[uuid.UUID]storedSession
+}
+
+func NewSessionRepository() *SessionRepository {
+	return &SessionRepository{
+		sessions: make(map[uuid.UUID]storedSession),
+	}
+}
+
+// Save stores the session under the tenant of ctx, which is the tenant of its user.
+func (r *SessionRepository) Save(ctx context.Context, session entity.Session) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	if _, ok := r.sessions[session.ID]; ok {
+		return ErrDuplicateKey
+	}
+
+	r.sessions[session.ID] = storedSession{tenantID: entity.TenantFromContext(ctx), session: session}
+	return nil
+}
+
+func (r *SessionRepository) FindById(ctx context.Context, id uuid.UUID) (*entity.Session, error) {
+	sessions := r.find(ctx, func(session entity.Session) bool { return session.ID == id })
+	if len(sessions) == 0 {
+		return nil, sql.ErrNoRows
+	}
+
+	return &sessions[0], nil
+}
+
+func (r *SessionRepository) FindActiveByUser(ctx context.Context, userID uuid.UUID, at time.Time) ([]entity.Session, error) {
+	return r.find(ctx, func(session entity.Session) bool { return session.UserID == userID && session.IsActive(at) }), nil
+}
+
+func (r *SessionRepository) Touch(ctx context.Context, id uuid.UUID, at time.Time) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	stored, ok := r.sessions[id]
+	if ok {
+		stored.session.LastSeenAt = at
+		r.sessions[id] = stored
+	}
+	return nil
+}
+
+func (r *SessionRepository) Revoke(ctx context.Context, id uuid.UUID, userID uuid.UUID, at time.Time) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	stored, ok := r.sessions[id]
+	if !ok || stored.tenantID != entity.TenantFromContext(ctx) || stored.session.UserID != userID || stored.session.RevokedAt != nil {
+		return sql.ErrNoRows
+	}
+
+	stored.session.RevokedAt = &at
+	r.sessions[id] = stored
+	return nil
+}
+
+func (r *SessionRepository) RevokeByUser(ctx context.Context, userID uuid.UUID, at time.Time) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	for id, stored := range r.sessions {
+		if stored.tenantID == entity.TenantFromContext(ctx) && stored.session.UserID == userID && stored.session.RevokedAt == nil {
+			stored.session.RevokedAt = &at
+			r.sessions[id] = stored
+		}
+	}
+	return nil
+}
+
+func (r *SessionRepository) Snapshot() func() {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	sessions := make(map[uuid.UUID]storedSession, len(r.sessions))
+	for id, stored := range r.sessions {
+		sessions[id] = stored
+	}
+
+	return func() {
+		r.mu.Lock()
+		defer r.mu.Unlock()
+		r.sessions = sessions
+	}
+}
+
+func (r *SessionRepository) find(ctx context.Context, match func(entity.Session) bool) []entity.Session {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	sessions := make([]entity.Session, 0)
+	for _, stored := range r.sessions {
+		if stored.tenantID == entity.TenantFromContext(ctx) && match(stored.session) {
+			sessions = append(sessions, stored.session)
+		}
+	}
+
+	sort.Slice(sessions, func(i, j int) bool {
+		if !sessions[i].CreatedAt.Equal(sessions[j].CreatedAt) {
+			return sessions[i].CreatedAt.Before(sessions[j].CreatedAt)
+		}
+		return sessions[i].ID.String() < sessions[j].ID.String()
+	})
+
+	return sessions
+}
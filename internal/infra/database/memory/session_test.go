@@ -0,0 +1,24 @@
+package memory
+
+import (
+	"testing"
+
+	"github.com/sesaquecruz/go-auth-api/internal/entity"
+	"github.com/sesaquecruz/go-auth-api/internal/infra/database/repositorytest"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/suite"
+)
+
+func TestSuite_SessionRepository(t *testing.T) {
+	suite.Run(t, &repositorytest.SessionRepositorySuite{
+		NewRepositories: func() (entity.UserRepositoryInterface, entity.TenantRepositoryInterface, entity.SessionRepositoryInterface) {
+			return NewUserRepository(), NewTenantRepository(), NewSessionRepository()
+		},
+	})
+}
+
+func Test_SessionRepository_NewSessionRepository(t *testing.T) {
+	sessionRepository := NewSessionRepository()
+	assert.NotNil(t, sessionRepository)
+	assert.NotNil(t, sessionRepository.sessions)
+}
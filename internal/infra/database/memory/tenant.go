@@ -0,0 +1,68 @@
+package memory
+
+import (
+	"context"
+	"database/sql"
+	"strings"
+	"sync"
+
+	"github.com/google/uuid"
+	"github.com/sesaquecruz/go-auth-api/internal/entity"
+)
+
+type TenantRepository struct {
+	mu      sync.RWMutex
+	tenants map[uuid.UUID]entity.Tenant
+}
+
+// NewTenantRepository returns a repository holding the default tenant, as the migrations create it.
+func NewTenantRepository() *TenantRepository {
+	return &TenantRepository{
+		tenants: map[uuid.UUID]entity.Tenant{
+			entity.DefaultTenantID: {ID: entity.DefaultTenantID, Slug: "default", Name: "Default"},
+		},
+	}
+}
+
+func (r *TenantRepository) Save(ctx context.Context, tenant entity.Tenant) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	if _, ok := r.tenants[tenant.ID]; ok {
+		return ErrDuplicateKey
+	}
+	for _, stored := range r.tenants {
+		if stored.Slug == tenant.Slug || (tenant.Host != "" && stored.Host == tenant.Host) {
+			return entity.ErrTenantAlreadyExists
+		}
+	}
+
+	r.tenants[tenant.ID] = tenant
+	return nil
+}
+
+func (r *TenantRepository) FindById(ctx context.Context, id uuid.UUID) (*entity.Tenant, error) {
+	return r.find(func(tenant entity.Tenant) bool { return tenant.ID == id })
+}
+
+func (r *TenantRepository) FindBySlug(ctx context.Context, slug string) (*entity.Tenant, error) {
+	return r.find(func(tenant entity.Tenant) bool { return tenant.Slug == slug })
+}
+
+func (r *TenantRepository) FindByHost(ctx context.Context, host string) (*entity.Tenant, error) {
+	host = strings.ToLower(host)
+	return r.find(func(tenant entity.Tenant) bool { return host != "" && tenant.Host == host })
+}
+
+func (r *TenantRepository) find(match func(entity.Tenant) bool) (*entity.Tenant, error) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	for _, tenant := range r.tenants {
+		if match(tenant) {
+			return &tenant, nil
+		}
+	}
+
+	return nil, sql.ErrNoRows
+}
@@ -0,0 +1,24 @@
+package memory
+
+import (
+	"testing"
+
+	"github.com/sesaquecruz/go-auth-api/internal/entity"
+	"github.com/sesaquecruz/go-auth-api/internal/infra/database/repositorytest"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/suite"
+)
+
+func TestSuite_TenantRepository(t *testing.T) {
+	suite.Run(t, &repositorytest.TenantRepositorySuite{
+		NewRepositories: func() (entity.UserRepositoryInterface, entity.TenantRepositoryInterface) {
+			return NewUserRepository(), NewTenantRepository()
+		},
+	})
+}
+
+func Test_TenantRepository_NewTenantRepository(t *testing.T) {
+	tenantRepository := NewTenantRepository()
+	assert.NotNil(t, tenantRepository)
+	assert.Contains(t, tenantRepository.tenants, entity.DefaultTenantID)
+}
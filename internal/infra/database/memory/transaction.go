@@ -0,0 +1,53 @@
+package memory
+
+import (
+	"context"
+	"sync"
+)
+
+type txKey struct{}
+
+// Snapshotter is implemented by the repositories of this package. Snapshot saves their current
+// state and returns a function that restores it.
+type Snapshotter interface {
+	Snapshot() (restore func())
+}
+
+// TransactionManager runs one unit of work at a time and restores the snapshot of every
+// repository when it fails. Calls made outside of Do are not isolated from it.
+type TransactionManager struct {
+	mu           sync.Mutex
+	repositories []Snapshotter
+}
+
+func NewTransactionManager(repositories ...Snapshotter) *TransactionManager {
+	return &TransactionManager{repositories: repositories}
+}
+
+func (m *TransactionManager) Do(ctx context.Context, fn func(ctx context.Context) error) (err error) {
+	if ctx.Value(txKey{}) != nil {
+		return fn(ctx)
+	}
+
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	restores := make([]func(), 0, len(m.repositories))
+	for _, repository := range m.repositories {
+		restores = append(restores, repository.Snapshot())
+	}
+
+	defer func() {
+		p := recover()
+		if p != nil || err != nil {
+			for _, restore := range restores {
+				restore()
+			}
+		}
+		if p != nil {
+			panic(p)
+		}
+	}()
+
+	return fn(context.WithValue(ctx, txKey{}, true))
+}
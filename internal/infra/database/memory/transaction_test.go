@@ -0,0 +1,101 @@
+package memory
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/sesaquecruz/go-auth-api/internal/entity"
+	"github.com/stretchr/testify/assert"
+)
+
+func Test_TransactionManager_NewTransactionManager(t *testing.T) {
+	userRepository := NewUserRepository()
+	transactionManager := NewTransactionManager(userRepository)
+	assert.NotNil(t, transactionManager)
+	assert.Equal(t, []Snapshotter{userRepository}, transactionManager.repositories)
+}
+
+func Test_TransactionManager_Do_Commits(t *testing.T) {
+	userRepository := NewUserRepository()
+	transactionManager := NewTransactionManager(userRepository)
+	user := entity.User{ID: uuid.New(), Email: "user@mail.com", Password: "12345", Version: 1}
+	ctx := context.Background()
+
+	err := transactionManager.Do(ctx, func(ctx context.Context) error {
+		return userRepository.Save(ctx, user)
+	})
+	assert.Nil(t, err)
+
+	_, err = userRepository.FindById(ctx, user.ID)
+	assert.Nil(t, err)
+}
+
+func Test_TransactionManager_Do_RollsBackOnError(t *testing.T) {
+	userRepository := NewUserRepository()
+	transactionManager := NewTransactionManager(userRepository)
+	kept := entity.User{ID: uuid.New(), Email: "kept@mail.com", Password: "12345", Version: 1}
+	user := entity.User{ID: uuid.New(), Email: "user@mail.com", Password: "12345", Version: 1}
+	ctx := context.Background()
+	failure := errors.New("failure")
+
+	err := userRepository.Save(ctx, kept)
+	assert.Nil(t, err)
+
+	err = transactionManager.Do(ctx, func(ctx context.Context) error {
+		err := userRepository.Save(ctx, user)
+		assert.Nil(t, err)
+
+		err = userRepository.Delete(ctx, kept.ID, kept.Version, entity.Now())
+		assert.Nil(t, err)
+
+		return failure
+	})
+	assert.ErrorIs(t, err, failure)
+
+	_, err = userRepository.FindById(ctx, user.ID)
+	assert.ErrorIs(t, err, sql.ErrNoRows)
+
+	_, err = userRepository.FindById(ctx, kept.ID)
+	assert.Nil(t, err)
+}
+
+func Test_TransactionManager_Do_RollsBackOnPanic(t *testing.T) {
+	userRepository := NewUserRepository()
+	transactionManager := NewTransactionManager(userRepository)
+	user := entity.User{ID: uuid.New(), Email: "user@mail.com", Password: "12345", Version: 1}
+	ctx := context.Background()
+
+	assert.Panics(t, func() {
+		transactionManager.Do(ctx, func(ctx context.Context) error {
+			userRepository.Save(ctx, user)
+			panic("failure")
+		})
+	})
+
+	_, err := userRepository.FindById(ctx, user.ID)
+	assert.ErrorIs(t, err, sql.ErrNoRows)
+}
+
+func Test_TransactionManager_Do_JoinsOuterTransaction(t *testing.T) {
+	userRepository := NewUserRepository()
+	transactionManager := NewTransactionManager(userRepository)
+	user := entity.User{ID: uuid.New(), Email: "user@mail.com", Password: "12345", Version: 1}
+	ctx := context.Background()
+	failure := errors.New("failure")
+
+	err := transactionManager.Do(ctx, func(ctx context.Context) error {
+		err := transactionManager.Do(ctx, func(ctx context.Context) error {
+			return userRepository.Save(ctx, user)
+		})
+		assert.Nil(t, err)
+
+		return failure
+	})
+	assert.ErrorIs(t, err, failure)
+
+	_, err = userRepository.FindById(ctx, user.ID)
+	assert.ErrorIs(t, err, sql.ErrNoRows)
+}
@@ -0,0 +1,251 @@
+package memory
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/sesaquecruz/go-auth-api/internal/entity"
+)
+
+var ErrDuplicateKey = errors.New("duplicate key")
+
+type UserRepository struct {
+	mu    sync.RWMutex
+	users map[uuid.UUID]entity.User
+}
+
+func NewUserRepository() *UserRepository {
+	return &UserRepository{
+		users: make(map[uuid.UUID]entity.User),
+	}
+}
+
+func (r *UserRepository) Save(ctx context.Context, user entity.User) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	if _, ok := r.users[user.ID]; ok {
+		return ErrDuplicateKey
+	}
+	user.TenantID = entity.TenantFromContext(ctx)
+	if r.emailTaken(user.TenantID, user.Email, user.ID) {
+		return entity.ErrUserEmailAlreadyExists
+	}
+
+	r.users[user.ID] = user
+	return nil
+}
+
+func (r *UserRepository) FindById(ctx context.Context, id uuid.UUID) (*entity.User, error) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	user, ok := r.get(ctx, id)
+	if !ok || user.DeletedAt != nil {
+		return nil, sql.ErrNoRows
+	}
+
+	return &user, nil
+}
+
+func (r *UserRepository) FindByEmail(ctx context.Context, email string) (*entity.User, error) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	tenantID := entity.TenantFromContext(ctx)
+	for _, user := range r.users {
+		if user.TenantID == tenantID && user.DeletedAt == nil && entity.EmailLookupKey(user.Email) == entity.EmailLookupKey(email) {
+			return &user, nil
+		}
+	}
+
+	return nil, sql.ErrNoRows
+}
+
+func (r *UserRepository) Update(ctx context.Context, user entity.User) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	stored, ok := r.get(ctx, user.ID)
+	if !ok || stored.DeletedAt != nil {
+		return nil
+	}
+	if stored.Version != user.Version {
+		return entity.ErrUserVersionConflict
+	}
+	if r.emailTaken(stored.TenantID, user.Email, user.ID) {
+		return entity.ErrUserEmailAlreadyExists
+	}
+
+	stored.Email = user.Email
+	stored.Password = user.Password
+	stored.UpdatedAt = user.UpdatedAt
+	stored.PasswordChangedAt = user.PasswordChangedAt
+	stored.Version++
+	r.users[user.ID] = stored
+	return nil
+}
+
+func (r *UserRepository) Delete(ctx context.Context, id uuid.UUID, version int64, at time.Time) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	stored, ok := r.get(ctx, id)
+	if !ok || stored.DeletedAt != nil {
+		return nil
+	}
+	if stored.Version != version {
+		return entity.ErrUserVersionConflict
+	}
+
+	stored.DeletedAt = &at
+	stored.Version++
+	r.users[id] = stored
+	return nil
+}
+
+func (r *UserRepository) FindDeletedByEmail(ctx context.Context, email string) (*entity.User, error) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	tenantID := entity.TenantFromContext(ctx)
+	for _, user := range r.users {
+		if user.TenantID == tenantID && user.DeletedAt != nil && entity.EmailLookupKey(user.Email) == entity.EmailLookupKey(email) {
+			return &user, nil
+		}
+	}
+
+	return nil, sql.ErrNoRows
+}
+
+func (r *UserRepository) Restore(ctx context.Context, id uuid.UUID, version int64, at time.Time) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	stored, ok := r.get(ctx, id)
+	if !ok || stored.DeletedAt == nil || stored.Version != version {
+		return entity.ErrUserVersionConflict
+	}
+
+	stored.DeletedAt = nil
+	stored.UpdatedAt = at
+	stored.Version++
+	r.users[id] = stored
+	return nil
+}
+
+func (r *UserRepository) Purge(ctx context.Context, deletedBefore time.Time) (int64, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	var purged int64
+	for id, user := range r.users {
+		if user.DeletedAt != nil && user.DeletedAt.Before(deletedBefore) {
+			delete(r.users, id)
+			purged++
+		}
+	}
+
+	return purged, nil
+}
+
+func (r *UserRepository) SetStatus(ctx context.Context, id uuid.UUID, status entity.UserStatus, at time.Time) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	user, ok := r.get(ctx, id)
+	if !ok || user.DeletedAt != nil {
+		return nil
+	}
+
+	user.Status = status
+	user.UpdatedAt = at
+	user.Version++
+	r.users[id] = user
+	return nil
+}
+
+func (r *UserRepository) List(ctx context.Context, filter entity.UserListFilter) (*entity.UserPage, error) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	tenantID := entity.TenantFromContext(ctx)
+	users := make([]entity.User, 0, len(r.users))
+	for _, user := range r.users {
+		if user.TenantID == tenantID && user.DeletedAt == nil && filter.Matches(user) {
+			users = append(users, user)
+		}
+	}
+	sort.Slice(users, func(i, j int) bool {
+		return entity.NewUserCursor(users[i]).Less(entity.NewUserCursor(users[j]))
+	})
+
+	page := &entity.UserPage{Total: int64(len(users))}
+	if filter.After != nil {
+		users = users[sort.Search(len(users), func(i int) bool {
+			return filter.After.Less(entity.NewUserCursor(users[i]))
+		}):]
+	}
+	if filter.Limit > 0 && len(users) > filter.Limit {
+		users = users[:filter.Limit]
+		next := entity.NewUserCursor(users[len(users)-1])
+		page.Next = &next
+	}
+	page.Users = users
+
+	return page, nil
+}
+
+func (r *UserRepository) Snapshot() func() {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	users := make(map[uuid.UUID]entity.User, len(r.users))
+	for id, user := range r.users {
+		users[id] = user
+	}
+
+	return func() {
+		r.mu.Lock()
+		defer r.mu.Unlock()
+		r.users = users
+	}
+}
+
+// get returns the user with id, deleted or not, when it belongs to the tenant of ctx.
+func (r *UserRepository) get(ctx context.Context, id uuid.UUID) (entity.User, bool) {
+	user, ok := r.users[id]
+	if !ok || user.TenantID != entity.TenantFromContext(ctx) {
+		return entity.User{}, false
+	}
+	return user, true
+}
+
+func (r *UserRepository) emailTaken(tenantID uuid.UUID, email string, owner uuid.UUID) bool {
+	for id, user := range r.users {
+		if id != owner && user.TenantID == tenantID && entity.EmailLookupKey(user.Email) == entity.EmailLookupKey(email) {
+			return true
+		}
+	}
+	return false
+}
+
+func (r *UserRepository) RecordLogin(ctx context.Context, id uuid.UUID, at time.Time, ip string) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	user, ok := r.get(ctx, id)
+	if !ok || user.DeletedAt != nil {
+		return nil
+	}
+
+	user.LastLoginAt = &at
+	user.LastLoginIP = ip
+	r.users[id] = user
+	return nil
+}
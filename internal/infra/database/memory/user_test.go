@@ -0,0 +1,50 @@
+package memory
+
+import (
+	"context"
+	"sync"
+	"sync/atomic"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/sesaquecruz/go-auth-api/internal/entity"
+	"github.com/sesaquecruz/go-auth-api/internal/infra/database/repositorytest"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/suite"
+)
+
+func TestSuite_UserRepository(t *testing.T) {
+	suite.Run(t, &repositorytest.UserRepositorySuite{
+		NewRepository: func() entity.UserRepositoryInterface {
+			return NewUserRepository()
+		},
+	})
+}
+
+func Test_UserRepository_NewUserRepository(t *testing.T) {
+	userRepository := NewUserRepository()
+	assert.NotNil(t, userRepository)
+	assert.NotNil(t, userRepository.users)
+}
+
+func Test_UserRepository_ConcurrentSave(t *testing.T) {
+	userRepository := NewUserRepository()
+	ctx := context.Background()
+	email := "user@mail.com"
+
+	var wg sync.WaitGroup
+	var saved atomic.Int32
+
+	for i := 0; i < 20; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			if userRepository.Save(ctx, entity.User{ID: uuid.New(), Email: email, Password: "12345"}) == nil {
+				saved.Add(1)
+			}
+		}()
+	}
+	wg.Wait()
+
+	assert.Equal(t, int32(1), saved.Load())
+}
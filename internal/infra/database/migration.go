@@ -0,0 +1,56 @@
+package database
+
+import (
+	"database/sql"
+	"fmt"
+
+	"github.com/sesaquecruz/go-auth-api/config"
+	"github.com/sesaquecruz/go-auth-api/migrations"
+
+	"github.com/golang-migrate/migrate/v4"
+	migratedb "github.com/golang-migrate/migrate/v4/database"
+	"github.com/golang-migrate/migrate/v4/database/mysql"
+	"github.com/golang-migrate/migrate/v4/database/postgres"
+	"github.com/golang-migrate/migrate/v4/database/sqlite"
+	"github.com/golang-migrate/migrate/v4/source/iofs"
+)
+
+func NewMigrate(db *sql.DB, cfg *config.Config) (*migrate.Migrate, error) {
+	var driver migratedb.Driver
+	var err error
+
+	switch cfg.DBDriver {
+	case "mysql":
+		driver, err = mysql.WithInstance(db, &mysql.Config{})
+	case "postgres":
+		driver, err = postgres.WithInstance(db, &postgres.Config{})
+	case "sqlite":
+		driver, err = sqlite.WithInstance(db, &sqlite.Config{})
+	default:
+		err = fmt.Errorf("unsupported database driver %s", cfg.DBDriver)
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	source, err := iofs.New(migrations.FS, cfg.DBDriver)
+	if err != nil {
+		return nil, err
+	}
+
+	return migrate.NewWithInstance("iofs", source, cfg.DBName, driver)
+}
+
+func MigrateUp(db *sql.DB, cfg *config.Config) error {
+	m, err := NewMigrate(db, cfg)
+	if err != nil {
+		return err
+	}
+
+	err = m.Up()
+	if err != nil && err != migrate.ErrNoChange {
+		return err
+	}
+
+	return nil
+}
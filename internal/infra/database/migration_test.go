@@ -0,0 +1,78 @@
+package database
+
+import (
+	"path/filepath"
+	"testing"
+
+	"github.com/sesaquecruz/go-auth-api/config"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func Test_Migration_MigrateUp(t *testing.T) {
+	cfg := &config.Config{DBDriver: "sqlite", DBName: filepath.Join(t.TempDir(), "auth.db")}
+
+	db, err := NewConnection(cfg)
+	require.Nil(t, err)
+	defer db.Close()
+
+	assert.Nil(t, MigrateUp(db, cfg))
+	assert.Nil(t, MigrateUp(db, cfg))
+
+	_, err = db.Exec("SELECT id, email, password FROM users")
+	assert.Nil(t, err)
+
+	m, err := NewMigrate(db, cfg)
+	require.Nil(t, err)
+
+	version, dirty, err := m.Version()
+	assert.Nil(t, err)
+	assert.False(t, dirty)
+	assert.NotZero(t, version)
+}
+
+func Test_Migration_NewMigrate_WhenDriverIsInvalid(t *testing.T) {
+	m, err := NewMigrate(nil, &config.Config{DBDriver: "oracle"})
+	assert.Nil(t, m)
+	assert.Error(t, err)
+}
+
+func Test_Migration_EmailLookupCollisions(t *testing.T) {
+	cfg := &config.Config{DBDriver: "sqlite", DBName: filepath.Join(t.TempDir(), "auth.db")}
+
+	db, err := NewConnection(cfg)
+	require.Nil(t, err)
+	defer db.Close()
+
+	m, err := NewMigrate(db, cfg)
+	require.Nil(t, err)
+	require.Nil(t, m.Migrate(2))
+
+	kept := "00000000-0000-0000-0000-000000000001"
+	collided := "00000000-0000-0000-0000-000000000002"
+
+	_, err = db.Exec("INSERT INTO users (id, email, password) VALUES (?, ?, ?), (?, ?, ?)",
+		kept, "user@mail.com", "12345",
+		collided, " user@mail.com", "12345",
+	)
+	require.Nil(t, err)
+
+	require.Nil(t, m.Up())
+
+	var userID, emailLookup string
+	err = db.QueryRow("SELECT user_id, email_lookup FROM user_email_collisions").Scan(&userID, &emailLookup)
+	assert.Nil(t, err)
+	assert.Equal(t, collided, userID)
+	assert.Equal(t, "user@mail.com", emailLookup)
+
+	err = db.QueryRow("SELECT email_lookup FROM users WHERE id = ?", kept).Scan(&emailLookup)
+	assert.Nil(t, err)
+	assert.Equal(t, "user@mail.com", emailLookup)
+
+	err = db.QueryRow("SELECT email_lookup FROM users WHERE id = ?", collided).Scan(&emailLookup)
+	assert.Nil(t, err)
+	assert.Equal(t, "collision:"+collided, emailLookup)
+
+	assert.Nil(t, m.Down())
+}
@@ -0,0 +1,134 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/sesaquecruz/go-auth-api/internal/entity"
+)
+
+const apiKeyColumns = "k.id, k.user_id, k.name, k.prefix, k.secret_hash, k.scopes, k.expires_at, k.last_used_at, k.revoked_at, k.created_at"
+
+type APIKeyRepository struct {
+	DB      *sql.DB
+	Dialect Dialect
+}
+
+func NewAPIKeyRepository(db *sql.DB, dialect Dialect) *APIKeyRepository {
+	return &APIKeyRepository{
+		DB:      db,
+		Dialect: dialect,
+	}
+}
+
+func (r *APIKeyRepository) Save(ctx context.Context, key entity.APIKey) error {
+	_, err := conn(ctx, r.DB).ExecContext(ctx, r.Dialect.Rebind(
+		"INSERT INTO api_keys (id, user_id, name, prefix, secret_hash, scopes, expires_at, created_at) VALUES (?, ?, ?, ?, ?, ?, ?, ?)",
+	),
+		key.ID,
+		key.UserID,
+		key.Name,
+		key.Prefix,
+		key.SecretHash,
+		strings.Join(key.Scopes, " "),
+		key.ExpiresAt,
+		key.CreatedAt,
+	)
+	return err
+}
+
+func (r *APIKeyRepository) FindByPrefix(ctx context.Context, prefix string) (*entity.APIKey, error) {
+	keys, err := r.query(ctx, "k.prefix = ?", prefix)
+	if err != nil {
+		return nil, err
+	}
+	if len(keys) == 0 {
+		return nil, sql.ErrNoRows
+	}
+
+	return &keys[0], nil
+}
+
+func (r *APIKeyRepository) FindByUser(ctx context.Context, userID uuid.UUID) ([]entity.APIKey, error) {
+	return r.query(ctx, "k.user_id = ? AND k.revoked_at IS NULL", userID)
+}
+
+func (r *APIKeyRepository) Revoke(ctx context.Context, id uuid.UUID, userID uuid.UUID, at time.Time) error {
+	result, err := conn(ctx, r.DB).ExecContext(ctx, r.Dialect.Rebind(
+		"UPDATE api_keys SET revoked_at = ? WHERE id = ? AND user_id = ? AND revoked_at IS NULL "+
+			"AND user_id IN (SELECT id FROM users WHERE tenant_id = ?)",
+	), at, id, userID, entity.TenantFromContext(ctx))
+	if err != nil {
+		return err
+	}
+
+	rows, err := result.RowsAffected()
+	if err != nil {
+		return err
+	}
+	if rows == 0 {
+		return sql.ErrNoRows
+	}
+
+	return nil
+}
+
+func (r *APIKeyRepository) RecordUse(ctx context.Context, id uuid.UUID, at time.Time) error {
+	_, err := conn(ctx, r.DB).ExecContext(ctx, r.Dialect.Rebind(
+		"UPDATE api_keys SET last_used_at = ? WHERE id = ?",
+	), at, id)
+	return err
+}
+
+func (r *APIKeyRepository) query(ctx context.Context, where string, args ...interface{}) ([]entity.APIKey, error) {
+	rows, err := conn(ctx, r.DB).QueryContext(ctx, r.Dialect.Rebind(
+		"SELECT "+apiKeyColumns+" FROM api_keys k JOIN users u ON u.id = k.user_id "+
+			"WHERE u.tenant_id = ? AND "+where+" ORDER BY k.created_at, k.id",
+	), append([]interface{}{entity.TenantFromContext(ctx)}, args...)...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	keys := make([]entity.APIKey, 0)
+	for rows.Next() {
+		var key entity.APIKey
+		var scopes string
+		var lastUsedAt sql.NullTime
+		var revokedAt sql.NullTime
+
+		err := rows.Scan(
+			&key.ID,
+			&key.UserID,
+			&key.Name,
+			&key.Prefix,
+			&key.SecretHash,
+			&scopes,
+			&key.ExpiresAt,
+			&lastUsedAt,
+			&revokedAt,
+			&key.CreatedAt,
+		)
+		if err != nil {
+			return nil, err
+		}
+
+		key.Scopes = strings.Fields(scopes)
+		key.ExpiresAt = key.ExpiresAt.UTC()
+		key.CreatedAt = key.CreatedAt.UTC()
+		if lastUsedAt.Valid {
+			at := lastUsedAt.Time.UTC()
+			key.LastUsedAt = &at
+		}
+		if revokedAt.Valid {
+			at := revokedAt.Time.UTC()
+			key.RevokedAt = &at
+		}
+		keys = append(keys, key)
+	}
+
+	return keys, rows.Err()
+}
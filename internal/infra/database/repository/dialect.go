@@ -0,0 +1,137 @@
+package repository
+
+import (
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/go-sql-driver/mysql"
+	"github.com/lib/pq"
+	"modernc.org/sqlite"
+	sqlite3 "modernc.org/sqlite/lib"
+)
+
+type Dialect interface {
+	Name() string
+	Rebind(query string) string
+	IsUniqueViolation(err error) bool
+	// IsUniqueIndexViolation tells whether err is a unique violation of index, rather than of any other.
+	IsUniqueIndexViolation(err error, index UniqueIndex) bool
+}
+
+// UniqueIndex names a unique index, along with the table and columns SQLite reports instead of its name.
+type UniqueIndex struct {
+	Name    string
+	Table   string
+	Columns []string
+}
+
+var (
+	MySQLDialect    Dialect = mysqlDialect{}
+	PostgresDialect Dialect = postgresDialect{}
+	SQLiteDialect   Dialect = sqliteDialect{}
+)
+
+func DialectFor(driver string) (Dialect, error) {
+	switch driver {
+	case "mysql":
+		return MySQLDialect, nil
+	case "postgres":
+		return PostgresDialect, nil
+	case "sqlite":
+		return SQLiteDialect, nil
+	default:
+		return nil, fmt.Errorf("unsupported database driver %s", driver)
+	}
+}
+
+type mysqlDialect struct{}
+
+func (mysqlDialect) Name() string {
+	return "mysql"
+}
+
+func (mysqlDialect) Rebind(query string) string {
+	return query
+}
+
+func (mysqlDialect) IsUniqueViolation(err error) bool {
+	var mysqlErr *mysql.MySQLError
+	return errors.As(err, &mysqlErr) && mysqlErr.Number == 1062
+}
+
+// IsUniqueIndexViolation reads the index from the message, as "for key 'name'" or, since MySQL 8,
+// "for key 'table.name'".
+func (d mysqlDialect) IsUniqueIndexViolation(err error, index UniqueIndex) bool {
+	var mysqlErr *mysql.MySQLError
+	if !d.IsUniqueViolation(err) || !errors.As(err, &mysqlErr) {
+		return false
+	}
+	return strings.HasSuffix(mysqlErr.Message, "'"+index.Name+"'") ||
+		strings.HasSuffix(mysqlErr.Message, "'"+index.Table+"."+index.Name+"'")
+}
+
+type postgresDialect struct{}
+
+func (postgresDialect) Name() string {
+	return "postgres"
+}
+
+// Rebind replaces the ? placeholders by the $n ones used by Postgres.
+func (postgresDialect) Rebind(query string) string {
+	var sb strings.Builder
+	n := 0
+
+	for _, c := range query {
+		if c == '?' {
+			n++
+			sb.WriteByte('$')
+			sb.WriteString(strconv.Itoa(n))
+			continue
+		}
+		sb.WriteRune(c)
+	}
+
+	return sb.String()
+}
+
+func (postgresDialect) IsUniqueViolation(err error) bool {
+	var pqErr *pq.Error
+	return errors.As(err, &pqErr) && pqErr.Code == "23505"
+}
+
+func (postgresDialect) IsUniqueIndexViolation(err error, index UniqueIndex) bool {
+	var pqErr *pq.Error
+	return errors.As(err, &pqErr) && pqErr.Code == "23505" && pqErr.Constraint == index.Name
+}
+
+type sqliteDialect struct{}
+
+func (sqliteDialect) Name() string {
+	return "sqlite"
+}
+
+func (sqliteDialect) Rebind(query string) string {
+	return query
+}
+
+func (sqliteDialect) IsUniqueViolation(err error) bool {
+	var sqliteErr *sqlite.Error
+	return errors.As(err, &sqliteErr) &&
+		(sqliteErr.Code() == sqlite3.SQLITE_CONSTRAINT_UNIQUE || sqliteErr.Code() == sqlite3.SQLITE_CONSTRAINT_PRIMARYKEY)
+}
+
+// IsUniqueIndexViolation reads the columns of the index from the message, as SQLite does not name it.
+func (sqliteDialect) IsUniqueIndexViolation(err error, index UniqueIndex) bool {
+	var sqliteErr *sqlite.Error
+	if !errors.As(err, &sqliteErr) || sqliteErr.Code() != sqlite3.SQLITE_CONSTRAINT_UNIQUE {
+		return false
+	}
+
+	columns := make([]string, len(index.Columns))
+	for i, column := range index.Columns {
+		columns[i] = index.Table + "." + column
+	}
+	return strings.Contains(sqliteErr.Error(), "UNIQUE constraint failed: "+strings.Join(columns, ", ")+" (")
+}
@@ -0,0 +1,64 @@
+package repository
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/go-sql-driver/mysql"
+	"github.com/lib/pq"
+	"github.com/stretchr/testify/assert"
+)
+
+func Test_Dialect_DialectFor(t *testing.T) {
+	dialect, err := DialectFor("mysql")
+	assert.Nil(t, err)
+	assert.Equal(t, MySQLDialect, dialect)
+
+	dialect, err = DialectFor("postgres")
+	assert.Nil(t, err)
+	assert.Equal(t, PostgresDialect, dialect)
+
+	dialect, err = DialectFor("sqlite")
+	assert.Nil(t, err)
+	assert.Equal(t, SQLiteDialect, dialect)
+
+	dialect, err = DialectFor("oracle")
+	assert.Nil(t, dialect)
+	assert.Error(t, err)
+}
+
+func Test_Dialect_Rebind(t *testing.T) {
+	query := "UPDATE users SET email = ?, password = ? WHERE id = ?"
+
+	assert.Equal(t, query, MySQLDialect.Rebind(query))
+	assert.Equal(t, query, SQLiteDialect.Rebind(query))
+	assert.Equal(t, "UPDATE users SET email = $1, password = $2 WHERE id = $3", PostgresDialect.Rebind(query))
+}
+
+func Test_Dialect_IsUniqueViolation(t *testing.T) {
+	assert.True(t, MySQLDialect.IsUniqueViolation(&mysql.MySQLError{Number: 1062}))
+	assert.False(t, MySQLDialect.IsUniqueViolation(&mysql.MySQLError{Number: 1045}))
+	assert.False(t, MySQLDialect.IsUniqueViolation(errors.New("")))
+
+	assert.True(t, PostgresDialect.IsUniqueViolation(&pq.Error{Code: "23505"}))
+	assert.False(t, PostgresDialect.IsUniqueViolation(&pq.Error{Code: "23503"}))
+	assert.False(t, PostgresDialect.IsUniqueViolation(errors.New("")))
+
+	assert.False(t, SQLiteDialect.IsUniqueViolation(errors.New("")))
+}
+
+func Test_Dialect_IsUniqueIndexViolation(t *testing.T) {
+	index := UniqueIndex{Name: "users_tenant_email_lookup_unique", Table: "users", Columns: []string{"tenant_id", "email_lookup"}}
+
+	assert.True(t, MySQLDialect.IsUniqueIndexViolation(&mysql.MySQLError{Number: 1062, Message: "Duplicate entry 't-a' for key 'users_tenant_email_lookup_unique'"}, index))
+	assert.True(t, MySQLDialect.IsUniqueIndexViolation(&mysql.MySQLError{Number: 1062, Message: "Duplicate entry 't-a' for key 'users.users_tenant_email_lookup_unique'"}, index))
+	assert.False(t, MySQLDialect.IsUniqueIndexViolation(&mysql.MySQLError{Number: 1062, Message: "Duplicate entry '1' for key 'users.PRIMARY'"}, index))
+	assert.False(t, MySQLDialect.IsUniqueIndexViolation(errors.New(""), index))
+
+	assert.True(t, PostgresDialect.IsUniqueIndexViolation(&pq.Error{Code: "23505", Constraint: "users_tenant_email_lookup_unique"}, index))
+	assert.False(t, PostgresDialect.IsUniqueIndexViolation(&pq.Error{Code: "23505", Constraint: "users_pkey"}, index))
+	assert.False(t, PostgresDialect.IsUniqueIndexViolation(&pq.Error{Code: "23503", Constraint: "users_tenant_email_lookup_unique"}, index))
+	assert.False(t, PostgresDialect.IsUniqueIndexViolation(errors.New(""), index))
+
+	assert.False(t, SQLiteDialect.IsUniqueIndexViolation(errors.New("UNIQUE constraint failed: users.tenant_id, users.email_lookup (2067)"), index))
+}
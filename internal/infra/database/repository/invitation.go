@@ -0,0 +1,70 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+
+	"github.com/google/uuid"
+	"github.com/sesaquecruz/go-auth-api/internal/entity"
+)
+
+type InvitationRepository struct {
+	DB      *sql.DB
+	Dialect Dialect
+}
+
+func NewInvitationRepository(db *sql.DB, dialect Dialect) *InvitationRepository {
+	return &InvitationRepository{
+		DB:      db,
+		Dialect: dialect,
+	}
+}
+
+func (r *InvitationRepository) Save(ctx context.Context, invitation entity.Invitation) error {
+	_, err := conn(ctx, r.DB).ExecContext(ctx, r.Dialect.Rebind(
+		"INSERT INTO organization_invitations (id, organization_id, email, role, invited_by, expires_at, created_at) "+
+			"VALUES (?, ?, ?, ?, ?, ?, ?)",
+	),
+		invitation.ID,
+		invitation.OrganizationID,
+		invitation.Email,
+		invitation.Role,
+		invitation.InvitedBy,
+		invitation.ExpiresAt,
+		invitation.CreatedAt,
+	)
+	return err
+}
+
+func (r *InvitationRepository) FindById(ctx context.Context, id uuid.UUID) (*entity.Invitation, error) {
+	var invitation entity.Invitation
+
+	err := conn(ctx, r.DB).QueryRowContext(ctx, r.Dialect.Rebind(
+		"SELECT i.id, i.organization_id, i.email, i.role, i.invited_by, i.expires_at, i.created_at "+
+			"FROM organization_invitations i JOIN organizations o ON o.id = i.organization_id "+
+			"WHERE i.id = ? AND o.tenant_id = ?",
+	), id, entity.TenantFromContext(ctx)).Scan(
+		&invitation.ID,
+		&invitation.OrganizationID,
+		&invitation.Email,
+		&invitation.Role,
+		&invitation.InvitedBy,
+		&invitation.ExpiresAt,
+		&invitation.CreatedAt,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	invitation.ExpiresAt = invitation.ExpiresAt.UTC()
+	invitation.CreatedAt = invitation.CreatedAt.UTC()
+	return &invitation, nil
+}
+
+func (r *InvitationRepository) Delete(ctx context.Context, id uuid.UUID) error {
+	_, err := conn(ctx, r.DB).ExecContext(ctx, r.Dialect.Rebind(
+		"DELETE FROM organization_invitations WHERE id = ? "+
+			"AND organization_id IN (SELECT id FROM organizations WHERE tenant_id = ?)",
+	), id, entity.TenantFromContext(ctx))
+	return err
+}
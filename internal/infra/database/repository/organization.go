@@ -0,0 +1,110 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+
+	"github.com/google/uuid"
+	"github.com/sesaquecruz/go-auth-api/internal/entity"
+)
+
+type OrganizationRepository struct {
+	DB      *sql.DB
+	Dialect Dialect
+}
+
+func NewOrganizationRepository(db *sql.DB, dialect Dialect) *OrganizationRepository {
+	return &OrganizationRepository{
+		DB:      db,
+		Dialect: dialect,
+	}
+}
+
+func (r *OrganizationRepository) Save(ctx context.Context, organization entity.Organization) error {
+	_, err := conn(ctx, r.DB).ExecContext(ctx, r.Dialect.Rebind(
+		"INSERT INTO organizations (id, tenant_id, name, created_at) VALUES (?, ?, ?, ?)",
+	), organization.ID, entity.TenantFromContext(ctx), organization.Name, organization.CreatedAt)
+	return err
+}
+
+func (r *OrganizationRepository) FindById(ctx context.Context, id uuid.UUID) (*entity.Organization, error) {
+	var organization entity.Organization
+
+	err := conn(ctx, r.DB).QueryRowContext(ctx, r.Dialect.Rebind(
+		"SELECT id, tenant_id, name, created_at FROM organizations WHERE id = ? AND tenant_id = ?",
+	), id, entity.TenantFromContext(ctx)).Scan(&organization.ID, &organization.TenantID, &organization.Name, &organization.CreatedAt)
+	if err != nil {
+		return nil, err
+	}
+
+	organization.CreatedAt = organization.CreatedAt.UTC()
+	return &organization, nil
+}
+
+// AddMember does not check the tenant of the organization, which callers find first.
+func (r *OrganizationRepository) AddMember(ctx context.Context, membership entity.Membership) error {
+	_, err := conn(ctx, r.DB).ExecContext(ctx, r.Dialect.Rebind(
+		"INSERT INTO organization_members (organization_id, user_id, role, created_at) VALUES (?, ?, ?, ?)",
+	), membership.OrganizationID, membership.UserID, membership.Role, membership.CreatedAt)
+	if r.Dialect.IsUniqueViolation(err) {
+		return entity.ErrMembershipAlreadyExists
+	}
+	return err
+}
+
+func (r *OrganizationRepository) FindMember(ctx context.Context, organizationID uuid.UUID, userID uuid.UUID) (*entity.Membership, error) {
+	members, err := r.queryMembers(ctx, "m.organization_id = ? AND m.user_id = ?", organizationID, userID)
+	if err != nil {
+		return nil, err
+	}
+	if len(members) == 0 {
+		return nil, sql.ErrNoRows
+	}
+
+	return &members[0], nil
+}
+
+func (r *OrganizationRepository) ListMembers(ctx context.Context, organizationID uuid.UUID) ([]entity.Membership, error) {
+	return r.queryMembers(ctx, "m.organization_id = ?", organizationID)
+}
+
+func (r *OrganizationRepository) SetMemberRole(ctx context.Context, organizationID uuid.UUID, userID uuid.UUID, role entity.OrganizationRole) error {
+	_, err := conn(ctx, r.DB).ExecContext(ctx, r.Dialect.Rebind(
+		"UPDATE organization_members SET role = ? WHERE organization_id = ? AND user_id = ? "+
+			"AND organization_id IN (SELECT id FROM organizations WHERE tenant_id = ?)",
+	), role, organizationID, userID, entity.TenantFromContext(ctx))
+	return err
+}
+
+func (r *OrganizationRepository) RemoveMember(ctx context.Context, organizationID uuid.UUID, userID uuid.UUID) error {
+	_, err := conn(ctx, r.DB).ExecContext(ctx, r.Dialect.Rebind(
+		"DELETE FROM organization_members WHERE organization_id = ? AND user_id = ? "+
+			"AND organization_id IN (SELECT id FROM organizations WHERE tenant_id = ?)",
+	), organizationID, userID, entity.TenantFromContext(ctx))
+	return err
+}
+
+func (r *OrganizationRepository) queryMembers(ctx context.Context, where string, args ...interface{}) ([]entity.Membership, error) {
+	rows, err := conn(ctx, r.DB).QueryContext(ctx, r.Dialect.Rebind(
+		"SELECT m.organization_id, m.user_id, m.role, m.created_at FROM organization_members m "+
+			"JOIN organizations o ON o.id = m.organization_id "+
+			"WHERE o.tenant_id = ? AND "+where+" ORDER BY m.created_at, m.user_id",
+	), append([]interface{}{entity.TenantFromContext(ctx)}, args...)...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	members := make([]entity.Membership, 0)
+	for rows.Next() {
+		var member entity.Membership
+		err := rows.Scan(&member.OrganizationID, &member.UserID, &member.Role, &member.CreatedAt)
+		if err != nil {
+			return nil, err
+		}
+		member.CreatedAt = member.CreatedAt.UTC()
+		members = append(members, member)
+	}
+
+	return members, rows.Err()
+}
@@ -0,0 +1,129 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+
+	"github.com/google/uuid"
+	"github.com/sesaquecruz/go-auth-api/internal/entity"
+)
+
+type RoleRepository struct {
+	DB      *sql.DB
+	Dialect Dialect
+}
+
+func NewRoleRepository(db *sql.DB, dialect Dialect) *RoleRepository {
+	return &RoleRepository{
+		DB:      db,
+		Dialect: dialect,
+	}
+}
+
+// Save inserts the role and its permissions with the transaction of ctx, if any, so callers that need them
+// stored together run it in the TransactionManager.
+func (r *RoleRepository) Save(ctx context.Context, role entity.Role) error {
+	_, err := conn(ctx, r.DB).ExecContext(ctx, r.Dialect.Rebind(
+		"INSERT INTO roles (name, description) VALUES (?, ?)",
+	), role.Name, role.Description)
+	if r.Dialect.IsUniqueViolation(err) {
+		return entity.ErrRoleAlreadyExists
+	}
+	if err != nil {
+		return err
+	}
+
+	for _, permission := range role.Permissions {
+		_, err = conn(ctx, r.DB).ExecContext(ctx, r.Dialect.Rebind(
+			"INSERT INTO role_permissions (role_name, permission) VALUES (?, ?)",
+		), role.Name, permission)
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func (r *RoleRepository) FindByName(ctx context.Context, name string) (*entity.Role, error) {
+	roles, err := r.query(ctx,
+		"SELECT r.name, r.description, p.permission FROM roles r "+
+			"LEFT JOIN role_permissions p ON p.role_name = r.name "+
+			"WHERE r.name = ? ORDER BY p.permission",
+		name,
+	)
+	if err != nil {
+		return nil, err
+	}
+	if len(roles) == 0 {
+		return nil, sql.ErrNoRows
+	}
+
+	return &roles[0], nil
+}
+
+func (r *RoleRepository) FindByUserId(ctx context.Context, userID uuid.UUID) ([]entity.Role, error) {
+	return r.query(ctx,
+		"SELECT r.name, r.description, p.permission FROM user_roles u "+
+			"JOIN roles r ON r.name = u.role_name "+
+			"LEFT JOIN role_permissions p ON p.role_name = r.name "+
+			"WHERE u.user_id = ? ORDER BY r.name, p.permission",
+		userID,
+	)
+}
+
+// AssignToUser does nothing when the user already has the role.
+func (r *RoleRepository) AssignToUser(ctx context.Context, userID uuid.UUID, name string) error {
+	_, err := r.FindByName(ctx, name)
+	if err == sql.ErrNoRows {
+		return entity.ErrRoleNotFound
+	}
+	if err != nil {
+		return err
+	}
+
+	_, err = conn(ctx, r.DB).ExecContext(ctx, r.Dialect.Rebind(
+		"INSERT INTO user_roles (user_id, role_name) VALUES (?, ?)",
+	), userID, name)
+	if r.Dialect.IsUniqueViolation(err) {
+		return nil
+	}
+	return err
+}
+
+func (r *RoleRepository) RevokeFromUser(ctx context.Context, userID uuid.UUID, name string) error {
+	_, err := conn(ctx, r.DB).ExecContext(ctx, r.Dialect.Rebind(
+		"DELETE FROM user_roles WHERE user_id = ? AND role_name = ?",
+	), userID, name)
+	return err
+}
+
+// query reads rows of role name, description and permission, ordered by role name.
+func (r *RoleRepository) query(ctx context.Context, query string, args ...interface{}) ([]entity.Role, error) {
+	rows, err := conn(ctx, r.DB).QueryContext(ctx, r.Dialect.Rebind(query), args...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	roles := make([]entity.Role, 0)
+	for rows.Next() {
+		var name, description string
+		var permission sql.NullString
+
+		err = rows.Scan(&name, &description, &permission)
+		if err != nil {
+			return nil, err
+		}
+
+		if len(roles) == 0 || roles[len(roles)-1].Name != name {
+			roles = append(roles, entity.Role{Name: name, Description: description, Permissions: []string{}})
+		}
+		if permission.Valid {
+			last := &roles[len(roles)-1]
+			last.Permissions = append(last.Permissions, permission.String)
+		}
+	}
+
+	return roles, rows.Err()
+}
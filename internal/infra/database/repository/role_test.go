@@ -0,0 +1,131 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/sesaquecruz/go-auth-api/config"
+	"github.com/sesaquecruz/go-auth-api/internal/entity"
+	"github.com/sesaquecruz/go-auth-api/internal/infra/database"
+	"github.com/sesaquecruz/go-auth-api/internal/infra/database/repositorytest"
+	"github.com/stretchr/testify/suite"
+
+	"github.com/golang-migrate/migrate/v4"
+)
+
+type RoleRepositoryTestSuite struct {
+	repositorytest.RoleRepositorySuite
+	db      *sql.DB
+	dialect Dialect
+	migrate *migrate.Migrate
+}
+
+func (s *RoleRepositoryTestSuite) SetupSuite() {
+	cfg := &config.Config{DBDriver: "sqlite", DBName: filepath.Join(s.T().TempDir(), "auth.db")}
+	if _, ok := os.LookupEnv("DB_DRIVER"); ok {
+		var err error
+		cfg, err = config.LoadConfig()
+		s.Require().Nil(err)
+	}
+
+	dialect, err := DialectFor(cfg.DBDriver)
+	s.Require().Nil(err)
+
+	db, err := database.NewConnection(cfg)
+	s.Require().Nil(err)
+
+	migrate, err := database.NewMigrate(db, cfg)
+	s.Require().Nil(err)
+
+	err = migrate.Up()
+	s.Require().Nil(err)
+
+	s.db = db
+	s.dialect = dialect
+	s.migrate = migrate
+	s.NewRepositories = func() (entity.UserRepositoryInterface, entity.RoleRepositoryInterface) {
+		return NewUserRepository(s.db, s.dialect), NewRoleRepository(s.db, s.dialect)
+	}
+}
+
+func (s *RoleRepositoryTestSuite) TearDownSuite() {
+	err := s.migrate.Down()
+	s.Require().Nil(err)
+
+	s.migrate.Close()
+	s.db.Close()
+}
+
+func (s *RoleRepositoryTestSuite) TearDownTest() {
+	for _, query := range []string{
+		"DELETE FROM user_roles",
+		"DELETE FROM roles WHERE name <> 'admin'",
+		"DELETE FROM users",
+	} {
+		_, err := s.db.Exec(query)
+		s.Require().Nil(err)
+	}
+}
+
+func TestSuite_RoleRepository(t *testing.T) {
+	suite.Run(t, new(RoleRepositoryTestSuite))
+}
+
+func (s *RoleRepositoryTestSuite) Test_RoleRepository_NewRoleRepository() {
+	roleRepository := NewRoleRepository(s.db, s.dialect)
+	s.NotNil(roleRepository)
+	s.Equal(s.db, roleRepository.DB)
+	s.Equal(s.dialect, roleRepository.Dialect)
+}
+
+func (s *RoleRepositoryTestSuite) Test_RoleRepository_AdminRoleIsSeeded() {
+	role, err := NewRoleRepository(s.db, s.dialect).FindByName(context.Background(), "admin")
+	s.Nil(err)
+	s.Equal([]string{entity.PermissionUsersAdmin}, role.Permissions)
+}
+
+func (s *RoleRepositoryTestSuite) Test_RoleRepository_Save_JoinsTransaction() {
+	roleRepository := NewRoleRepository(s.db, s.dialect)
+	role := entity.Role{Name: "editor", Permissions: []string{"posts:read", "posts:write"}}
+
+	err := NewTransactionManager(s.db).Do(context.Background(), func(ctx context.Context) error {
+		err := roleRepository.Save(ctx, role)
+		s.Require().Nil(err)
+		return errors.New("rollback")
+	})
+	s.NotNil(err)
+
+	_, err = roleRepository.FindByName(context.Background(), role.Name)
+	s.ErrorIs(err, sql.ErrNoRows)
+}
+
+func (s *RoleRepositoryTestSuite) Test_RoleRepository_FindByUserId_WhenUserIsPurged() {
+	ctx := context.Background()
+	userRepository := NewUserRepository(s.db, s.dialect)
+	roleRepository := NewRoleRepository(s.db, s.dialect)
+
+	now := entity.Now()
+	user := entity.User{ID: uuid.New(), Email: "purged@mail.com", Password: "12345", CreatedAt: now, UpdatedAt: now, PasswordChangedAt: now, Version: 1}
+
+	err := userRepository.Save(ctx, user)
+	s.Require().Nil(err)
+
+	err = roleRepository.AssignToUser(ctx, user.ID, "admin")
+	s.Nil(err)
+
+	err = userRepository.Delete(ctx, user.ID, user.Version, now.Add(-time.Hour))
+	s.Nil(err)
+
+	_, err = userRepository.Purge(ctx, now)
+	s.Nil(err)
+
+	roles, err := roleRepository.FindByUserId(ctx, user.ID)
+	s.Nil(err)
+	s.Empty(roles)
+}
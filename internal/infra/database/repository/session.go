@@ -0,0 +1,132 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/sesaquecruz/go-auth-api/internal/entity"
+)
+
+const sessionColumns = "s.id, s.user_id, s.user_agent, s.ip, s.expires_at, s.last_seen_at, s.revoked_at, s.created_at"
+
+type SessionRepository struct {
+	DB      *sql.DB
+	Dialect Dialect
+}
+
+func NewSessionRepository(db *sql.DB, dialect Dialect) *SessionRepository {
+	return &SessionRepository{
+		DB:      db,
+		Dialect: dialect,
+	}
+}
+
+func (r *SessionRepository) Save(ctx context.Context, session entity.Session) error {
+	_, err := conn(ctx, r.DB).ExecContext(ctx, r.Dialect.Rebind(
+		"INSERT INTO sessions (id, user_id, user_agent, ip, expires_at, last_seen_at, created_at) VALUES (?, ?, ?, ?, ?, ?, ?)",
+	),
+		session.ID,
+		session.UserID,
+		session.UserAgent,
+		session.IP,
+		session.ExpiresAt,
+		session.LastSeenAt,
+		session.CreatedAt,
+	)
+	return err
+}
+
+func (r *SessionRepository) FindById(ctx context.Context, id uuid.UUID) (*entity.Session, error) {
+	sessions, err := r.query(ctx, "s.id = ?", id)
+	if err != nil {
+		return nil, err
+	}
+	if len(sessions) == 0 {
+		return nil, sql.ErrNoRows
+	}
+
+	return &sessions[0], nil
+}
+
+func (r *SessionRepository) FindActiveByUser(ctx context.Context, userID uuid.UUID, at time.Time) ([]entity.Session, error) {
+	return r.query(ctx, "s.user_id = ? AND s.revoked_at IS NULL AND s.expires_at > ?", userID, at)
+}
+
+func (r *SessionRepository) Touch(ctx context.Context, id uuid.UUID, at time.Time) error {
+	_, err := conn(ctx, r.DB).ExecContext(ctx, r.Dialect.Rebind(
+		"UPDATE sessions SET last_seen_at = ? WHERE id = ?",
+	), at, id)
+	return err
+}
+
+func (r *SessionRepository) Revoke(ctx context.Context, id uuid.UUID, userID uuid.UUID, at time.Time) error {
+	result, err := conn(ctx, r.DB).ExecContext(ctx, r.Dialect.Rebind(
+		"UPDATE sessions SET revoked_at = ? WHERE id = ? AND user_id = ? AND revoked_at IS NULL "+
+			"AND user_id IN (SELECT id FROM users WHERE tenant_id = ?)",
+	), at, id, userID, entity.TenantFromContext(ctx))
+	if err != nil {
+		return err
+	}
+
+	rows, err := result.RowsAffected()
+	if err != nil {
+		return err
+	}
+	if rows == 0 {
+		return sql.ErrNoRows
+	}
+
+	return nil
+}
+
+func (r *SessionRepository) RevokeByUser(ctx context.Context, userID uuid.UUID, at time.Time) error {
+	_, err := conn(ctx, r.DB).ExecContext(ctx, r.Dialect.Rebind(
+		"UPDATE sessions SET revoked_at = ? WHERE user_id = ? AND revoked_at IS NULL "+
+			"AND user_id IN (SELECT id FROM users WHERE tenant_id = ?)",
+	), at, userID, entity.TenantFromContext(ctx))
+	return err
+}
+
+func (r *SessionRepository) query(ctx context.Context, where string, args ...interface{}) ([]entity.Session, error) {
+	rows, err := conn(ctx, r.DB).QueryContext(ctx, r.Dialect.Rebind(
+		"SELECT "+sessionColumns+" FROM sessions s JOIN users u ON u.id = s.user_id "+
+			"WHERE u.tenant_id = ? AND "+where+" ORDER BY s.created_at, s.id",
+	), append([]interface{}{entity.TenantFromContext(ctx)}, args...)...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	sessions := make([]entity.Session, 0)
+	for rows.Next() {
+		var session entity.Session
+		var revokedAt sql.NullTime
+
+		err := rows.Scan(
+			&session.ID,
+			&session.UserID,
+			&session.UserAgent,
+			&session.IP,
+			&session.ExpiresAt,
+			&session.LastSeenAt,
+			&revokedAt,
+			&session.CreatedAt,
+		)
+		if err != nil {
+			return nil, err
+		}
+
+		session.ExpiresAt = session.ExpiresAt.UTC()
+		session.LastSeenAt = session.LastSeenAt.UTC()
+		session.CreatedAt = session.CreatedAt.UTC()
+		if revokedAt.Valid {
+			at := revokedAt.Time.UTC()
+			session.RevokedAt = &at
+		}
+		sessions = append(sessions, session)
+	}
+
+	return sessions, rows.Err()
+}
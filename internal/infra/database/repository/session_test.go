@@ -0,0 +1,80 @@
+package repository
+
+import (
+	"database/sql"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/sesaquecruz/go-auth-api/config"
+	"github.com/sesaquecruz/go-auth-api/internal/entity"
+	"github.com/sesaquecruz/go-auth-api/internal/infra/database"
+	"github.com/sesaquecruz/go-auth-api/internal/infra/database/repositorytest"
+	"github.com/stretchr/testify/suite"
+
+	"github.com/golang-migrate/migrate/v4"
+)
+
+type SessionRepositoryTestSuite struct {
+	repositorytest.SessionRepositorySuite
+	db      *sql.DB
+	dialect Dialect
+	migrate *migrate.Migrate
+}
+
+func (s *SessionRepositoryTestSuite) SetupSuite() {
+	cfg := &config.Config{DBDriver: "sqlite", DBName: filepath.Join(s.T().TempDir(), "auth.db")}
+	if _, ok := os.LookupEnv("DB_DRIVER"); ok {
+		var err error
+		cfg, err = config.LoadConfig()
+		s.Require().Nil(err)
+	}
+
+	dialect, err := DialectFor(cfg.DBDriver)
+	s.Require().Nil(err)
+
+	db, err := database.NewConnection(cfg)
+	s.Require().Nil(err)
+
+	migrate, err := database.NewMigrate(db, cfg)
+	s.Require().Nil(err)
+
+	err = migrate.Up()
+	s.Require().Nil(err)
+
+	s.db = db
+	s.dialect = dialect
+	s.migrate = migrate
+	s.NewRepositories = func() (entity.UserRepositoryInterface, entity.TenantRepositoryInterface, entity.SessionRepositoryInterface) {
+		return NewUserRepository(s.db, s.dialect), NewTenantRepository(s.db, s.dialect), NewSessionRepository(s.db, s.dialect)
+	}
+}
+
+func (s *SessionRepositoryTestSuite) TearDownSuite() {
+	err := s.migrate.Down()
+	s.Require().Nil(err)
+
+	s.migrate.Close()
+	s.db.Close()
+}
+
+func (s *SessionRepositoryTestSuite) TearDownTest() {
+	for _, query := range []string{
+		"DELETE FROM users",
+		"DELETE FROM tenants WHERE slug <> 'default'",
+	} {
+		_, err := s.db.Exec(query)
+		s.Require().Nil(err)
+	}
+}
+
+func TestSuite_SessionRepository(t *testing.T) {
+	suite.Run(t, new(SessionRepositoryTestSuite))
+}
+
+func (s *SessionRepositoryTestSuite) Test_SessionRepository_NewSessionRepository() {
+	sessionRepository := NewSessionRepository(s.db, s.dialect)
+	s.NotNil(sessionRepository)
+	s.Equal(s.db, sessionRepository.DB)
+	s.Equal(s.dialect, sessionRepository.Dialect)
+}
@@ -0,0 +1,68 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+	"strings"
+
+	"github.com/google/uuid"
+	"github.com/sesaquecruz/go-auth-api/internal/entity"
+)
+
+const tenantColumns = "id, slug, name, host, created_at"
+
+type TenantRepository struct {
+	DB      *sql.DB
+	Dialect Dialect
+}
+
+func NewTenantRepository(db *sql.DB, dialect Dialect) *TenantRepository {
+	return &TenantRepository{
+		DB:      db,
+		Dialect: dialect,
+	}
+}
+
+func (r *TenantRepository) Save(ctx context.Context, tenant entity.Tenant) error {
+	host := sql.NullString{String: tenant.Host, Valid: tenant.Host != ""}
+
+	_, err := conn(ctx, r.DB).ExecContext(ctx, r.Dialect.Rebind(
+		"INSERT INTO tenants ("+tenantColumns+") VALUES (?, ?, ?, ?, ?)",
+	), tenant.ID, tenant.Slug, tenant.Name, host, tenant.CreatedAt)
+	if r.Dialect.IsUniqueViolation(err) {
+		return entity.ErrTenantAlreadyExists
+	}
+	return err
+}
+
+func (r *TenantRepository) FindById(ctx context.Context, id uuid.UUID) (*entity.Tenant, error) {
+	return r.find(ctx, "id = ?", id)
+}
+
+func (r *TenantRepository) FindBySlug(ctx context.Context, slug string) (*entity.Tenant, error) {
+	return r.find(ctx, "slug = ?", slug)
+}
+
+func (r *TenantRepository) FindByHost(ctx context.Context, host string) (*entity.Tenant, error) {
+	host = strings.ToLower(host)
+	if host == "" {
+		return nil, sql.ErrNoRows
+	}
+	return r.find(ctx, "host = ?", host)
+}
+
+func (r *TenantRepository) find(ctx context.Context, where string, arg interface{}) (*entity.Tenant, error) {
+	var tenant entity.Tenant
+	var host sql.NullString
+
+	err := conn(ctx, r.DB).QueryRowContext(ctx, r.Dialect.Rebind(
+		"SELECT "+tenantColumns+" FROM tenants WHERE "+where,
+	), arg).Scan(&tenant.ID, &tenant.Slug, &tenant.Name, &host, &tenant.CreatedAt)
+	if err != nil {
+		return nil, err
+	}
+
+	tenant.Host = host.String
+	tenant.CreatedAt = tenant.CreatedAt.UTC()
+	return &tenant, nil
+}
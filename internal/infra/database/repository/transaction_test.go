@@ -0,0 +1,105 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+
+	"github.com/google/uuid"
+	"github.com/sesaquecruz/go-auth-api/internal/entity"
+)
+
+func (s *UserRepositoryTestSuite) newTransactionUser() entity.User {
+	now := entity.Now()
+	return entity.User{
+		ID:                uuid.New(),
+		Email:             "user@mail.com",
+		Password:          "12345",
+		CreatedAt:         now,
+		UpdatedAt:         now,
+		PasswordChangedAt: now,
+		Version:           1,
+	}
+}
+
+func (s *UserRepositoryTestSuite) Test_TransactionManager_NewTransactionManager() {
+	transactionManager := NewTransactionManager(s.db)
+	s.NotNil(transactionManager)
+	s.Equal(s.db, transactionManager.DB)
+}
+
+func (s *UserRepositoryTestSuite) Test_TransactionManager_Do_Commits() {
+	transactionManager := NewTransactionManager(s.db)
+	userRepository := NewUserRepository(s.db, s.dialect)
+	user := s.newTransactionUser()
+	ctx := context.Background()
+
+	err := transactionManager.Do(ctx, func(ctx context.Context) error {
+		return userRepository.Save(ctx, user)
+	})
+	s.Nil(err)
+
+	_, err = userRepository.FindById(ctx, user.ID)
+	s.Nil(err)
+}
+
+func (s *UserRepositoryTestSuite) Test_TransactionManager_Do_RollsBackOnError() {
+	transactionManager := NewTransactionManager(s.db)
+	userRepository := NewUserRepository(s.db, s.dialect)
+	user := s.newTransactionUser()
+	ctx := context.Background()
+	failure := errors.New("failure")
+
+	err := transactionManager.Do(ctx, func(ctx context.Context) error {
+		err := userRepository.Save(ctx, user)
+		s.Require().Nil(err)
+
+		_, err = userRepository.FindById(ctx, user.ID)
+		s.Require().Nil(err)
+
+		return failure
+	})
+	s.ErrorIs(err, failure)
+
+	_, err = userRepository.FindById(ctx, user.ID)
+	s.ErrorIs(err, sql.ErrNoRows)
+}
+
+func (s *UserRepositoryTestSuite) Test_TransactionManager_Do_RollsBackOnPanic() {
+	transactionManager := NewTransactionManager(s.db)
+	userRepository := NewUserRepository(s.db, s.dialect)
+	user := s.newTransactionUser()
+	ctx := context.Background()
+
+	s.Panics(func() {
+		transactionManager.Do(ctx, func(ctx context.Context) error {
+			err := userRepository.Save(ctx, user)
+			s.Require().Nil(err)
+			panic("failure")
+		})
+	})
+
+	_, err := userRepository.FindById(ctx, user.ID)
+	s.ErrorIs(err, sql.ErrNoRows)
+}
+
+func (s *UserRepositoryTestSuite) Test_TransactionManager_Do_JoinsOuterTransaction() {
+	transactionManager := NewTransactionManager(s.db)
+	userRepository := NewUserRepository(s.db, s.dialect)
+	user := s.newTransactionUser()
+	ctx := context.Background()
+	failure := errors.New("failure")
+
+	err := transactionManager.Do(ctx, func(ctx context.Context) error {
+		err := transactionManager.Do(ctx, func(ctx context.Context) error {
+			return userRepository.Save(ctx, user)
+		})
+		s.Require().Nil(err)
+
+		return failure
+	})
+	s.ErrorIs(err, failure)
+
+	_, err = userRepository.FindById(ctx, user.ID)
+	s.ErrorIs(err, sql.ErrNoRows)
+}
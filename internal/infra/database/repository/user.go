@@ -3,82 +3,330 @@ package repository
 import (
 	"context"
 	"database/sql"
+	"strings"
+	"time"
 
 	"github.com/google/uuid"
 	"github.com/sesaquecruz/go-auth-api/internal/entity"
 )
 
+// likeEscaper escapes the LIKE wildcards of a literal pattern, for use with ESCAPE '!'.
+var likeEscaper = strings.NewReplacer("!", "!!", "%", "!%", "_", "!_")
+
+// usersEmailIndex keeps emails unique within a tenant, the only unique violation that means the email is taken.
+var usersEmailIndex = UniqueIndex{Name: "users_tenant_email_lookup_unique", Table: "users", Columns: []string{"tenant_id", "email_lookup"}}
+
+const userColumns = "id, tenant_id, email, password, created_at, updated_at, password_changed_at, last_login_at, last_login_ip, deleted_at, status, version"
+
 type UserRepository struct {
-	DB *sql.DB
+	DB      *sql.DB
+	Dialect Dialect
 }
 
-func NewUserRepository(db *sql.DB) *UserRepository {
+func NewUserRepository(db *sql.DB, dialect Dialect) *UserRepository {
 	return &UserRepository{
-		DB: db,
+		DB:      db,
+		Dialect: dialect,
 	}
 }
 
 func (r *UserRepository) Save(ctx context.Context, user entity.User) error {
-	stmt, err := r.DB.PrepareContext(ctx, "INSERT INTO users (id, email, password) VALUES (?, ?, ?)")
+	stmt, err := conn(ctx, r.DB).PrepareContext(ctx, r.Dialect.Rebind(
+		"INSERT INTO users (id, tenant_id, email, email_lookup, password, created_at, updated_at, password_changed_at, last_login_at, last_login_ip, status, version) "+
+			"VALUES (?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?)",
+	))
 	if err != nil {
 		return err
 	}
 	defer stmt.Close()
 
-	_, err = stmt.ExecContext(ctx, user.ID, user.Email, user.Password)
+	_, err = stmt.ExecContext(
+		ctx,
+		user.ID,
+		entity.TenantFromContext(ctx),
+		user.Email,
+		entity.EmailLookupKey(user.Email),
+		user.Password,
+		user.CreatedAt,
+		user.UpdatedAt,
+		user.PasswordChangedAt,
+		user.LastLoginAt,
+		user.LastLoginIP,
+		user.Status,
+		user.Version,
+	)
+	if r.Dialect.IsUniqueIndexViolation(err, usersEmailIndex) {
+		return entity.ErrUserEmailAlreadyExists
+	}
 	return err
 }
 
 func (r *UserRepository) FindById(ctx context.Context, id uuid.UUID) (*entity.User, error) {
-	stmt, err := r.DB.PrepareContext(ctx, "SELECT id, email, password FROM users WHERE id = ?")
+	stmt, err := conn(ctx, r.DB).PrepareContext(ctx, r.Dialect.Rebind("SELECT "+userColumns+" FROM users WHERE id = ? AND tenant_id = ? AND deleted_at IS NULL"))
 	if err != nil {
 		return nil, err
 	}
 	defer stmt.Close()
 
-	var user entity.User
-	err = stmt.QueryRowContext(ctx, id).Scan(&user.ID, &user.Email, &user.Password)
+	return scanUser(stmt.QueryRowContext(ctx, id, entity.TenantFromContext(ctx)))
+}
+
+func (r *UserRepository) FindByEmail(ctx context.Context, email string) (*entity.User, error) {
+	stmt, err := conn(ctx, r.DB).PrepareContext(ctx, r.Dialect.Rebind("SELECT "+userColumns+" FROM users WHERE tenant_id = ? AND email_lookup = ? AND deleted_at IS NULL"))
 	if err != nil {
 		return nil, err
 	}
+	defer stmt.Close()
 
-	return &user, nil
+	return scanUser(stmt.QueryRowContext(ctx, entity.TenantFromContext(ctx), entity.EmailLookupKey(email)))
 }
 
-func (r *UserRepository) FindByEmail(ctx context.Context, email string) (*entity.User, error) {
-	stmt, err := r.DB.PrepareContext(ctx, "SELECT id, email, password FROM users WHERE email = ?")
+func (r *UserRepository) Update(ctx context.Context, user entity.User) error {
+	stmt, err := conn(ctx, r.DB).PrepareContext(ctx, r.Dialect.Rebind(
+		"UPDATE users SET email = ?, email_lookup = ?, password = ?, updated_at = ?, password_changed_at = ?, version = version + 1 "+
+			"WHERE id = ? AND tenant_id = ? AND version = ? AND deleted_at IS NULL",
+	))
 	if err != nil {
-		return nil, err
+		return err
 	}
 	defer stmt.Close()
 
-	var user entity.User
-	err = stmt.QueryRowContext(ctx, email).Scan(&user.ID, &user.Email, &user.Password)
+	result, err := stmt.ExecContext(
+		ctx,
+		user.Email,
+		entity.EmailLookupKey(user.Email),
+		user.Password,
+		user.UpdatedAt,
+		user.PasswordChangedAt,
+		user.ID,
+		entity.TenantFromContext(ctx),
+		user.Version,
+	)
+	if r.Dialect.IsUniqueIndexViolation(err, usersEmailIndex) {
+		return entity.ErrUserEmailAlreadyExists
+	}
 	if err != nil {
-		return nil, err
+		return err
 	}
 
-	return &user, nil
+	return r.checkVersion(ctx, result, user.ID)
 }
 
-func (r *UserRepository) Update(ctx context.Context, user entity.User) error {
-	stmt, err := r.DB.Prepare("UPDATE users SET email = ?, password = ? WHERE id = ?")
+func (r *UserRepository) Delete(ctx context.Context, id uuid.UUID, version int64, at time.Time) error {
+	stmt, err := conn(ctx, r.DB).PrepareContext(ctx, r.Dialect.Rebind(
+		"UPDATE users SET deleted_at = ?, version = version + 1 WHERE id = ? AND tenant_id = ? AND version = ? AND deleted_at IS NULL",
+	))
+	if err != nil {
+		return err
+	}
+	defer stmt.Close()
+
+	result, err := stmt.ExecContext(ctx, at, id, entity.TenantFromContext(ctx), version)
+	if err != nil {
+		return err
+	}
+
+	return r.checkVersion(ctx, result, id)
+}
+
+func (r *UserRepository) RecordLogin(ctx context.Context, id uuid.UUID, at time.Time, ip string) error {
+	stmt, err := conn(ctx, r.DB).PrepareContext(ctx, r.Dialect.Rebind("UPDATE users SET last_login_at = ?, last_login_ip = ? WHERE id = ? AND tenant_id = ? AND deleted_at IS NULL"))
 	if err != nil {
 		return err
 	}
 	defer stmt.Close()
 
-	_, err = stmt.ExecContext(ctx, user.Email, user.Password, user.ID)
+	_, err = stmt.ExecContext(ctx, at, ip, id, entity.TenantFromContext(ctx))
 	return err
 }
 
-func (r *UserRepository) Delete(ctx context.Context, id uuid.UUID) error {
-	stmt, err := r.DB.Prepare("DELETE FROM users WHERE id = ?")
+func (r *UserRepository) FindDeletedByEmail(ctx context.Context, email string) (*entity.User, error) {
+	stmt, err := conn(ctx, r.DB).PrepareContext(ctx, r.Dialect.Rebind(
+		"SELECT "+userColumns+" FROM users WHERE tenant_id = ? AND email_lookup = ? AND deleted_at IS NOT NULL",
+	))
+	if err != nil {
+		return nil, err
+	}
+	defer stmt.Close()
+
+	return scanUser(stmt.QueryRowContext(ctx, entity.TenantFromContext(ctx), entity.EmailLookupKey(email)))
+}
+
+// Restore fails with entity.ErrUserVersionConflict unless the user is deleted at the given version.
+func (r *UserRepository) Restore(ctx context.Context, id uuid.UUID, version int64, at time.Time) error {
+	stmt, err := conn(ctx, r.DB).PrepareContext(ctx, r.Dialect.Rebind(
+		"UPDATE users SET deleted_at = NULL, updated_at = ?, version = version + 1 "+
+			"WHERE id = ? AND tenant_id = ? AND version = ? AND deleted_at IS NOT NULL",
+	))
+	if err != nil {
+		return err
+	}
+	defer stmt.Close()
+
+	result, err := stmt.ExecContext(ctx, at, id, entity.TenantFromContext(ctx), version)
 	if err != nil {
 		return err
 	}
+
+	rows, err := result.RowsAffected()
+	if err != nil {
+		return err
+	}
+	if rows == 0 {
+		return entity.ErrUserVersionConflict
+	}
+
+	return nil
+}
+
+func (r *UserRepository) Purge(ctx context.Context, deletedBefore time.Time) (int64, error) {
+	stmt, err := conn(ctx, r.DB).PrepareContext(ctx, r.Dialect.Rebind(
+		"DELETE FROM users WHERE deleted_at IS NOT NULL AND deleted_at < ?",
+	))
+	if err != nil {
+		return 0, err
+	}
 	defer stmt.Close()
 
-	_, err = stmt.ExecContext(ctx, id)
+	result, err := stmt.ExecContext(ctx, deletedBefore)
+	if err != nil {
+		return 0, err
+	}
+
+	return result.RowsAffected()
+}
+
+func (r *UserRepository) SetStatus(ctx context.Context, id uuid.UUID, status entity.UserStatus, at time.Time) error {
+	stmt, err := conn(ctx, r.DB).PrepareContext(ctx, r.Dialect.Rebind(
+		"UPDATE users SET status = ?, updated_at = ?, version = version + 1 WHERE id = ? AND tenant_id = ? AND deleted_at IS NULL",
+	))
+	if err != nil {
+		return err
+	}
+	defer stmt.Close()
+
+	_, err = stmt.ExecContext(ctx, status, at, id, entity.TenantFromContext(ctx))
 	return err
 }
+
+func (r *UserRepository) List(ctx context.Context, filter entity.UserListFilter) (*entity.UserPage, error) {
+	where := "tenant_id = ? AND deleted_at IS NULL"
+	args := []interface{}{entity.TenantFromContext(ctx)}
+	if prefix := filter.EmailLookupPrefix(); prefix != "" {
+		where += " AND email_lookup LIKE ? ESCAPE '!'"
+		args = append(args, likeEscaper.Replace(prefix)+"%")
+	}
+	if len(filter.Statuses) > 0 {
+		where += " AND status IN (?" + strings.Repeat(", ?", len(filter.Statuses)-1) + ")"
+		for _, status := range filter.Statuses {
+			args = append(args, string(status))
+		}
+	}
+
+	page := &entity.UserPage{}
+	err := conn(ctx, r.DB).QueryRowContext(ctx, r.Dialect.Rebind("SELECT COUNT(*) FROM users WHERE "+where), args...).Scan(&page.Total)
+	if err != nil {
+		return nil, err
+	}
+
+	if filter.After != nil {
+		where += " AND (created_at > ? OR (created_at = ? AND id > ?))"
+		args = append(args, filter.After.CreatedAt, filter.After.CreatedAt, filter.After.ID)
+	}
+	query := "SELECT " + userColumns + " FROM users WHERE " + where + " ORDER BY created_at, id"
+	if filter.Limit > 0 {
+		// One more row than asked tells whether there is a next page.
+		query += " LIMIT ?"
+		args = append(args, filter.Limit+1)
+	}
+
+	rows, err := conn(ctx, r.DB).QueryContext(ctx, r.Dialect.Rebind(query), args...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	page.Users = make([]entity.User, 0)
+	for rows.Next() {
+		user, err := scanUser(rows)
+		if err != nil {
+			return nil, err
+		}
+		page.Users = append(page.Users, *user)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+
+	if filter.Limit > 0 && len(page.Users) > filter.Limit {
+		page.Users = page.Users[:filter.Limit]
+		next := entity.NewUserCursor(page.Users[len(page.Users)-1])
+		page.Next = &next
+	}
+
+	return page, nil
+}
+
+// checkVersion tells apart a write that missed because the row is gone or deleted, which is not an error,
+// from one that missed because another write changed the version first.
+func (r *UserRepository) checkVersion(ctx context.Context, result sql.Result, id uuid.UUID) error {
+	rows, err := result.RowsAffected()
+	if err != nil {
+		return err
+	}
+	if rows > 0 {
+		return nil
+	}
+
+	_, err = r.FindById(ctx, id)
+	if err == sql.ErrNoRows {
+		return nil
+	}
+	if err != nil {
+		return err
+	}
+
+	return entity.ErrUserVersionConflict
+}
+
+type scanner interface {
+	Scan(dest ...interface{}) error
+}
+
+func scanUser(row scanner) (*entity.User, error) {
+	var user entity.User
+	var lastLoginAt sql.NullTime
+	var deletedAt sql.NullTime
+
+	err := row.Scan(
+		&user.ID,
+		&user.TenantID,
+		&user.Email,
+		&user.Password,
+		&user.CreatedAt,
+		&user.UpdatedAt,
+		&user.PasswordChangedAt,
+		&lastLoginAt,
+		&user.LastLoginIP,
+		&deletedAt,
+		&user.Status,
+		&user.Version,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	user.CreatedAt = user.CreatedAt.UTC()
+	user.UpdatedAt = user.UpdatedAt.UTC()
+	user.PasswordChangedAt = user.PasswordChangedAt.UTC()
+	if lastLoginAt.Valid {
+		at := lastLoginAt.Time.UTC()
+		user.LastLoginAt = &at
+	}
+	if deletedAt.Valid {
+		at := deletedAt.Time.UTC()
+		user.DeletedAt = &at
+	}
+
+	return &user, nil
+}
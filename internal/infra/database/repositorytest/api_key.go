@@ -0,0 +1,136 @@
+package repositorytest
+
+import (
+	"context"
+	"database/sql"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/sesaquecruz/go-auth-api/internal/entity"
+	"github.com/stretchr/testify/suite"
+)
+
+type APIKeyRepositorySuite struct {
+	suite.Suite
+	NewRepositories func() (entity.UserRepositoryInterface, entity.TenantRepositoryInterface, entity.APIKeyRepositoryInterface)
+
+	userRepository   entity.UserRepositoryInterface
+	tenantRepository entity.TenantRepositoryInterface
+	apiKeyRepository entity.APIKeyRepositoryInterface
+	ctx              context.Context
+	user             *entity.User
+}
+
+func (s *APIKeyRepositorySuite) SetupTest() {
+	s.userRepository, s.tenantRepository, s.apiKeyRepository = s.NewRepositories()
+	s.ctx = context.Background()
+
+	var err error
+	s.user, err = entity.NewUserFactory().NewUser("user@mail.com", "12345")
+	s.Require().Nil(err)
+	err = s.userRepository.Save(s.ctx, *s.user)
+	s.Require().Nil(err)
+}
+
+func (s *APIKeyRepositorySuite) newAPIKey(name string, scopes ...string) *entity.APIKey {
+	key, _, err := entity.NewAPIKey(s.user.ID, name, scopes, time.Hour)
+	s.Require().Nil(err)
+	return key
+}
+
+func (s *APIKeyRepositorySuite) Test_APIKeyRepository_Save() {
+	key := s.newAPIKey("ci", "users:admin", "users:read")
+
+	err := s.apiKeyRepository.Save(s.ctx, *key)
+	s.Nil(err)
+
+	found, err := s.apiKeyRepository.FindByPrefix(s.ctx, key.Prefix)
+	s.Nil(err)
+	s.Equal(key, found)
+
+	duplicated := *s.newAPIKey("cli")
+	duplicated.Prefix = key.Prefix
+	err = s.apiKeyRepository.Save(s.ctx, duplicated)
+	s.NotNil(err)
+
+	_, err = s.apiKeyRepository.FindByPrefix(s.ctx, "unknown")
+	s.ErrorIs(err, sql.ErrNoRows)
+}
+
+func (s *APIKeyRepositorySuite) Test_APIKeyRepository_FindByUser() {
+	key1 := s.newAPIKey("ci")
+	key2 := s.newAPIKey("cli", "users:read")
+	key2.CreatedAt = key1.CreatedAt.Add(time.Second)
+
+	for _, key := range []*entity.APIKey{key1, key2} {
+		err := s.apiKeyRepository.Save(s.ctx, *key)
+		s.Require().Nil(err)
+	}
+
+	keys, err := s.apiKeyRepository.FindByUser(s.ctx, s.user.ID)
+	s.Nil(err)
+	s.Equal([]entity.APIKey{*key1, *key2}, keys)
+
+	keys, err = s.apiKeyRepository.FindByUser(s.ctx, uuid.New())
+	s.Nil(err)
+	s.Empty(keys)
+}
+
+func (s *APIKeyRepositorySuite) Test_APIKeyRepository_Revoke() {
+	key := s.newAPIKey("ci")
+	err := s.apiKeyRepository.Save(s.ctx, *key)
+	s.Require().Nil(err)
+
+	err = s.apiKeyRepository.Revoke(s.ctx, key.ID, uuid.New(), entity.Now())
+	s.ErrorIs(err, sql.ErrNoRows)
+
+	at := entity.Now()
+	err = s.apiKeyRepository.Revoke(s.ctx, key.ID, s.user.ID, at)
+	s.Nil(err)
+	err = s.apiKeyRepository.Revoke(s.ctx, key.ID, s.user.ID, at)
+	s.ErrorIs(err, sql.ErrNoRows)
+
+	found, err := s.apiKeyRepository.FindByPrefix(s.ctx, key.Prefix)
+	s.Nil(err)
+	s.Equal(&at, found.RevokedAt)
+
+	keys, err := s.apiKeyRepository.FindByUser(s.ctx, s.user.ID)
+	s.Nil(err)
+	s.Empty(keys)
+}
+
+func (s *APIKeyRepositorySuite) Test_APIKeyRepository_RecordUse() {
+	key := s.newAPIKey("ci")
+	err := s.apiKeyRepository.Save(s.ctx, *key)
+	s.Require().Nil(err)
+
+	at := entity.Now()
+	err = s.apiKeyRepository.RecordUse(s.ctx, key.ID, at)
+	s.Nil(err)
+
+	found, err := s.apiKeyRepository.FindByPrefix(s.ctx, key.Prefix)
+	s.Nil(err)
+	s.Equal(&at, found.LastUsedAt)
+}
+
+func (s *APIKeyRepositorySuite) Test_APIKeyRepository_IsolatesTenants() {
+	key := s.newAPIKey("ci")
+	err := s.apiKeyRepository.Save(s.ctx, *key)
+	s.Require().Nil(err)
+
+	tenant, err := entity.NewTenant("globex", "Globex", "")
+	s.Require().Nil(err)
+	err = s.tenantRepository.Save(s.ctx, *tenant)
+	s.Require().Nil(err)
+	ctx := entity.WithTenant(s.ctx, tenant.ID)
+
+	_, err = s.apiKeyRepository.FindByPrefix(ctx, key.Prefix)
+	s.ErrorIs(err, sql.ErrNoRows)
+
+	keys, err := s.apiKeyRepository.FindByUser(ctx, s.user.ID)
+	s.Nil(err)
+	s.Empty(keys)
+
+	err = s.apiKeyRepository.Revoke(ctx, key.ID, s.user.ID, entity.Now())
+	s.ErrorIs(err, sql.ErrNoRows)
+}
@@ -0,0 +1,164 @@
+package repositorytest
+
+import (
+	"context"
+	"database/sql"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/sesaquecruz/go-auth-api/internal/entity"
+	"github.com/stretchr/testify/suite"
+)
+
+type OrganizationRepositorySuite struct {
+	suite.Suite
+	NewRepositories func() (entity.UserRepositoryInterface, entity.TenantRepositoryInterface, entity.OrganizationRepositoryInterface, entity.InvitationRepositoryInterface)
+
+	userRepository         entity.UserRepositoryInterface
+	tenantRepository       entity.TenantRepositoryInterface
+	organizationRepository entity.OrganizationRepositoryInterface
+	invitationRepository   entity.InvitationRepositoryInterface
+	ctx                    context.Context
+	user1                  *entity.User
+	user2                  *entity.User
+	organization           *entity.Organization
+}
+
+func (s *OrganizationRepositorySuite) SetupTest() {
+	s.userRepository, s.tenantRepository, s.organizationRepository, s.invitationRepository = s.NewRepositories()
+	s.ctx = context.Background()
+
+	var err error
+	s.user1, err = entity.NewUserFactory().NewUser("user1@mail.com", "12345")
+	s.Require().Nil(err)
+	s.user1.TenantID = entity.DefaultTenantID
+	s.user2, err = entity.NewUserFactory().NewUser("user2@mail.com", "12345")
+	s.Require().Nil(err)
+	s.user2.TenantID = entity.DefaultTenantID
+
+	for _, user := range []*entity.User{s.user1, s.user2} {
+		err = s.userRepository.Save(s.ctx, *user)
+		s.Require().Nil(err)
+	}
+
+	s.organization, err = entity.NewOrganization("Acme")
+	s.Require().Nil(err)
+	s.organization.TenantID = entity.DefaultTenantID
+}
+
+func (s *OrganizationRepositorySuite) Test_OrganizationRepository_Save() {
+	err := s.organizationRepository.Save(s.ctx, *s.organization)
+	s.Nil(err)
+
+	organization, err := s.organizationRepository.FindById(s.ctx, s.organization.ID)
+	s.Nil(err)
+	s.Equal(s.organization, organization)
+
+	_, err = s.organizationRepository.FindById(s.ctx, uuid.New())
+	s.ErrorIs(err, sql.ErrNoRows)
+}
+
+func (s *OrganizationRepositorySuite) Test_OrganizationRepository_Members() {
+	err := s.organizationRepository.Save(s.ctx, *s.organization)
+	s.Require().Nil(err)
+
+	now := entity.Now()
+	owner := entity.Membership{OrganizationID: s.organization.ID, UserID: s.user1.ID, Role: entity.OrganizationRoleOwner, CreatedAt: now}
+	member := entity.Membership{OrganizationID: s.organization.ID, UserID: s.user2.ID, Role: entity.OrganizationRoleMember, CreatedAt: now.Add(time.Second)}
+
+	err = s.organizationRepository.AddMember(s.ctx, owner)
+	s.Nil(err)
+	err = s.organizationRepository.AddMember(s.ctx, member)
+	s.Nil(err)
+	err = s.organizationRepository.AddMember(s.ctx, member)
+	s.ErrorIs(err, entity.ErrMembershipAlreadyExists)
+
+	found, err := s.organizationRepository.FindMember(s.ctx, s.organization.ID, s.user2.ID)
+	s.Nil(err)
+	s.Equal(&member, found)
+
+	members, err := s.organizationRepository.ListMembers(s.ctx, s.organization.ID)
+	s.Nil(err)
+	s.Equal([]entity.Membership{owner, member}, members)
+
+	err = s.organizationRepository.SetMemberRole(s.ctx, s.organization.ID, s.user2.ID, entity.OrganizationRoleAdmin)
+	s.Nil(err)
+	found, err = s.organizationRepository.FindMember(s.ctx, s.organization.ID, s.user2.ID)
+	s.Nil(err)
+	s.Equal(entity.OrganizationRoleAdmin, found.Role)
+
+	err = s.organizationRepository.RemoveMember(s.ctx, s.organization.ID, s.user2.ID)
+	s.Nil(err)
+	_, err = s.organizationRepository.FindMember(s.ctx, s.organization.ID, s.user2.ID)
+	s.ErrorIs(err, sql.ErrNoRows)
+
+	members, err = s.organizationRepository.ListMembers(s.ctx, s.organization.ID)
+	s.Nil(err)
+	s.Equal([]entity.Membership{owner}, members)
+}
+
+func (s *OrganizationRepositorySuite) Test_OrganizationRepository_IsolatesTenants() {
+	err := s.organizationRepository.Save(s.ctx, *s.organization)
+	s.Require().Nil(err)
+	owner := entity.Membership{OrganizationID: s.organization.ID, UserID: s.user1.ID, Role: entity.OrganizationRoleOwner, CreatedAt: entity.Now()}
+	err = s.organizationRepository.AddMember(s.ctx, owner)
+	s.Require().Nil(err)
+
+	tenant, err := entity.NewTenant("globex", "Globex", "")
+	s.Require().Nil(err)
+	err = s.tenantRepository.Save(s.ctx, *tenant)
+	s.Require().Nil(err)
+	ctx := entity.WithTenant(s.ctx, tenant.ID)
+
+	_, err = s.organizationRepository.FindById(ctx, s.organization.ID)
+	s.ErrorIs(err, sql.ErrNoRows)
+	_, err = s.organizationRepository.FindMember(ctx, s.organization.ID, s.user1.ID)
+	s.ErrorIs(err, sql.ErrNoRows)
+
+	members, err := s.organizationRepository.ListMembers(ctx, s.organization.ID)
+	s.Nil(err)
+	s.Empty(members)
+
+	// Writes from another tenant do not reach the members.
+	err = s.organizationRepository.SetMemberRole(ctx, s.organization.ID, s.user1.ID, entity.OrganizationRoleMember)
+	s.Nil(err)
+	err = s.organizationRepository.RemoveMember(ctx, s.organization.ID, s.user1.ID)
+	s.Nil(err)
+
+	found, err := s.organizationRepository.FindMember(s.ctx, s.organization.ID, s.user1.ID)
+	s.Nil(err)
+	s.Equal(entity.OrganizationRoleOwner, found.Role)
+}
+
+func (s *OrganizationRepositorySuite) Test_InvitationRepository() {
+	err := s.organizationRepository.Save(s.ctx, *s.organization)
+	s.Require().Nil(err)
+
+	invitation, err := entity.NewInvitation(s.organization.ID, "guest@mail.com", entity.OrganizationRoleMember, s.user1.ID, time.Hour)
+	s.Require().Nil(err)
+
+	err = s.invitationRepository.Save(s.ctx, *invitation)
+	s.Nil(err)
+
+	found, err := s.invitationRepository.FindById(s.ctx, invitation.ID)
+	s.Nil(err)
+	s.Equal(invitation, found)
+
+	tenant, err := entity.NewTenant("globex", "Globex", "")
+	s.Require().Nil(err)
+	err = s.tenantRepository.Save(s.ctx, *tenant)
+	s.Require().Nil(err)
+	ctx := entity.WithTenant(s.ctx, tenant.ID)
+
+	_, err = s.invitationRepository.FindById(ctx, invitation.ID)
+	s.ErrorIs(err, sql.ErrNoRows)
+	err = s.invitationRepository.Delete(ctx, invitation.ID)
+	s.Nil(err)
+	_, err = s.invitationRepository.FindById(s.ctx, invitation.ID)
+	s.Nil(err)
+
+	err = s.invitationRepository.Delete(s.ctx, invitation.ID)
+	s.Nil(err)
+	_, err = s.invitationRepository.FindById(s.ctx, invitation.ID)
+	s.ErrorIs(err, sql.ErrNoRows)
+}
@@ -0,0 +1,109 @@
+package repositorytest
+
+import (
+	"context"
+	"database/sql"
+
+	"github.com/google/uuid"
+	"github.com/sesaquecruz/go-auth-api/internal/entity"
+	"github.com/stretchr/testify/suite"
+)
+
+type RoleRepositorySuite struct {
+	suite.Suite
+	NewRepositories func() (entity.UserRepositoryInterface, entity.RoleRepositoryInterface)
+
+	userRepository entity.UserRepositoryInterface
+	roleRepository entity.RoleRepositoryInterface
+	ctx            context.Context
+	user           *entity.User
+	editor         entity.Role
+	viewer         entity.Role
+}
+
+func (s *RoleRepositorySuite) SetupTest() {
+	s.userRepository, s.roleRepository = s.NewRepositories()
+	s.ctx = context.Background()
+	now := entity.Now()
+	s.user = &entity.User{ID: uuid.New(), Email: "user@mail.com", Password: "12345", CreatedAt: now, UpdatedAt: now, PasswordChangedAt: now, Version: 1}
+	s.editor = entity.Role{Name: "editor", Description: "Edits", Permissions: []string{"posts:write", "posts:read"}}
+	s.viewer = entity.Role{Name: "viewer", Permissions: []string{}}
+
+	err := s.userRepository.Save(s.ctx, *s.user)
+	s.Require().Nil(err)
+}
+
+func (s *RoleRepositorySuite) Test_RoleRepository_Save() {
+	err := s.roleRepository.Save(s.ctx, s.editor)
+	s.Nil(err)
+
+	err = s.roleRepository.Save(s.ctx, s.editor)
+	s.ErrorIs(err, entity.ErrRoleAlreadyExists)
+}
+
+func (s *RoleRepositorySuite) Test_RoleRepository_FindByName() {
+	err := s.roleRepository.Save(s.ctx, s.editor)
+	s.Nil(err)
+
+	role, err := s.roleRepository.FindByName(s.ctx, s.editor.Name)
+	s.Nil(err)
+	s.Equal(s.editor.Name, role.Name)
+	s.Equal(s.editor.Description, role.Description)
+	s.Equal([]string{"posts:read", "posts:write"}, role.Permissions)
+
+	role, err = s.roleRepository.FindByName(s.ctx, "unknown")
+	s.ErrorIs(err, sql.ErrNoRows)
+	s.Nil(role)
+}
+
+func (s *RoleRepositorySuite) Test_RoleRepository_AssignToUser() {
+	err := s.roleRepository.Save(s.ctx, s.editor)
+	s.Nil(err)
+
+	err = s.roleRepository.Save(s.ctx, s.viewer)
+	s.Nil(err)
+
+	roles, err := s.roleRepository.FindByUserId(s.ctx, s.user.ID)
+	s.Nil(err)
+	s.Empty(roles)
+
+	err = s.roleRepository.AssignToUser(s.ctx, s.user.ID, s.viewer.Name)
+	s.Nil(err)
+
+	err = s.roleRepository.AssignToUser(s.ctx, s.user.ID, s.editor.Name)
+	s.Nil(err)
+
+	err = s.roleRepository.AssignToUser(s.ctx, s.user.ID, s.editor.Name)
+	s.Nil(err)
+
+	roles, err = s.roleRepository.FindByUserId(s.ctx, s.user.ID)
+	s.Nil(err)
+	s.Len(roles, 2)
+	s.Equal("editor", roles[0].Name)
+	s.Equal([]string{"posts:read", "posts:write"}, roles[0].Permissions)
+	s.Equal("viewer", roles[1].Name)
+	s.Equal([]string{}, roles[1].Permissions)
+}
+
+func (s *RoleRepositorySuite) Test_RoleRepository_AssignToUser_WhenRoleNotExists() {
+	err := s.roleRepository.AssignToUser(s.ctx, s.user.ID, "unknown")
+	s.ErrorIs(err, entity.ErrRoleNotFound)
+}
+
+func (s *RoleRepositorySuite) Test_RoleRepository_RevokeFromUser() {
+	err := s.roleRepository.Save(s.ctx, s.editor)
+	s.Nil(err)
+
+	err = s.roleRepository.AssignToUser(s.ctx, s.user.ID, s.editor.Name)
+	s.Nil(err)
+
+	err = s.roleRepository.RevokeFromUser(s.ctx, s.user.ID, s.editor.Name)
+	s.Nil(err)
+
+	err = s.roleRepository.RevokeFromUser(s.ctx, s.user.ID, s.editor.Name)
+	s.Nil(err)
+
+	roles, err := s.roleRepository.FindByUserId(s.ctx, s.user.ID)
+	s.Nil(err)
+	s.Empty(roles)
+}
@@ -0,0 +1,161 @@
+package repositorytest
+
+import (
+	"context"
+	"database/sql"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/sesaquecruz/go-auth-api/internal/entity"
+	"github.com/stretchr/testify/suite"
+)
+
+type SessionRepositorySuite struct {
+	suite.Suite
+	NewRepositories func() (entity.UserRepositoryInterface, entity.TenantRepositoryInterface, entity.SessionRepositoryInterface)
+
+	userRepository    entity.UserRepositoryInterface
+	tenantRepository  entity.TenantRepositoryInterface
+	sessionRepository entity.SessionRepositoryInterface
+	ctx               context.Context
+	user              *entity.User
+}
+
+func (s *SessionRepositorySuite) SetupTest() {
+	s.userRepository, s.tenantRepository, s.sessionRepository = s.NewRepositories()
+	s.ctx = context.Background()
+
+	var err error
+	s.user, err = entity.NewUserFactory().NewUser("user@mail.com", "12345")
+	s.Require().Nil(err)
+	err = s.userRepository.Save(s.ctx, *s.user)
+	s.Require().Nil(err)
+}
+
+func (s *SessionRepositorySuite) newSession(ttl time.Duration) *entity.Session {
+	session, err := entity.NewSession(s.user.ID, "curl/8.0", "127.0.0.1", ttl)
+	s.Require().Nil(err)
+	err = s.sessionRepository.Save(s.ctx, *session)
+	s.Require().Nil(err)
+	return session
+}
+
+func (s *SessionRepositorySuite) Test_SessionRepository_Save() {
+	session := s.newSession(time.Hour)
+
+	found, err := s.sessionRepository.FindById(s.ctx, session.ID)
+	s.Nil(err)
+	s.Equal(session, found)
+
+	err = s.sessionRepository.Save(s.ctx, *session)
+	s.NotNil(err)
+
+	_, err = s.sessionRepository.FindById(s.ctx, uuid.New())
+	s.ErrorIs(err, sql.ErrNoRows)
+}
+
+func (s *SessionRepositorySuite) Test_SessionRepository_FindActiveByUser() {
+	session1 := s.newSession(time.Hour)
+	session2, err := entity.NewSession(s.user.ID, "", "", time.Hour)
+	s.Require().Nil(err)
+	session2.CreatedAt = session1.CreatedAt.Add(time.Second)
+	err = s.sessionRepository.Save(s.ctx, *session2)
+	s.Require().Nil(err)
+	s.newSession(time.Minute)
+
+	sessions, err := s.sessionRepository.FindActiveByUser(s.ctx, s.user.ID, entity.Now().Add(time.Minute))
+	s.Nil(err)
+	s.Equal([]entity.Session{*session1, *session2}, sessions)
+
+	sessions, err = s.sessionRepository.FindActiveByUser(s.ctx, uuid.New(), entity.Now())
+	s.Nil(err)
+	s.Empty(sessions)
+}
+
+func (s *SessionRepositorySuite) Test_SessionRepository_Touch() {
+	session := s.newSession(time.Hour)
+
+	at := entity.Now().Add(time.Minute)
+	err := s.sessionRepository.Touch(s.ctx, session.ID, at)
+	s.Nil(err)
+
+	found, err := s.sessionRepository.FindById(s.ctx, session.ID)
+	s.Nil(err)
+	s.Equal(at, found.LastSeenAt)
+}
+
+func (s *SessionRepositorySuite) Test_SessionRepository_Revoke() {
+	session := s.newSession(time.Hour)
+
+	err := s.sessionRepository.Revoke(s.ctx, session.ID, uuid.New(), entity.Now())
+	s.ErrorIs(err, sql.ErrNoRows)
+
+	at := entity.Now()
+	err = s.sessionRepository.Revoke(s.ctx, session.ID, s.user.ID, at)
+	s.Nil(err)
+	err = s.sessionRepository.Revoke(s.ctx, session.ID, s.user.ID, at)
+	s.ErrorIs(err, sql.ErrNoRows)
+
+	found, err := s.sessionRepository.FindById(s.ctx, session.ID)
+	s.Nil(err)
+	s.Equal(&at, found.RevokedAt)
+
+	sessions, err := s.sessionRepository.FindActiveByUser(s.ctx, s.user.ID, entity.Now())
+	s.Nil(err)
+	s.Empty(sessions)
+}
+
+func (s *SessionRepositorySuite) Test_SessionRepository_RevokeByUser() {
+	session1 := s.newSession(time.Hour)
+	session2 := s.newSession(time.Hour)
+
+	other, err := entity.NewUserFactory().NewUser("other@mail.com", "12345")
+	s.Require().Nil(err)
+	err = s.userRepository.Save(s.ctx, *other)
+	s.Require().Nil(err)
+	otherSession, err := entity.NewSession(other.ID, "", "", time.Hour)
+	s.Require().Nil(err)
+	err = s.sessionRepository.Save(s.ctx, *otherSession)
+	s.Require().Nil(err)
+
+	at := entity.Now()
+	err = s.sessionRepository.RevokeByUser(s.ctx, s.user.ID, at)
+	s.Nil(err)
+
+	for _, session := range []*entity.Session{session1, session2} {
+		found, err := s.sessionRepository.FindById(s.ctx, session.ID)
+		s.Nil(err)
+		s.Equal(&at, found.RevokedAt)
+	}
+
+	found, err := s.sessionRepository.FindById(s.ctx, otherSession.ID)
+	s.Nil(err)
+	s.Nil(found.RevokedAt)
+}
+
+func (s *SessionRepositorySuite) Test_SessionRepository_IsolatesTenants() {
+	session := s.newSession(time.Hour)
+
+	tenant, err := entity.NewTenant("globex", "Globex", "")
+	s.Require().Nil(err)
+	err = s.tenantRepository.Save(s.ctx, *tenant)
+	s.Require().Nil(err)
+	ctx := entity.WithTenant(s.ctx, tenant.ID)
+
+	_, err = s.sessionRepository.FindById(ctx, session.ID)
+	s.ErrorIs(err, sql.ErrNoRows)
+
+	sessions, err := s.sessionRepository.FindActiveByUser(ctx, s.user.ID, entity.Now())
+	s.Nil(err)
+	s.Empty(sessions)
+
+	err = s.sessionRepository.Revoke(ctx, session.ID, s.user.ID, entity.Now())
+	s.ErrorIs(err, sql.ErrNoRows)
+
+	err = s.sessionRepository.RevokeByUser(ctx, s.user.ID, entity.Now())
+	s.Nil(err)
+
+	found, err := s.sessionRepository.FindById(s.ctx, session.ID)
+	s.Nil(err)
+	s.Nil(found.RevokedAt)
+}
@@ -0,0 +1,134 @@
+package repositorytest
+
+import (
+	"context"
+	"database/sql"
+
+	"github.com/google/uuid"
+	"github.com/sesaquecruz/go-auth-api/internal/entity"
+	"github.com/stretchr/testify/suite"
+)
+
+type TenantRepositorySuite struct {
+	suite.Suite
+	NewRepositories func() (entity.UserRepositoryInterface, entity.TenantRepositoryInterface)
+
+	userRepository   entity.UserRepositoryInterface
+	tenantRepository entity.TenantRepositoryInterface
+	ctx              context.Context
+	tenant1          *entity.Tenant
+	tenant2          *entity.Tenant
+}
+
+func (s *TenantRepositorySuite) SetupTest() {
+	s.userRepository, s.tenantRepository = s.NewRepositories()
+	s.ctx = context.Background()
+
+	var err error
+	s.tenant1, err = entity.NewTenant("acme", "Acme", "auth.acme.com")
+	s.Require().Nil(err)
+	s.tenant2, err = entity.NewTenant("globex", "Globex", "")
+	s.Require().Nil(err)
+}
+
+func (s *TenantRepositorySuite) Test_TenantRepository_Save() {
+	err := s.tenantRepository.Save(s.ctx, *s.tenant1)
+	s.Nil(err)
+
+	duplicated := *s.tenant2
+	duplicated.Slug = s.tenant1.Slug
+	err = s.tenantRepository.Save(s.ctx, duplicated)
+	s.ErrorIs(err, entity.ErrTenantAlreadyExists)
+
+	duplicated = *s.tenant2
+	duplicated.Host = s.tenant1.Host
+	err = s.tenantRepository.Save(s.ctx, duplicated)
+	s.ErrorIs(err, entity.ErrTenantAlreadyExists)
+
+	err = s.tenantRepository.Save(s.ctx, *s.tenant2)
+	s.Nil(err)
+}
+
+func (s *TenantRepositorySuite) Test_TenantRepository_Find() {
+	for _, tenant := range []*entity.Tenant{s.tenant1, s.tenant2} {
+		err := s.tenantRepository.Save(s.ctx, *tenant)
+		s.Require().Nil(err)
+	}
+
+	tenant, err := s.tenantRepository.FindById(s.ctx, s.tenant1.ID)
+	s.Nil(err)
+	s.Equal(s.tenant1, tenant)
+
+	tenant, err = s.tenantRepository.FindBySlug(s.ctx, s.tenant2.Slug)
+	s.Nil(err)
+	s.Equal(s.tenant2, tenant)
+
+	tenant, err = s.tenantRepository.FindByHost(s.ctx, "Auth.Acme.com")
+	s.Nil(err)
+	s.Equal(s.tenant1, tenant)
+
+	tenant, err = s.tenantRepository.FindBySlug(s.ctx, "default")
+	s.Nil(err)
+	s.Equal(entity.DefaultTenantID, tenant.ID)
+
+	_, err = s.tenantRepository.FindById(s.ctx, uuid.New())
+	s.ErrorIs(err, sql.ErrNoRows)
+	_, err = s.tenantRepository.FindBySlug(s.ctx, "unknown")
+	s.ErrorIs(err, sql.ErrNoRows)
+	_, err = s.tenantRepository.FindByHost(s.ctx, "")
+	s.ErrorIs(err, sql.ErrNoRows)
+}
+
+func (s *TenantRepositorySuite) Test_TenantRepository_IsolatesUsers() {
+	for _, tenant := range []*entity.Tenant{s.tenant1, s.tenant2} {
+		err := s.tenantRepository.Save(s.ctx, *tenant)
+		s.Require().Nil(err)
+	}
+
+	ctx1 := entity.WithTenant(s.ctx, s.tenant1.ID)
+	ctx2 := entity.WithTenant(s.ctx, s.tenant2.ID)
+
+	now := entity.Now()
+	user1 := entity.User{ID: uuid.New(), Email: "user@mail.com", Password: "12345", CreatedAt: now, UpdatedAt: now, PasswordChangedAt: now, Status: entity.UserStatusActive, Version: 1}
+	user2 := user1
+	user2.ID = uuid.New()
+
+	// The same email can be taken once in each tenant.
+	err := s.userRepository.Save(ctx1, user1)
+	s.Nil(err)
+	err = s.userRepository.Save(ctx2, user2)
+	s.Nil(err)
+	err = s.userRepository.Save(ctx1, entity.User{ID: uuid.New(), Email: user1.Email, Password: "12345", CreatedAt: now, UpdatedAt: now, PasswordChangedAt: now, Status: entity.UserStatusActive, Version: 1})
+	s.ErrorIs(err, entity.ErrUserEmailAlreadyExists)
+
+	user, err := s.userRepository.FindByEmail(ctx1, user1.Email)
+	s.Nil(err)
+	s.Equal(user1.ID, user.ID)
+	s.Equal(s.tenant1.ID, user.TenantID)
+
+	user, err = s.userRepository.FindByEmail(ctx2, user1.Email)
+	s.Nil(err)
+	s.Equal(user2.ID, user.ID)
+	s.Equal(s.tenant2.ID, user.TenantID)
+
+	_, err = s.userRepository.FindById(ctx2, user1.ID)
+	s.ErrorIs(err, sql.ErrNoRows)
+	_, err = s.userRepository.FindByEmail(s.ctx, user1.Email)
+	s.ErrorIs(err, sql.ErrNoRows)
+
+	page, err := s.userRepository.List(ctx1, entity.UserListFilter{})
+	s.Nil(err)
+	s.Len(page.Users, 1)
+	s.Equal(int64(1), page.Total)
+
+	// Writes from another tenant do not reach the user.
+	err = s.userRepository.SetStatus(ctx2, user1.ID, entity.UserStatusSuspended, now)
+	s.Nil(err)
+	err = s.userRepository.Delete(ctx2, user1.ID, user1.Version, now)
+	s.Nil(err)
+
+	user, err = s.userRepository.FindById(ctx1, user1.ID)
+	s.Nil(err)
+	s.Equal(entity.UserStatusActive, user.Status)
+	s.Equal(user1.Version, user.Version)
+}
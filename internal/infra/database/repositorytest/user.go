@@ -0,0 +1,444 @@
+// Package repositorytest holds the conformance suites every repository implementation must pass.
+package repositorytest
+
+import (
+	"context"
+	"database/sql"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/sesaquecruz/go-auth-api/internal/entity"
+	"github.com/stretchr/testify/suite"
+)
+
+type UserRepositorySuite struct {
+	suite.Suite
+	NewRepository func() entity.UserRepositoryInterface
+
+	userRepository entity.UserRepositoryInterface
+	ctx            context.Context
+	user1          *entity.User
+	user2          *entity.User
+}
+
+func (s *UserRepositorySuite) SetupTest() {
+	s.userRepository = s.NewRepository()
+	s.ctx = context.Background()
+	now := entity.Now()
+	s.user1 = &entity.User{ID: uuid.New(), TenantID: entity.DefaultTenantID, Email: "user1@mail.com", Password: "12345", CreatedAt: now, UpdatedAt: now, PasswordChangedAt: now, Status: entity.UserStatusActive, Version: 1}
+	s.user2 = &entity.User{ID: uuid.New(), TenantID: entity.DefaultTenantID, Email: "user2@mail.com", Password: "54321", CreatedAt: now, UpdatedAt: now, PasswordChangedAt: now, Status: entity.UserStatusActive, Version: 1}
+}
+
+func (s *UserRepositorySuite) Test_UserRepository_Save() {
+	user, err := s.userRepository.FindById(s.ctx, s.user1.ID)
+	s.ErrorIs(err, sql.ErrNoRows)
+	s.Nil(user)
+
+	err = s.userRepository.Save(s.ctx, *s.user1)
+	s.Nil(err)
+
+	user, err = s.userRepository.FindById(s.ctx, s.user1.ID)
+	s.Nil(err)
+	s.Equal(s.user1, user)
+
+	err = s.userRepository.Save(s.ctx, *s.user1)
+	s.Error(err)
+}
+
+func (s *UserRepositorySuite) Test_UserRepository_Save_WhenEmailAlreadyExists() {
+	err := s.userRepository.Save(s.ctx, *s.user1)
+	s.Nil(err)
+
+	duplicated := *s.user2
+	duplicated.Email = s.user1.Email
+	err = s.userRepository.Save(s.ctx, duplicated)
+	s.ErrorIs(err, entity.ErrUserEmailAlreadyExists)
+
+	duplicated.Email = strings.ToUpper(s.user1.Email)
+	err = s.userRepository.Save(s.ctx, duplicated)
+	s.ErrorIs(err, entity.ErrUserEmailAlreadyExists)
+
+	user, err := s.userRepository.FindById(s.ctx, s.user2.ID)
+	s.ErrorIs(err, sql.ErrNoRows)
+	s.Nil(user)
+}
+
+func (s *UserRepositorySuite) Test_UserRepository_Save_WhenIDAlreadyExists() {
+	err := s.userRepository.Save(s.ctx, *s.user1)
+	s.Nil(err)
+
+	duplicated := *s.user2
+	duplicated.ID = s.user1.ID
+	err = s.userRepository.Save(s.ctx, duplicated)
+	s.Error(err)
+	s.NotErrorIs(err, entity.ErrUserEmailAlreadyExists)
+}
+
+func (s *UserRepositorySuite) Test_UserRepository_FindById() {
+	user, err := s.userRepository.FindById(s.ctx, s.user1.ID)
+	s.ErrorIs(err, sql.ErrNoRows)
+	s.Nil(user)
+
+	err = s.userRepository.Save(s.ctx, *s.user1)
+	s.Nil(err)
+
+	user, err = s.userRepository.FindById(s.ctx, s.user1.ID)
+	s.Nil(err)
+	s.Equal(s.user1, user)
+}
+
+func (s *UserRepositorySuite) Test_UserRepository_FindByEmail() {
+	user, err := s.userRepository.FindByEmail(s.ctx, s.user1.Email)
+	s.ErrorIs(err, sql.ErrNoRows)
+	s.Nil(user)
+
+	err = s.userRepository.Save(s.ctx, *s.user1)
+	s.Nil(err)
+
+	user, err = s.userRepository.FindByEmail(s.ctx, s.user1.Email)
+	s.Nil(err)
+	s.Equal(s.user1, user)
+
+	user, err = s.userRepository.FindByEmail(s.ctx, " "+strings.ToUpper(s.user1.Email))
+	s.Nil(err)
+	s.Equal(s.user1, user)
+}
+
+func (s *UserRepositorySuite) Test_UserRepository_Update() {
+	err := s.userRepository.Save(s.ctx, *s.user1)
+	s.Nil(err)
+
+	user, err := s.userRepository.FindById(s.ctx, s.user1.ID)
+	s.Nil(err)
+	s.Equal(s.user1, user)
+	s.NotEqual(s.user2.Email, user.Email)
+	s.NotEqual(s.user2.Password, user.Password)
+
+	updatedAt := s.user1.UpdatedAt.Add(time.Hour)
+	err = s.userRepository.Update(s.ctx, entity.User{
+		ID:                s.user1.ID,
+		Email:             s.user2.Email,
+		Password:          s.user2.Password,
+		CreatedAt:         updatedAt,
+		UpdatedAt:         updatedAt,
+		PasswordChangedAt: updatedAt,
+		Version:           s.user1.Version,
+	})
+	s.Nil(err)
+
+	user, err = s.userRepository.FindById(s.ctx, s.user1.ID)
+	s.Nil(err)
+	s.Equal(s.user1.ID, user.ID)
+	s.Equal(s.user2.Email, user.Email)
+	s.Equal(s.user2.Password, user.Password)
+	s.Equal(s.user1.CreatedAt, user.CreatedAt)
+	s.Equal(updatedAt, user.UpdatedAt)
+	s.Equal(updatedAt, user.PasswordChangedAt)
+	s.Equal(s.user1.Version+1, user.Version)
+
+	err = s.userRepository.Update(s.ctx, *s.user2)
+	s.Nil(err)
+
+	user, err = s.userRepository.FindById(s.ctx, s.user2.ID)
+	s.ErrorIs(err, sql.ErrNoRows)
+	s.Nil(user)
+}
+
+func (s *UserRepositorySuite) Test_UserRepository_Update_WhenEmailAlreadyExists() {
+	err := s.userRepository.Save(s.ctx, *s.user1)
+	s.Nil(err)
+
+	err = s.userRepository.Save(s.ctx, *s.user2)
+	s.Nil(err)
+
+	user := *s.user2
+	user.Email = s.user1.Email
+	err = s.userRepository.Update(s.ctx, user)
+	s.ErrorIs(err, entity.ErrUserEmailAlreadyExists)
+
+	found, err := s.userRepository.FindById(s.ctx, s.user2.ID)
+	s.Nil(err)
+	s.Equal(s.user2, found)
+}
+
+func (s *UserRepositorySuite) Test_UserRepository_Update_WhenVersionIsStale() {
+	err := s.userRepository.Save(s.ctx, *s.user1)
+	s.Nil(err)
+
+	user := *s.user1
+	user.Email = s.user2.Email
+	err = s.userRepository.Update(s.ctx, user)
+	s.Nil(err)
+
+	user.Email = "user3@mail.com"
+	err = s.userRepository.Update(s.ctx, user)
+	s.ErrorIs(err, entity.ErrUserVersionConflict)
+
+	found, err := s.userRepository.FindById(s.ctx, s.user1.ID)
+	s.Nil(err)
+	s.Equal(s.user2.Email, found.Email)
+	s.Equal(s.user1.Version+1, found.Version)
+}
+
+func (s *UserRepositorySuite) Test_UserRepository_Delete_WhenVersionIsStale() {
+	err := s.userRepository.Save(s.ctx, *s.user1)
+	s.Nil(err)
+
+	err = s.userRepository.Delete(s.ctx, s.user1.ID, s.user1.Version+1, entity.Now())
+	s.ErrorIs(err, entity.ErrUserVersionConflict)
+
+	user, err := s.userRepository.FindById(s.ctx, s.user1.ID)
+	s.Nil(err)
+	s.Equal(s.user1, user)
+}
+
+func (s *UserRepositorySuite) Test_UserRepository_Delete() {
+	err := s.userRepository.Save(s.ctx, *s.user1)
+	s.Nil(err)
+
+	err = s.userRepository.Save(s.ctx, *s.user2)
+	s.Nil(err)
+
+	user, err := s.userRepository.FindById(s.ctx, s.user1.ID)
+	s.Nil(err)
+	s.Equal(s.user1, user)
+
+	user, err = s.userRepository.FindById(s.ctx, s.user2.ID)
+	s.Nil(err)
+	s.Equal(s.user2, user)
+
+	err = s.userRepository.Delete(s.ctx, s.user1.ID, s.user1.Version, entity.Now())
+	s.Nil(err)
+
+	user, err = s.userRepository.FindById(s.ctx, s.user1.ID)
+	s.ErrorIs(err, sql.ErrNoRows)
+	s.Nil(user)
+
+	err = s.userRepository.Delete(s.ctx, s.user2.ID, s.user2.Version, entity.Now())
+	s.Nil(err)
+
+	user, err = s.userRepository.FindById(s.ctx, s.user2.ID)
+	s.ErrorIs(err, sql.ErrNoRows)
+	s.Nil(user)
+
+	err = s.userRepository.Delete(s.ctx, s.user2.ID, s.user2.Version, entity.Now())
+	s.Nil(err)
+}
+
+func (s *UserRepositorySuite) Test_UserRepository_Delete_KeepsEmailTaken() {
+	err := s.userRepository.Save(s.ctx, *s.user1)
+	s.Nil(err)
+
+	err = s.userRepository.Delete(s.ctx, s.user1.ID, s.user1.Version, entity.Now())
+	s.Nil(err)
+
+	user, err := s.userRepository.FindByEmail(s.ctx, s.user1.Email)
+	s.ErrorIs(err, sql.ErrNoRows)
+	s.Nil(user)
+
+	s.user2.Email = s.user1.Email
+	err = s.userRepository.Save(s.ctx, *s.user2)
+	s.ErrorIs(err, entity.ErrUserEmailAlreadyExists)
+}
+
+func (s *UserRepositorySuite) Test_UserRepository_FindDeletedByEmail() {
+	err := s.userRepository.Save(s.ctx, *s.user1)
+	s.Nil(err)
+
+	user, err := s.userRepository.FindDeletedByEmail(s.ctx, s.user1.Email)
+	s.ErrorIs(err, sql.ErrNoRows)
+	s.Nil(user)
+
+	at := s.user1.CreatedAt.Add(time.Minute)
+	err = s.userRepository.Delete(s.ctx, s.user1.ID, s.user1.Version, at)
+	s.Nil(err)
+
+	user, err = s.userRepository.FindDeletedByEmail(s.ctx, strings.ToUpper(s.user1.Email))
+	s.Nil(err)
+	s.Equal(s.user1.ID, user.ID)
+	s.Equal(&at, user.DeletedAt)
+	s.Equal(s.user1.Version+1, user.Version)
+}
+
+func (s *UserRepositorySuite) Test_UserRepository_Restore() {
+	err := s.userRepository.Save(s.ctx, *s.user1)
+	s.Nil(err)
+
+	err = s.userRepository.Delete(s.ctx, s.user1.ID, s.user1.Version, entity.Now())
+	s.Nil(err)
+
+	at := s.user1.CreatedAt.Add(time.Minute)
+	err = s.userRepository.Restore(s.ctx, s.user1.ID, s.user1.Version+1, at)
+	s.Nil(err)
+
+	user, err := s.userRepository.FindById(s.ctx, s.user1.ID)
+	s.Nil(err)
+	s.Nil(user.DeletedAt)
+	s.Equal(at, user.UpdatedAt)
+	s.Equal(s.user1.Version+2, user.Version)
+
+	err = s.userRepository.Restore(s.ctx, s.user1.ID, user.Version, at)
+	s.ErrorIs(err, entity.ErrUserVersionConflict)
+}
+
+func (s *UserRepositorySuite) Test_UserRepository_Restore_WhenVersionIsStale() {
+	err := s.userRepository.Save(s.ctx, *s.user1)
+	s.Nil(err)
+
+	err = s.userRepository.Delete(s.ctx, s.user1.ID, s.user1.Version, entity.Now())
+	s.Nil(err)
+
+	err = s.userRepository.Restore(s.ctx, s.user1.ID, s.user1.Version, entity.Now())
+	s.ErrorIs(err, entity.ErrUserVersionConflict)
+
+	_, err = s.userRepository.FindById(s.ctx, s.user1.ID)
+	s.ErrorIs(err, sql.ErrNoRows)
+}
+
+func (s *UserRepositorySuite) Test_UserRepository_Purge() {
+	err := s.userRepository.Save(s.ctx, *s.user1)
+	s.Nil(err)
+
+	err = s.userRepository.Save(s.ctx, *s.user2)
+	s.Nil(err)
+
+	now := entity.Now()
+	err = s.userRepository.Delete(s.ctx, s.user1.ID, s.user1.Version, now.Add(-time.Hour))
+	s.Nil(err)
+
+	err = s.userRepository.Delete(s.ctx, s.user2.ID, s.user2.Version, now)
+	s.Nil(err)
+
+	purged, err := s.userRepository.Purge(s.ctx, now.Add(-time.Minute))
+	s.Nil(err)
+	s.Equal(int64(1), purged)
+
+	_, err = s.userRepository.FindDeletedByEmail(s.ctx, s.user1.Email)
+	s.ErrorIs(err, sql.ErrNoRows)
+
+	_, err = s.userRepository.FindDeletedByEmail(s.ctx, s.user2.Email)
+	s.Nil(err)
+
+	err = s.userRepository.Save(s.ctx, *s.user1)
+	s.Nil(err)
+}
+
+func (s *UserRepositorySuite) Test_UserRepository_SetStatus() {
+	err := s.userRepository.Save(s.ctx, *s.user1)
+	s.Nil(err)
+
+	at := s.user1.CreatedAt.Add(time.Minute)
+	err = s.userRepository.SetStatus(s.ctx, s.user1.ID, entity.UserStatusSuspended, at)
+	s.Nil(err)
+
+	user, err := s.userRepository.FindById(s.ctx, s.user1.ID)
+	s.Nil(err)
+	s.Equal(entity.UserStatusSuspended, user.Status)
+	s.Equal(at, user.UpdatedAt)
+	s.Equal(s.user1.Version+1, user.Version)
+
+	err = s.userRepository.SetStatus(s.ctx, s.user2.ID, entity.UserStatusSuspended, at)
+	s.Nil(err)
+}
+
+func (s *UserRepositorySuite) Test_UserRepository_List() {
+	page, err := s.userRepository.List(s.ctx, entity.UserListFilter{Limit: 10})
+	s.Nil(err)
+	s.Empty(page.Users)
+	s.Nil(page.Next)
+	s.Zero(page.Total)
+
+	s.user2.CreatedAt = s.user1.CreatedAt.Add(time.Second)
+	user3 := *s.user1
+	user3.ID = uuid.New()
+	user3.Email = "user3@mail.com"
+	user3.CreatedAt = s.user1.CreatedAt.Add(2 * time.Second)
+	user4 := *s.user2
+	user4.ID = uuid.New()
+	user4.Email = "user4@mail.com"
+
+	for _, user := range []entity.User{user3, *s.user1, user4, *s.user2} {
+		err = s.userRepository.Save(s.ctx, user)
+		s.Nil(err)
+	}
+
+	err = s.userRepository.Delete(s.ctx, user3.ID, user3.Version, entity.Now())
+	s.Nil(err)
+
+	// user2 and user4 were created at the same time and are ordered by ID.
+	expected := []entity.User{*s.user1, *s.user2, user4}
+	if user4.ID.String() < s.user2.ID.String() {
+		expected[1], expected[2] = expected[2], expected[1]
+	}
+
+	page, err = s.userRepository.List(s.ctx, entity.UserListFilter{})
+	s.Nil(err)
+	s.Equal(expected, page.Users)
+	s.Nil(page.Next)
+	s.Equal(int64(3), page.Total)
+
+	page, err = s.userRepository.List(s.ctx, entity.UserListFilter{Limit: 2})
+	s.Nil(err)
+	s.Equal(expected[:2], page.Users)
+	s.Equal(entity.NewUserCursor(expected[1]), *page.Next)
+	s.Equal(int64(3), page.Total)
+
+	page, err = s.userRepository.List(s.ctx, entity.UserListFilter{Limit: 2, After: page.Next})
+	s.Nil(err)
+	s.Equal(expected[2:], page.Users)
+	s.Nil(page.Next)
+	s.Equal(int64(3), page.Total)
+}
+
+func (s *UserRepositorySuite) Test_UserRepository_List_WithFilters() {
+	s.user2.CreatedAt = s.user1.CreatedAt.Add(time.Second)
+	s.user2.Status = entity.UserStatusSuspended
+	user3 := *s.user1
+	user3.ID = uuid.New()
+	user3.Email = "User_3@mail.com"
+	user3.CreatedAt = s.user1.CreatedAt.Add(2 * time.Second)
+
+	for _, user := range []entity.User{*s.user1, *s.user2, user3} {
+		err := s.userRepository.Save(s.ctx, user)
+		s.Nil(err)
+	}
+
+	tests := []struct {
+		filter entity.UserListFilter
+		users  []entity.User
+		total  int64
+	}{
+		{entity.UserListFilter{EmailPrefix: "USER"}, []entity.User{*s.user1, *s.user2, user3}, 3},
+		{entity.UserListFilter{EmailPrefix: "user_"}, []entity.User{user3}, 1},
+		{entity.UserListFilter{EmailPrefix: "user%"}, []entity.User{}, 0},
+		{entity.UserListFilter{Statuses: []entity.UserStatus{entity.UserStatusSuspended}}, []entity.User{*s.user2}, 1},
+		{entity.UserListFilter{EmailPrefix: "user2", Statuses: []entity.UserStatus{entity.UserStatusActive, entity.UserStatusSuspended}}, []entity.User{*s.user2}, 1},
+		{entity.UserListFilter{Statuses: []entity.UserStatus{entity.UserStatusActive}, Limit: 1}, []entity.User{*s.user1}, 2},
+	}
+
+	for _, test := range tests {
+		page, err := s.userRepository.List(s.ctx, test.filter)
+		s.Nil(err)
+		s.Equal(test.users, page.Users, test.filter)
+		s.Equal(test.total, page.Total, test.filter)
+	}
+}
+
+func (s *UserRepositorySuite) Test_UserRepository_RecordLogin() {
+	err := s.userRepository.Save(s.ctx, *s.user1)
+	s.Nil(err)
+
+	at := s.user1.CreatedAt.Add(time.Minute)
+	err = s.userRepository.RecordLogin(s.ctx, s.user1.ID, at, "127.0.0.1")
+	s.Nil(err)
+
+	user, err := s.userRepository.FindById(s.ctx, s.user1.ID)
+	s.Nil(err)
+	s.Equal(&at, user.LastLoginAt)
+	s.Equal("127.0.0.1", user.LastLoginIP)
+	s.Equal(s.user1.UpdatedAt, user.UpdatedAt)
+
+	err = s.userRepository.RecordLogin(s.ctx, s.user2.ID, at, "127.0.0.1")
+	s.Nil(err)
+}
@@ -0,0 +1,32 @@
+package mail
+
+import (
+	"bytes"
+	"context"
+	"log"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func Test_Mailer_NewSMTPMailer(t *testing.T) {
+	mailer := NewSMTPMailer("localhost", "587", "", "", "no-reply@mail.com")
+	assert.NotNil(t, mailer)
+	assert.Equal(t, "localhost:587", mailer.Addr)
+	assert.Nil(t, mailer.Auth)
+	assert.Equal(t, "no-reply@mail.com", mailer.From)
+
+	mailer = NewSMTPMailer("localhost", "587", "user", "12345", "no-reply@mail.com")
+	assert.NotNil(t, mailer.Auth)
+}
+
+func Test_Mailer_LogMailer_Send(t *testing.T) {
+	var buf bytes.Buffer
+	mailer := NewLogMailer(log.New(&buf, "", 0))
+
+	err := mailer.Send(context.Background(), "user@mail.com", "subject", "body")
+	assert.Nil(t, err)
+	assert.Contains(t, buf.String(), "user@mail.com")
+	assert.Contains(t, buf.String(), "subject")
+	assert.Contains(t, buf.String(), "body")
+}
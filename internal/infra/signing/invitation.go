@@ -0,0 +1,69 @@
+package signing
+
+import (
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/binary"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/sesaquecruz/go-auth-api/internal/entity"
+)
+
+// invitationContext keeps invitation tokens from being valid signatures for anything else signed with the secret.
+const invitationContext = "invitation:"
+
+// InvitationSigner signs the ID and expiry of invitations with HMAC-SHA256.
+type InvitationSigner struct {
+	Secret []byte
+}
+
+func NewInvitationSigner(secret []byte) *InvitationSigner {
+	return &InvitationSigner{Secret: secret}
+}
+
+func (s *InvitationSigner) Sign(invitation entity.Invitation) (string, error) {
+	payload := make([]byte, 0, 24)
+	payload = append(payload, invitation.ID[:]...)
+	payload = binary.BigEndian.AppendUint64(payload, uint64(invitation.ExpiresAt.Unix()))
+
+	return base64.RawURLEncoding.EncodeToString(payload) + "." + base64.RawURLEncoding.EncodeToString(s.mac(payload)), nil
+}
+
+func (s *InvitationSigner) Verify(token string) (uuid.UUID, error) {
+	encodedPayload, encodedMAC, ok := strings.Cut(token, ".")
+	if !ok {
+		return uuid.Nil, entity.ErrInvitationInvalidToken
+	}
+
+	payload, err := base64.RawURLEncoding.DecodeString(encodedPayload)
+	if err != nil || len(payload) != 24 {
+		return uuid.Nil, entity.ErrInvitationInvalidToken
+	}
+
+	mac, err := base64.RawURLEncoding.DecodeString(encodedMAC)
+	if err != nil || !hmac.Equal(mac, s.mac(payload)) {
+		return uuid.Nil, entity.ErrInvitationInvalidToken
+	}
+
+	expiresAt := time.Unix(int64(binary.BigEndian.Uint64(payload[16:])), 0)
+	if !entity.Now().Before(expiresAt) {
+		return uuid.Nil, entity.ErrInvitationExpired
+	}
+
+	id, err := uuid.FromBytes(payload[:16])
+	if err != nil {
+		return uuid.Nil, entity.ErrInvitationInvalidToken
+	}
+
+	return id, nil
+}
+
+func (s *InvitationSigner) mac(payload []byte) []byte {
+	h := hmac.New(sha256.New, s.Secret)
+	h.Write([]byte(invitationContext))
+	h.Write(payload)
+	return h.Sum(nil)
+}
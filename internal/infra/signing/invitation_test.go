@@ -0,0 +1,56 @@
+package signing
+
+import (
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/sesaquecruz/go-auth-api/internal/entity"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func Test_InvitationSigner_NewInvitationSigner(t *testing.T) {
+	signer := NewInvitationSigner([]byte("secret"))
+	assert.NotNil(t, signer)
+	assert.Equal(t, []byte("secret"), signer.Secret)
+}
+
+func Test_InvitationSigner_SignAndVerify(t *testing.T) {
+	signer := NewInvitationSigner([]byte("secret"))
+	invitation := entity.Invitation{ID: uuid.New(), ExpiresAt: entity.Now().Add(time.Hour)}
+
+	token, err := signer.Sign(invitation)
+	require.Nil(t, err)
+
+	id, err := signer.Verify(token)
+	assert.Nil(t, err)
+	assert.Equal(t, invitation.ID, id)
+}
+
+func Test_InvitationSigner_Verify_WhenTokenIsInvalid(t *testing.T) {
+	signer := NewInvitationSigner([]byte("secret"))
+	invitation := entity.Invitation{ID: uuid.New(), ExpiresAt: entity.Now().Add(time.Hour)}
+
+	token, err := signer.Sign(invitation)
+	require.Nil(t, err)
+
+	forged, err := NewInvitationSigner([]byte("other")).Sign(invitation)
+	require.Nil(t, err)
+
+	for _, token := range []string{"", "abc", "abc.def", forged, token[:len(token)-2], "x" + token} {
+		_, err = signer.Verify(token)
+		assert.ErrorIs(t, err, entity.ErrInvitationInvalidToken, token)
+	}
+}
+
+func Test_InvitationSigner_Verify_WhenTokenIsExpired(t *testing.T) {
+	signer := NewInvitationSigner([]byte("secret"))
+
+	token, err := signer.Sign(entity.Invitation{ID: uuid.New(), ExpiresAt: entity.Now().Add(-time.Second)})
+	require.Nil(t, err)
+
+	_, err = signer.Verify(token)
+	assert.ErrorIs(t, err, entity.ErrInvitationExpired)
+}
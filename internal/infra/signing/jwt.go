@@ -0,0 +1,73 @@
+package signing
+
+import (
+	"crypto/rsa"
+	"crypto/x509"
+	"encoding/pem"
+	"errors"
+
+	"github.com/go-chi/jwtauth"
+	"github.com/lestrrat-go/jwx/jwa"
+	"github.com/lestrrat-go/jwx/jwk"
+)
+
+var ErrInvalidPrivateKey = errors.New("invalid rsa private key")
+
+// NewJWTAuth signs tokens with RS256 and the RSA private key in privateKeyPEM, named by its thumbprint in the
+// "kid" header, or with HS256 and secret when there is no key. It also returns the public keys other services
+// verify tokens with, which is an empty set for HS256.
+func NewJWTAuth(secret []byte, privateKeyPEM []byte) (*jwtauth.JWTAuth, jwk.Set, error) {
+	keys := jwk.NewSet()
+	if len(privateKeyPEM) == 0 {
+		return jwtauth.New(jwa.HS256.String(), secret, nil), keys, nil
+	}
+
+	privateKey, err := parseRSAPrivateKey(privateKeyPEM)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	signKey, err := jwk.New(privateKey)
+	if err != nil {
+		return nil, nil, err
+	}
+	err = jwk.AssignKeyID(signKey)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	publicKey, err := jwk.PublicKeyOf(signKey)
+	if err != nil {
+		return nil, nil, err
+	}
+	for name, value := range map[string]interface{}{jwk.KeyIDKey: signKey.KeyID(), jwk.AlgorithmKey: jwa.RS256, jwk.KeyUsageKey: jwk.ForSignature} {
+		err = publicKey.Set(name, value)
+		if err != nil {
+			return nil, nil, err
+		}
+	}
+	keys.Add(publicKey)
+
+	return jwtauth.New(jwa.RS256.String(), signKey, &privateKey.PublicKey), keys, nil
+}
+
+func parseRSAPrivateKey(privateKeyPEM []byte) (*rsa.PrivateKey, error) {
+	block, _ := pem.Decode(privateKeyPEM)
+	if block == nil {
+		return nil, ErrInvalidPrivateKey
+	}
+
+	if key, err := x509.ParsePKCS1PrivateKey(block.Bytes); err == nil {
+		return key, nil
+	}
+
+	key, err := x509.ParsePKCS8PrivateKey(block.Bytes)
+	if err != nil {
+		return nil, ErrInvalidPrivateKey
+	}
+	rsaKey, ok := key.(*rsa.PrivateKey)
+	if !ok {
+		return nil, ErrInvalidPrivateKey
+	}
+	return rsaKey, nil
+}
@@ -0,0 +1,76 @@
+package signing
+
+import (
+	"context"
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/x509"
+	"encoding/pem"
+	"testing"
+
+	"github.com/lestrrat-go/jwx/jwa"
+	"github.com/lestrrat-go/jwx/jws"
+	"github.com/lestrrat-go/jwx/jwt"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func Test_NewJWTAuth_WithoutPrivateKey(t *testing.T) {
+	jwtAuth, keys, err := NewJWTAuth([]byte("secret"), nil)
+	require.Nil(t, err)
+	assert.Equal(t, 0, keys.Len())
+
+	_, token, err := jwtAuth.Encode(map[string]interface{}{"sub": "user"})
+	require.Nil(t, err)
+
+	_, err = jwt.ParseString(token, jwt.WithVerify(jwa.HS256, []byte("secret")))
+	assert.Nil(t, err)
+}
+
+func Test_NewJWTAuth_WithPrivateKey(t *testing.T) {
+	privateKey, err := rsa.GenerateKey(rand.Reader, 2048)
+	require.Nil(t, err)
+
+	pkcs8, err := x509.MarshalPKCS8PrivateKey(privateKey)
+	require.Nil(t, err)
+
+	for _, block := range []*pem.Block{
+		{Type: "RSA PRIVATE KEY", Bytes: x509.MarshalPKCS1PrivateKey(privateKey)},
+		{Type: "PRIVATE KEY", Bytes: pkcs8},
+	} {
+		jwtAuth, keys, err := NewJWTAuth([]byte("secret"), pem.EncodeToMemory(block))
+		require.Nil(t, err, block.Type)
+		require.Equal(t, 1, keys.Len())
+
+		_, token, err := jwtAuth.Encode(map[string]interface{}{"sub": "user"})
+		require.Nil(t, err)
+
+		// Tokens name their key and verify with the published set alone.
+		message, err := jws.ParseString(token)
+		require.Nil(t, err)
+		publicKey, _ := keys.Get(0)
+		assert.Equal(t, publicKey.KeyID(), message.Signatures()[0].ProtectedHeaders().KeyID())
+		assert.Equal(t, jwa.RS256, message.Signatures()[0].ProtectedHeaders().Algorithm())
+
+		parsed, err := jwt.ParseString(token, jwt.WithKeySet(keys))
+		require.Nil(t, err)
+		assert.Equal(t, "user", parsed.Subject())
+
+		_, err = jwtAuth.Decode(token)
+		assert.Nil(t, err)
+
+		// Only the public key is published.
+		m, err := publicKey.AsMap(context.Background())
+		require.Nil(t, err)
+		assert.NotContains(t, m, "d")
+		assert.Equal(t, "sig", m["use"])
+	}
+}
+
+func Test_NewJWTAuth_WithInvalidPrivateKey(t *testing.T) {
+	_, _, err := NewJWTAuth([]byte("secret"), []byte("not a key"))
+	assert.ErrorIs(t, err, ErrInvalidPrivateKey)
+
+	_, _, err = NewJWTAuth([]byte("secret"), pem.EncodeToMemory(&pem.Block{Type: "PRIVATE KEY", Bytes: []byte("junk")}))
+	assert.ErrorIs(t, err, ErrInvalidPrivateKey)
+}
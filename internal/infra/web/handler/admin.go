@@ -0,0 +1,299 @@
+package handler
+
+import (
+	"encoding/json"
+	"net/http"
+	"strconv"
+
+	"github.com/sesaquecruz/go-auth-api/internal/entity"
+	"github.com/sesaquecruz/go-auth-api/internal/usecase"
+
+	"github.com/go-chi/chi/v5"
+)
+
+type AdminHandler struct {
+	CreateUserUseCase        usecase.CreateUserUseCaseInterface
+	ListUsersUseCase         usecase.ListUsersUseCaseInterface
+	FindUserUseCase          usecase.FindUserUseCaseInterface
+	SetUserStatusUseCase     usecase.SetUserStatusUseCaseInterface
+	ResetUserPasswordUseCase usecase.ResetUserPasswordUseCaseInterface
+	DeleteUserUseCase        usecase.DeleteUserUseCaseInterface
+}
+
+func NewAdminHandler(
+	createUserUseCase usecase.CreateUserUseCaseInterface,
+	listUsersUseCase usecase.ListUsersUseCaseInterface,
+	findUserUseCase usecase.FindUserUseCaseInterface,
+	setUserStatusUseCase usecase.SetUserStatusUseCaseInterface,
+	resetUserPasswordUseCase usecase.ResetUserPasswordUseCaseInterface,
+	deleteUserUseCase usecase.DeleteUserUseCaseInterface,
+) *AdminHandler {
+	return &AdminHandler{
+		CreateUserUseCase:        createUserUseCase,
+		ListUsersUseCase:         listUsersUseCase,
+		FindUserUseCase:          findUserUseCase,
+		SetUserStatusUseCase:     setUserStatusUseCase,
+		ResetUserPasswordUseCase: resetUserPasswordUseCase,
+		DeleteUserUseCase:        deleteUserUseCase,
+	}
+}
+
+// List users godoc
+// @Sumary		List users
+// @Description	List users in creation order, or find the one with an email
+// @Tags		admin
+// @Produce		json
+// @Param		email			query		string		false	"email of the user"
+// @Param		email_prefix	query		string		false	"start of the email of the users"
+// @Param		status			query		[]string	false	"statuses of the users"	collectionFormat(multi)
+// @Param		cursor			query		string		false	"next_cursor of the previous page"
+// @Param		limit			query		int			false	"page size, 20 by default and at most 100"
+// @Success		200			{object}	usecase.ListUsersUseCaseOutputDTO
+// @Failure		400			{object}	handler.UserHandlerMessageDTO
+// @Failure		401			{object}	handler.UserHandlerMessageDTO
+// @Failure		403			{object}	handler.UserHandlerMessageDTO
+// @Failure		500			{object}	handler.UserHandlerMessageDTO
+// @Router		/admin/users	[get]
+// @Security	ApiKeyAuth
+func (h *AdminHandler) ListUsers(w http.ResponseWriter, r *http.Request) {
+	query := r.URL.Query()
+	input := usecase.ListUsersUseCaseInputDTO{
+		Email:       query.Get("email"),
+		EmailPrefix: query.Get("email_prefix"),
+		Status:      query["status"],
+		Cursor:      query.Get("cursor"),
+	}
+
+	if value := query.Get("limit"); value != "" {
+		var err error
+		if input.Limit, err = strconv.Atoi(value); err != nil {
+			writeMessage(w, http.StatusBadRequest, usecase.ErrListUsersInvalidData.Error())
+			return
+		}
+	}
+
+	output, err := h.ListUsersUseCase.Execute(r.Context(), input)
+	if err != nil {
+		if err == usecase.ErrListUsersInternalError {
+			writeMessage(w, http.StatusInternalServerError, err.Error())
+		} else {
+			writeMessage(w, http.StatusBadRequest, err.Error())
+		}
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(http.StatusOK)
+	json.NewEncoder(w).Encode(output)
+}
+
+// Create user godoc
+// @Sumary		Create user
+// @Description	Create user as an administrator
+// @Tags		admin
+// @Accept		json
+// @Produce		json
+// @Param		request		body		handler.UserHandlerInputDTO		true	"user request"
+// @Success		201			{object}	usecase.CreateUserUseCaseOutputDTO
+// @Failure		400			{object}	handler.UserHandlerMessageDTO
+// @Failure		401			{object}	handler.UserHandlerMessageDTO
+// @Failure		403			{object}	handler.UserHandlerMessageDTO
+// @Failure		500			{object}	handler.UserHandlerMessageDTO
+// @Router		/admin/users	[post]
+// @Security	ApiKeyAuth
+func (h *AdminHandler) CreateUser(w http.ResponseWriter, r *http.Request) {
+	var data UserHandlerInputDTO
+	err := json.NewDecoder(r.Body).Decode(&data)
+	if err != nil {
+		w.WriteHeader(http.StatusBadRequest)
+		return
+	}
+
+	output, err := h.CreateUserUseCase.Execute(r.Context(), usecase.CreateUserUseCaseInputDTO{
+		Email:    data.Email,
+		Password: data.Password,
+	})
+	if err != nil {
+		if err == usecase.ErrCreateUserInternalError {
+			writeMessage(w, http.StatusInternalServerError, err.Error())
+		} else {
+			writeMessage(w, http.StatusBadRequest, err.Error())
+		}
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(http.StatusCreated)
+	json.NewEncoder(w).Encode(output)
+}
+
+// Find user godoc
+// @Sumary		Find user
+// @Description	Find user by id
+// @Tags		admin
+// @Produce		json
+// @Param		id			path		string	true	"user id"
+// @Success		200			{object}	usecase.FindUserUseCaseOutputDTO
+// @Header		200			{string}	ETag	"ETag of the user"
+// @Failure		400			{object}	handler.UserHandlerMessageDTO
+// @Failure		401			{object}	handler.UserHandlerMessageDTO
+// @Failure		403			{object}	handler.UserHandlerMessageDTO
+// @Failure		404			{object}	handler.UserHandlerMessageDTO
+// @Failure		500			{object}	handler.UserHandlerMessageDTO
+// @Router		/admin/users/{id}	[get]
+// @Security	ApiKeyAuth
+func (h *AdminHandler) FindUser(w http.ResponseWriter, r *http.Request) {
+	output, err := h.FindUserUseCase.Execute(r.Context(), usecase.FindUserUseCaseInputDTO{
+		ID: chi.URLParam(r, "id"),
+	})
+	if err != nil {
+		if err == usecase.ErrFindUserInternalError {
+			writeMessage(w, http.StatusInternalServerError, err.Error())
+		} else if err == usecase.ErrFindUserUserNotExists {
+			writeMessage(w, http.StatusNotFound, err.Error())
+		} else {
+			writeMessage(w, http.StatusBadRequest, err.Error())
+		}
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	w.Header().Set("ETag", etag(output.Version))
+	w.WriteHeader(http.StatusOK)
+	json.NewEncoder(w).Encode(output)
+}
+
+// Suspend user godoc
+// @Sumary		Suspend user
+// @Description	Suspend user, so they can no longer log in
+// @Tags		admin
+// @Produce		json
+// @Param		id			path		string	true	"user id"
+// @Success		200
+// @Failure		400			{object}	handler.UserHandlerMessageDTO
+// @Failure		401			{object}	handler.UserHandlerMessageDTO
+// @Failure		403			{object}	handler.UserHandlerMessageDTO
+// @Failure		404			{object}	handler.UserHandlerMessageDTO
+// @Failure		500			{object}	handler.UserHandlerMessageDTO
+// @Router		/admin/users/{id}/suspend	[post]
+// @Security	ApiKeyAuth
+func (h *AdminHandler) SuspendUser(w http.ResponseWriter, r *http.Request) {
+	h.setUserStatus(w, r, entity.UserStatusSuspended)
+}
+
+// Enable user godoc
+// @Sumary		Enable user
+// @Description	Enable a suspended user
+// @Tags		admin
+// @Produce		json
+// @Param		id			path		string	true	"user id"
+// @Success		200
+// @Failure		400			{object}	handler.UserHandlerMessageDTO
+// @Failure		401			{object}	handler.UserHandlerMessageDTO
+// @Failure		403			{object}	handler.UserHandlerMessageDTO
+// @Failure		404			{object}	handler.UserHandlerMessageDTO
+// @Failure		500			{object}	handler.UserHandlerMessageDTO
+// @Router		/admin/users/{id}/enable	[post]
+// @Security	ApiKeyAuth
+func (h *AdminHandler) EnableUser(w http.ResponseWriter, r *http.Request) {
+	h.setUserStatus(w, r, entity.UserStatusActive)
+}
+
+func (h *AdminHandler) setUserStatus(w http.ResponseWriter, r *http.Request, status entity.UserStatus) {
+	err := h.SetUserStatusUseCase.Execute(r.Context(), usecase.SetUserStatusUseCaseInputDTO{
+		ID:     chi.URLParam(r, "id"),
+		Status: string(status),
+	})
+	if err != nil {
+		if err == usecase.ErrSetUserStatusInternalError {
+			writeMessage(w, http.StatusInternalServerError, err.Error())
+		} else if err == usecase.ErrSetUserStatusUserNotExists {
+			writeMessage(w, http.StatusNotFound, err.Error())
+		} else {
+			writeMessage(w, http.StatusBadRequest, err.Error())
+		}
+		return
+	}
+
+	w.WriteHeader(http.StatusOK)
+}
+
+// Reset user password godoc
+// @Sumary		Reset user password
+// @Description	Replace the password of a user with a temporary one sent to their email
+// @Tags		admin
+// @Produce		json
+// @Param		id			path		string	true	"user id"
+// @Success		202
+// @Failure		400			{object}	handler.UserHandlerMessageDTO
+// @Failure		401			{object}	handler.UserHandlerMessageDTO
+// @Failure		403			{object}	handler.UserHandlerMessageDTO
+// @Failure		404			{object}	handler.UserHandlerMessageDTO
+// @Failure		412			{object}	handler.UserHandlerMessageDTO
+// @Failure		500			{object}	handler.UserHandlerMessageDTO
+// @Router		/admin/users/{id}/password-reset	[post]
+// @Security	ApiKeyAuth
+func (h *AdminHandler) ResetUserPassword(w http.ResponseWriter, r *http.Request) {
+	err := h.ResetUserPasswordUseCase.Execute(r.Context(), usecase.ResetUserPasswordUseCaseInputDTO{
+		ID: chi.URLParam(r, "id"),
+	})
+	if err != nil {
+		if err == usecase.ErrResetUserPasswordInternalError {
+			writeMessage(w, http.StatusInternalServerError, err.Error())
+		} else if err == usecase.ErrResetUserPasswordUserNotExists {
+			writeMessage(w, http.StatusNotFound, err.Error())
+		} else if err == usecase.ErrResetUserPasswordVersionConflict {
+			writeMessage(w, http.StatusPreconditionFailed, err.Error())
+		} else {
+			writeMessage(w, http.StatusBadRequest, err.Error())
+		}
+		return
+	}
+
+	w.WriteHeader(http.StatusAccepted)
+}
+
+// Delete user godoc
+// @Sumary		Delete user
+// @Description	Delete user, checking its ETag when If-Match is given
+// @Tags		admin
+// @Produce		json
+// @Param		id			path		string	true	"user id"
+// @Param		If-Match	header		string	false	"ETag of the user"
+// @Success		200
+// @Failure		400			{object}	handler.UserHandlerMessageDTO
+// @Failure		401			{object}	handler.UserHandlerMessageDTO
+// @Failure		403			{object}	handler.UserHandlerMessageDTO
+// @Failure		404			{object}	handler.UserHandlerMessageDTO
+// @Failure		412			{object}	handler.UserHandlerMessageDTO
+// @Failure		500			{object}	handler.UserHandlerMessageDTO
+// @Router		/admin/users/{id}	[delete]
+// @Security	ApiKeyAuth
+func (h *AdminHandler) DeleteUser(w http.ResponseWriter, r *http.Request) {
+	version, _ := ifMatchVersion(r)
+
+	err := h.DeleteUserUseCase.Execute(r.Context(), usecase.DeleteUserUseCaseInputDTO{
+		ID:      chi.URLParam(r, "id"),
+		Version: version,
+	})
+	if err != nil {
+		if err == usecase.ErrDeleteUserInternalError {
+			writeMessage(w, http.StatusInternalServerError, err.Error())
+		} else if err == usecase.ErrDeleteUserUserNotExists {
+			writeMessage(w, http.StatusNotFound, err.Error())
+		} else if err == usecase.ErrDeleteUserVersionConflict {
+			writeMessage(w, http.StatusPreconditionFailed, err.Error())
+		} else {
+			writeMessage(w, http.StatusBadRequest, err.Error())
+		}
+		return
+	}
+
+	w.WriteHeader(http.StatusOK)
+}
+
+func writeMessage(w http.ResponseWriter, status int, message string) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(status)
+	json.NewEncoder(w).Encode(UserHandlerMessageDTO{Message: message})
+}
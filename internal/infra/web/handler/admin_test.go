@@ -0,0 +1,273 @@
+package handler
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/sesaquecruz/go-auth-api/internal/usecase"
+
+	"github.com/go-chi/chi/v5"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func newAdminRouter(h *AdminHandler) http.Handler {
+	r := chi.NewRouter()
+	r.Get("/admin/users", h.ListUsers)
+	r.Post("/admin/users", h.CreateUser)
+	r.Get("/admin/users/{id}", h.FindUser)
+	r.Delete("/admin/users/{id}", h.DeleteUser)
+	r.Post("/admin/users/{id}/suspend", h.SuspendUser)
+	r.Post("/admin/users/{id}/enable", h.EnableUser)
+	r.Post("/admin/users/{id}/password-reset", h.ResetUserPassword)
+	return r
+}
+
+func Test_AdminHandler_NewAdminHandler(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	createUserUseCase := usecase.NewMockCreateUserUseCaseInterface(ctrl)
+	listUsersUseCase := usecase.NewMockListUsersUseCaseInterface(ctrl)
+	findUserUseCase := usecase.NewMockFindUserUseCaseInterface(ctrl)
+	setUserStatusUseCase := usecase.NewMockSetUserStatusUseCaseInterface(ctrl)
+	resetUserPasswordUseCase := usecase.NewMockResetUserPasswordUseCaseInterface(ctrl)
+	deleteUserUseCase := usecase.NewMockDeleteUserUseCaseInterface(ctrl)
+
+	adminHandler := NewAdminHandler(
+		createUserUseCase,
+		listUsersUseCase,
+		findUserUseCase,
+		setUserStatusUseCase,
+		resetUserPasswordUseCase,
+		deleteUserUseCase,
+	)
+	assert.NotNil(t, adminHandler)
+	assert.Equal(t, createUserUseCase, adminHandler.CreateUserUseCase)
+	assert.Equal(t, listUsersUseCase, adminHandler.ListUsersUseCase)
+	assert.Equal(t, findUserUseCase, adminHandler.FindUserUseCase)
+	assert.Equal(t, setUserStatusUseCase, adminHandler.SetUserStatusUseCase)
+	assert.Equal(t, resetUserPasswordUseCase, adminHandler.ResetUserPasswordUseCase)
+	assert.Equal(t, deleteUserUseCase, adminHandler.DeleteUserUseCase)
+}
+
+func Test_AdminHandler_ListUsers(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	output := &usecase.ListUsersUseCaseOutputDTO{
+		Users:      []usecase.FindUserUseCaseOutputDTO{{ID: uuid.NewString(), Email: "user@mail.com"}},
+		NextCursor: "def",
+		Total:      2,
+	}
+
+	listUsersUseCase := usecase.NewMockListUsersUseCaseInterface(ctrl)
+	listUsersUseCase.EXPECT().
+		Execute(gomock.Any(), usecase.ListUsersUseCaseInputDTO{
+			EmailPrefix: "user",
+			Status:      []string{"active", "suspended"},
+			Cursor:      "abc",
+			Limit:       10,
+		}).
+		Return(output, nil).
+		Times(1)
+
+	router := newAdminRouter(&AdminHandler{ListUsersUseCase: listUsersUseCase})
+
+	req := httptest.NewRequest(http.MethodGet, "/admin/users?email_prefix=user&status=active&status=suspended&cursor=abc&limit=10", nil)
+	rr := httptest.NewRecorder()
+	router.ServeHTTP(rr, req)
+
+	var body usecase.ListUsersUseCaseOutputDTO
+	json.NewDecoder(rr.Body).Decode(&body)
+
+	assert.Equal(t, http.StatusOK, rr.Code)
+	assert.Equal(t, output.Users[0].ID, body.Users[0].ID)
+	assert.Equal(t, "def", body.NextCursor)
+	assert.Equal(t, int64(2), body.Total)
+}
+
+func Test_AdminHandler_ListUsers_WhenPageIsInvalid(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	listUsersUseCase := usecase.NewMockListUsersUseCaseInterface(ctrl)
+	listUsersUseCase.EXPECT().Execute(gomock.Any(), gomock.Any()).Times(0)
+
+	router := newAdminRouter(&AdminHandler{ListUsersUseCase: listUsersUseCase})
+
+	req := httptest.NewRequest(http.MethodGet, "/admin/users?limit=ten", nil)
+	rr := httptest.NewRecorder()
+	router.ServeHTTP(rr, req)
+
+	assert.Equal(t, http.StatusBadRequest, rr.Code)
+}
+
+func Test_AdminHandler_CreateUser(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	output := &usecase.CreateUserUseCaseOutputDTO{ID: uuid.NewString()}
+
+	createUserUseCase := usecase.NewMockCreateUserUseCaseInterface(ctrl)
+	createUserUseCase.EXPECT().
+		Execute(gomock.Any(), usecase.CreateUserUseCaseInputDTO{Email: "user@mail.com", Password: "12345"}).
+		Return(output, nil).
+		Times(1)
+
+	router := newAdminRouter(&AdminHandler{CreateUserUseCase: createUserUseCase})
+
+	body, err := json.Marshal(UserHandlerInputDTO{Email: "user@mail.com", Password: "12345"})
+	require.Nil(t, err)
+
+	req := httptest.NewRequest(http.MethodPost, "/admin/users", bytes.NewReader(body))
+	rr := httptest.NewRecorder()
+	router.ServeHTTP(rr, req)
+
+	var response usecase.CreateUserUseCaseOutputDTO
+	json.NewDecoder(rr.Body).Decode(&response)
+
+	assert.Equal(t, http.StatusCreated, rr.Code)
+	assert.Equal(t, output.ID, response.ID)
+}
+
+func Test_AdminHandler_FindUser(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	id := uuid.NewString()
+	output := &usecase.FindUserUseCaseOutputDTO{ID: id, Email: "user@mail.com", Version: 3}
+
+	findUserUseCase := usecase.NewMockFindUserUseCaseInterface(ctrl)
+	findUserUseCase.EXPECT().Execute(gomock.Any(), usecase.FindUserUseCaseInputDTO{ID: id}).Return(output, nil).Times(1)
+
+	router := newAdminRouter(&AdminHandler{FindUserUseCase: findUserUseCase})
+
+	req := httptest.NewRequest(http.MethodGet, "/admin/users/"+id, nil)
+	rr := httptest.NewRecorder()
+	router.ServeHTTP(rr, req)
+
+	assert.Equal(t, http.StatusOK, rr.Code)
+	assert.Equal(t, `"3"`, rr.Header().Get("ETag"))
+}
+
+func Test_AdminHandler_FindUser_WhenUserNotExists(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	findUserUseCase := usecase.NewMockFindUserUseCaseInterface(ctrl)
+	findUserUseCase.EXPECT().Execute(gomock.Any(), gomock.Any()).Return(nil, usecase.ErrFindUserUserNotExists).Times(1)
+
+	router := newAdminRouter(&AdminHandler{FindUserUseCase: findUserUseCase})
+
+	req := httptest.NewRequest(http.MethodGet, "/admin/users/"+uuid.NewString(), nil)
+	rr := httptest.NewRecorder()
+	router.ServeHTTP(rr, req)
+
+	assert.Equal(t, http.StatusNotFound, rr.Code)
+}
+
+func Test_AdminHandler_SuspendAndEnableUser(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	id := uuid.NewString()
+
+	setUserStatusUseCase := usecase.NewMockSetUserStatusUseCaseInterface(ctrl)
+	gomock.InOrder(
+		setUserStatusUseCase.EXPECT().
+			Execute(gomock.Any(), usecase.SetUserStatusUseCaseInputDTO{ID: id, Status: "suspended"}).
+			Return(nil),
+		setUserStatusUseCase.EXPECT().
+			Execute(gomock.Any(), usecase.SetUserStatusUseCaseInputDTO{ID: id, Status: "active"}).
+			Return(nil),
+	)
+
+	router := newAdminRouter(&AdminHandler{SetUserStatusUseCase: setUserStatusUseCase})
+
+	for _, action := range []string{"suspend", "enable"} {
+		req := httptest.NewRequest(http.MethodPost, "/admin/users/"+id+"/"+action, nil)
+		rr := httptest.NewRecorder()
+		router.ServeHTTP(rr, req)
+
+		assert.Equal(t, http.StatusOK, rr.Code)
+	}
+}
+
+func Test_AdminHandler_ResetUserPassword(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	id := uuid.NewString()
+
+	resetUserPasswordUseCase := usecase.NewMockResetUserPasswordUseCaseInterface(ctrl)
+	resetUserPasswordUseCase.EXPECT().
+		Execute(gomock.Any(), usecase.ResetUserPasswordUseCaseInputDTO{ID: id}).
+		Return(nil).
+		Times(1)
+
+	router := newAdminRouter(&AdminHandler{ResetUserPasswordUseCase: resetUserPasswordUseCase})
+
+	req := httptest.NewRequest(http.MethodPost, "/admin/users/"+id+"/password-reset", nil)
+	rr := httptest.NewRecorder()
+	router.ServeHTTP(rr, req)
+
+	assert.Equal(t, http.StatusAccepted, rr.Code)
+}
+
+func Test_AdminHandler_ResetUserPassword_WhenVersionConflicts(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	resetUserPasswordUseCase := usecase.NewMockResetUserPasswordUseCaseInterface(ctrl)
+	resetUserPasswordUseCase.EXPECT().
+		Execute(gomock.Any(), gomock.Any()).
+		Return(usecase.ErrResetUserPasswordVersionConflict).
+		Times(1)
+
+	router := newAdminRouter(&AdminHandler{ResetUserPasswordUseCase: resetUserPasswordUseCase})
+
+	req := httptest.NewRequest(http.MethodPost, "/admin/users/"+uuid.NewString()+"/password-reset", nil)
+	rr := httptest.NewRecorder()
+	router.ServeHTTP(rr, req)
+
+	assert.Equal(t, http.StatusPreconditionFailed, rr.Code)
+}
+
+func Test_AdminHandler_DeleteUser(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	id := uuid.NewString()
+
+	deleteUserUseCase := usecase.NewMockDeleteUserUseCaseInterface(ctrl)
+	gomock.InOrder(
+		deleteUserUseCase.EXPECT().
+			Execute(gomock.Any(), usecase.DeleteUserUseCaseInputDTO{ID: id}).
+			Return(nil),
+		deleteUserUseCase.EXPECT().
+			Execute(gomock.Any(), usecase.DeleteUserUseCaseInputDTO{ID: id, Version: 2}).
+			DoAndReturn(func(context.Context, usecase.DeleteUserUseCaseInputDTO) error {
+				return usecase.ErrDeleteUserVersionConflict
+			}),
+	)
+
+	router := newAdminRouter(&AdminHandler{DeleteUserUseCase: deleteUserUseCase})
+
+	req := httptest.NewRequest(http.MethodDelete, "/admin/users/"+id, nil)
+	rr := httptest.NewRecorder()
+	router.ServeHTTP(rr, req)
+	assert.Equal(t, http.StatusOK, rr.Code)
+
+	req = httptest.NewRequest(http.MethodDelete, "/admin/users/"+id, nil)
+	req.Header.Set("If-Match", `"2"`)
+	rr = httptest.NewRecorder()
+	router.ServeHTTP(rr, req)
+	assert.Equal(t, http.StatusPreconditionFailed, rr.Code)
+}
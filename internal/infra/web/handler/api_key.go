@@ -0,0 +1,167 @@
+package handler
+
+import (
+	"encoding/json"
+	"net/http"
+
+	"github.com/sesaquecruz/go-auth-api/internal/infra/web/middleware"
+	"github.com/sesaquecruz/go-auth-api/internal/usecase"
+
+	"github.com/go-chi/chi/v5"
+)
+
+type APIKeyHandlerInputDTO struct {
+	Name             string   `json:"name"`
+	Scopes           []string `json:"scopes"`
+	ExpiresInSeconds int64    `json:"expires_in_seconds"`
+}
+
+type APIKeyHandler struct {
+	CreateAPIKeyUseCase usecase.CreateAPIKeyUseCaseInterface
+	ListAPIKeysUseCase  usecase.ListAPIKeysUseCaseInterface
+	RevokeAPIKeyUseCase usecase.RevokeAPIKeyUseCaseInterface
+}
+
+func NewAPIKeyHandler(
+	createAPIKeyUseCase usecase.CreateAPIKeyUseCaseInterface,
+	listAPIKeysUseCase usecase.ListAPIKeysUseCaseInterface,
+	revokeAPIKeyUseCase usecase.RevokeAPIKeyUseCaseInterface,
+) *APIKeyHandler {
+	return &APIKeyHandler{
+		CreateAPIKeyUseCase: createAPIKeyUseCase,
+		ListAPIKeysUseCase:  listAPIKeysUseCase,
+		RevokeAPIKeyUseCase: revokeAPIKeyUseCase,
+	}
+}
+
+// Create api key godoc
+// @Sumary		Create api key
+// @Description	Create a personal api key, whose plain text is only returned in this response
+// @Tags		api-keys
+// @Accept		json
+// @Produce		json
+// @Param		request		body		handler.APIKeyHandlerInputDTO	true	"api key request"
+// @Success		201			{object}	usecase.CreateAPIKeyUseCaseOutputDTO
+// @Failure		400			{object}	handler.UserHandlerMessageDTO
+// @Failure		401			{object}	handler.UserHandlerMessageDTO
+// @Failure		403			{object}	handler.UserHandlerMessageDTO
+// @Failure		500			{object}	handler.UserHandlerMessageDTO
+// @Router		/users/api-keys	[post]
+// @Security	ApiKeyAuth
+func (h *APIKeyHandler) CreateAPIKey(w http.ResponseWriter, r *http.Request) {
+	sub, ok := keyOwner(w, r)
+	if !ok {
+		return
+	}
+
+	var data APIKeyHandlerInputDTO
+	err := json.NewDecoder(r.Body).Decode(&data)
+	if err != nil {
+		w.WriteHeader(http.StatusBadRequest)
+		return
+	}
+
+	output, err := h.CreateAPIKeyUseCase.Execute(r.Context(), usecase.CreateAPIKeyUseCaseInputDTO{
+		UserID:           sub,
+		Name:             data.Name,
+		Scopes:           data.Scopes,
+		ExpiresInSeconds: data.ExpiresInSeconds,
+	})
+	if err != nil {
+		writeAPIKeyError(w, err)
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	w.Header().Set("Cache-Control", "no-store")
+	w.WriteHeader(http.StatusCreated)
+	json.NewEncoder(w).Encode(output)
+}
+
+// List api keys godoc
+// @Sumary		List api keys
+// @Description	List the api keys of the user that are not revoked
+// @Tags		api-keys
+// @Produce		json
+// @Success		200			{object}	usecase.ListAPIKeysUseCaseOutputDTO
+// @Failure		401			{object}	handler.UserHandlerMessageDTO
+// @Failure		403			{object}	handler.UserHandlerMessageDTO
+// @Failure		500			{object}	handler.UserHandlerMessageDTO
+// @Router		/users/api-keys	[get]
+// @Security	ApiKeyAuth
+func (h *APIKeyHandler) ListAPIKeys(w http.ResponseWriter, r *http.Request) {
+	sub, ok := keyOwner(w, r)
+	if !ok {
+		return
+	}
+
+	output, err := h.ListAPIKeysUseCase.Execute(r.Context(), usecase.ListAPIKeysUseCaseInputDTO{UserID: sub})
+	if err != nil {
+		writeAPIKeyError(w, err)
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(http.StatusOK)
+	json.NewEncoder(w).Encode(output)
+}
+
+// Revoke api key godoc
+// @Sumary		Revoke api key
+// @Description	Revoke an api key of the user
+// @Tags		api-keys
+// @Param		id			path		string	true	"api key id"
+// @Success		200
+// @Failure		400			{object}	handler.UserHandlerMessageDTO
+// @Failure		401			{object}	handler.UserHandlerMessageDTO
+// @Failure		403			{object}	handler.UserHandlerMessageDTO
+// @Failure		404			{object}	handler.UserHandlerMessageDTO
+// @Failure		500			{object}	handler.UserHandlerMessageDTO
+// @Router		/users/api-keys/{id}	[delete]
+// @Security	ApiKeyAuth
+func (h *APIKeyHandler) RevokeAPIKey(w http.ResponseWriter, r *http.Request) {
+	sub, ok := keyOwner(w, r)
+	if !ok {
+		return
+	}
+
+	err := h.RevokeAPIKeyUseCase.Execute(r.Context(), usecase.RevokeAPIKeyUseCaseInputDTO{
+		UserID: sub,
+		ID:     chi.URLParam(r, "id"),
+	})
+	if err != nil {
+		writeAPIKeyError(w, err)
+		return
+	}
+
+	w.WriteHeader(http.StatusOK)
+}
+
+// keyOwner is the subject of a login token. Keys are not managed with keys, so a key cannot be
+// used to mint another one outliving it.
+func keyOwner(w http.ResponseWriter, r *http.Request) (string, bool) {
+	principal, ok := requestPrincipal(w, r)
+	if !ok {
+		return "", false
+	}
+	if principal.AuthMethod == middleware.AuthMethodAPIKey {
+		writeMessage(w, http.StatusForbidden, "api keys cannot manage api keys")
+		return "", false
+	}
+	return principal.UserID.String(), true
+}
+
+func writeAPIKeyError(w http.ResponseWriter, err error) {
+	switch err {
+	case usecase.ErrCreateAPIKeyInternalError,
+		usecase.ErrListAPIKeysInternalError,
+		usecase.ErrRevokeAPIKeyInternalError:
+		writeMessage(w, http.StatusInternalServerError, err.Error())
+	case usecase.ErrRevokeAPIKeyNotExists:
+		writeMessage(w, http.StatusNotFound, err.Error())
+	case usecase.ErrCreateAPIKeyInvalidScope:
+		writeMessage(w, http.StatusForbidden, err.Error())
+	default:
+		writeMessage(w, http.StatusBadRequest, err.Error())
+	}
+}
@@ -0,0 +1,131 @@
+package handler
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/sesaquecruz/go-auth-api/internal/usecase"
+
+	"github.com/go-chi/chi/v5"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func newAPIKeyRouter(h *APIKeyHandler) http.Handler {
+	r := chi.NewRouter()
+	r.Post("/users/api-keys", h.CreateAPIKey)
+	r.Get("/users/api-keys", h.ListAPIKeys)
+	r.Delete("/users/api-keys/{id}", h.RevokeAPIKey)
+	return r
+}
+
+func Test_APIKeyHandler_NewAPIKeyHandler(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	createAPIKeyUseCase := usecase.NewMockCreateAPIKeyUseCaseInterface(ctrl)
+	listAPIKeysUseCase := usecase.NewMockListAPIKeysUseCaseInterface(ctrl)
+	revokeAPIKeyUseCase := usecase.NewMockRevokeAPIKeyUseCaseInterface(ctrl)
+
+	apiKeyHandler := NewAPIKeyHandler(createAPIKeyUseCase, listAPIKeysUseCase, revokeAPIKeyUseCase)
+	assert.NotNil(t, apiKeyHandler)
+	assert.Equal(t, createAPIKeyUseCase, apiKeyHandler.CreateAPIKeyUseCase)
+	assert.Equal(t, listAPIKeysUseCase, apiKeyHandler.ListAPIKeysUseCase)
+	assert.Equal(t, revokeAPIKeyUseCase, apiKeyHandler.RevokeAPIKeyUseCase)
+}
+
+func Test_APIKeyHandler_CreateAPIKey(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	sub := uuid.NewString()
+	input := usecase.CreateAPIKeyUseCaseInputDTO{UserID: sub, Name: "ci", Scopes: []string{"users:read"}, ExpiresInSeconds: 3600}
+	output := &usecase.CreateAPIKeyUseCaseOutputDTO{ID: uuid.NewString(), Name: "ci", Key: "ak_0123456789ab_secret"}
+
+	createAPIKeyUseCase := usecase.NewMockCreateAPIKeyUseCaseInterface(ctrl)
+	createAPIKeyUseCase.EXPECT().Execute(gomock.Any(), input).Return(output, nil).Times(1)
+	createAPIKeyUseCase.EXPECT().Execute(gomock.Any(), input).Return(nil, usecase.ErrCreateAPIKeyInvalidScope).Times(1)
+	createAPIKeyUseCase.EXPECT().Execute(gomock.Any(), input).Return(nil, usecase.ErrCreateAPIKeyInvalidData).Times(1)
+
+	router := newAPIKeyRouter(&APIKeyHandler{CreateAPIKeyUseCase: createAPIKeyUseCase})
+	body := APIKeyHandlerInputDTO{Name: "ci", Scopes: []string{"users:read"}, ExpiresInSeconds: 3600}
+
+	rr := httptest.NewRecorder()
+	router.ServeHTTP(rr, organizationRequest(t, http.MethodPost, "/users/api-keys", sub, body))
+	assert.Equal(t, http.StatusCreated, rr.Code)
+	assert.Equal(t, "no-store", rr.Header().Get("Cache-Control"))
+
+	var created usecase.CreateAPIKeyUseCaseOutputDTO
+	require.Nil(t, json.NewDecoder(rr.Body).Decode(&created))
+	assert.Equal(t, output.Key, created.Key)
+
+	for _, expected := range []int{http.StatusForbidden, http.StatusBadRequest} {
+		rr = httptest.NewRecorder()
+		router.ServeHTTP(rr, organizationRequest(t, http.MethodPost, "/users/api-keys", sub, body))
+		assert.Equal(t, expected, rr.Code)
+	}
+
+	rr = httptest.NewRecorder()
+	router.ServeHTTP(rr, organizationRequest(t, http.MethodPost, "/users/api-keys", "", body))
+	assert.Equal(t, http.StatusUnauthorized, rr.Code)
+}
+
+func Test_APIKeyHandler_CreateAPIKey_WhenMadeWithAPIKey(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	createAPIKeyUseCase := usecase.NewMockCreateAPIKeyUseCaseInterface(ctrl)
+	createAPIKeyUseCase.EXPECT().Execute(gomock.Any(), gomock.Any()).Times(0)
+
+	req := organizationRequest(t, http.MethodPost, "/users/api-keys", "", APIKeyHandlerInputDTO{Name: "ci"})
+	req = req.WithContext(apiKeyContext(uuid.NewString()))
+
+	rr := httptest.NewRecorder()
+	newAPIKeyRouter(&APIKeyHandler{CreateAPIKeyUseCase: createAPIKeyUseCase}).ServeHTTP(rr, req)
+	assert.Equal(t, http.StatusForbidden, rr.Code)
+}
+
+func Test_APIKeyHandler_ListAPIKeys(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	sub := uuid.NewString()
+	output := &usecase.ListAPIKeysUseCaseOutputDTO{Keys: []usecase.APIKeyOutputDTO{{ID: uuid.NewString(), Name: "ci"}}}
+
+	listAPIKeysUseCase := usecase.NewMockListAPIKeysUseCaseInterface(ctrl)
+	listAPIKeysUseCase.EXPECT().Execute(gomock.Any(), usecase.ListAPIKeysUseCaseInputDTO{UserID: sub}).Return(output, nil).Times(1)
+
+	rr := httptest.NewRecorder()
+	newAPIKeyRouter(&APIKeyHandler{ListAPIKeysUseCase: listAPIKeysUseCase}).ServeHTTP(rr, organizationRequest(t, http.MethodGet, "/users/api-keys", sub, nil))
+	assert.Equal(t, http.StatusOK, rr.Code)
+
+	var body usecase.ListAPIKeysUseCaseOutputDTO
+	require.Nil(t, json.NewDecoder(rr.Body).Decode(&body))
+	assert.Equal(t, output.Keys[0].ID, body.Keys[0].ID)
+}
+
+func Test_APIKeyHandler_RevokeAPIKey(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	sub := uuid.NewString()
+	id := uuid.NewString()
+	input := usecase.RevokeAPIKeyUseCaseInputDTO{UserID: sub, ID: id}
+
+	revokeAPIKeyUseCase := usecase.NewMockRevokeAPIKeyUseCaseInterface(ctrl)
+	revokeAPIKeyUseCase.EXPECT().Execute(gomock.Any(), input).Return(nil).Times(1)
+	revokeAPIKeyUseCase.EXPECT().Execute(gomock.Any(), input).Return(usecase.ErrRevokeAPIKeyNotExists).Times(1)
+	revokeAPIKeyUseCase.EXPECT().Execute(gomock.Any(), input).Return(usecase.ErrRevokeAPIKeyInternalError).Times(1)
+
+	router := newAPIKeyRouter(&APIKeyHandler{RevokeAPIKeyUseCase: revokeAPIKeyUseCase})
+
+	for _, expected := range []int{http.StatusOK, http.StatusNotFound, http.StatusInternalServerError} {
+		rr := httptest.NewRecorder()
+		router.ServeHTTP(rr, organizationRequest(t, http.MethodDelete, "/users/api-keys/"+id, sub, nil))
+		assert.Equal(t, expected, rr.Code)
+	}
+}
@@ -0,0 +1,94 @@
+package handler
+
+import (
+	"fmt"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/sesaquecruz/go-auth-api/internal/infra/web/middleware"
+
+	"github.com/go-chi/jwtauth"
+)
+
+// browserMode is the value of the "mode" query parameter asking for the token in cookies rather than headers.
+const browserMode = "browser"
+
+// CookieOptions are the attributes of the cookies of browser sessions.
+type CookieOptions struct {
+	Secure   bool
+	SameSite http.SameSite
+}
+
+func ParseSameSite(value string) (http.SameSite, error) {
+	switch strings.ToLower(value) {
+	case "strict":
+		return http.SameSiteStrictMode, nil
+	case "lax":
+		return http.SameSiteLaxMode, nil
+	case "none":
+		return http.SameSiteNoneMode, nil
+	default:
+		return 0, fmt.Errorf("invalid same site mode %q", value)
+	}
+}
+
+// setSession sets the token in an HttpOnly cookie along with a fresh CSRF token, which scripts can read.
+func (o CookieOptions) setSession(w http.ResponseWriter, token string, expires time.Time) error {
+	csrfToken, err := middleware.NewCSRFToken()
+	if err != nil {
+		return err
+	}
+
+	http.SetCookie(w, o.cookie(middleware.SessionCookie, token, expires, true))
+	http.SetCookie(w, o.cookie(middleware.CSRFCookie, csrfToken, expires, false))
+	return nil
+}
+
+// reissueToken replaces the token of the request with one holding changes on top of its claims, expiration
+// included. Browser sessions get it in their cookie, other clients in the Authorization header.
+func (o CookieOptions) reissueToken(w http.ResponseWriter, r *http.Request, jwtAuth *jwtauth.JWTAuth, changes map[string]interface{}) error {
+	_, claims, err := jwtauth.FromContext(r.Context())
+	if err != nil {
+		return err
+	}
+
+	payload := make(map[string]interface{}, len(claims)+len(changes))
+	for name, value := range claims {
+		payload[name] = value
+	}
+	for name, value := range changes {
+		payload[name] = value
+	}
+
+	t, token, err := jwtAuth.Encode(payload)
+	if err != nil {
+		return err
+	}
+
+	if middleware.AuthenticatedByCookie(r.Context()) {
+		return o.setSession(w, token, t.Expiration())
+	}
+	w.Header().Set("Authorization", "Bearer "+token)
+	return nil
+}
+
+func (o CookieOptions) clearSession(w http.ResponseWriter) {
+	for _, name := range []string{middleware.SessionCookie, middleware.CSRFCookie} {
+		cookie := o.cookie(name, "", time.Unix(0, 0), name == middleware.SessionCookie)
+		cookie.MaxAge = -1
+		http.SetCookie(w, cookie)
+	}
+}
+
+func (o CookieOptions) cookie(name string, value string, expires time.Time, httpOnly bool) *http.Cookie {
+	return &http.Cookie{
+		Name:     name,
+		Value:    value,
+		Path:     "/",
+		Expires:  expires,
+		Secure:   o.Secure,
+		HttpOnly: httpOnly,
+		SameSite: o.SameSite,
+	}
+}
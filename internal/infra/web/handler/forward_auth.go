@@ -0,0 +1,71 @@
+package handler
+
+import (
+	"net/http"
+	"strings"
+
+	"github.com/sesaquecruz/go-auth-api/internal/usecase"
+)
+
+type ForwardAuthHandler struct {
+	FindUserUseCase usecase.FindUserUseCaseInterface
+}
+
+func NewForwardAuthHandler(findUserUseCase usecase.FindUserUseCaseInterface) *ForwardAuthHandler {
+	return &ForwardAuthHandler{
+		FindUserUseCase: findUserUseCase,
+	}
+}
+
+// Verify godoc
+// @Sumary		Verify forwarded request
+// @Description	Authorize a request forwarded by a reverse proxy, by the bearer token or session cookie it carries and the rule matching its path
+// @Tags		auth
+// @Param		X-Forwarded-Uri		header	string	false	"path of the forwarded request, or X-Original-URI"
+// @Param		X-Forwarded-Method	header	string	false	"method of the forwarded request, or X-Original-Method"
+// @Success		200
+// @Header		200	{string}	X-User-Id		"user id, unless the path is public"
+// @Header		200	{string}	X-User-Email	"user email, unless the path is public"
+// @Header		200	{string}	X-User-Roles	"comma separated user roles, unless the path is public"
+// @Failure		400			{object}	handler.UserHandlerMessageDTO
+// @Failure		401			{object}	handler.UserHandlerMessageDTO
+// @Failure		403			{object}	handler.UserHandlerMessageDTO
+// @Failure		500			{object}	handler.UserHandlerMessageDTO
+// @Router		/auth/verify	[get]
+func (h *ForwardAuthHandler) Verify(w http.ResponseWriter, r *http.Request) {
+	principal, ok := requestPrincipal(w, r)
+	if !ok {
+		return
+	}
+
+	output, err := h.FindUserUseCase.Execute(r.Context(), usecase.FindUserUseCaseInputDTO{ID: principal.UserID.String()})
+	if err == usecase.ErrFindUserInternalError {
+		writeMessage(w, http.StatusInternalServerError, err.Error())
+		return
+	}
+	if err != nil {
+		writeMessage(w, http.StatusUnauthorized, "unauthorized")
+		return
+	}
+
+	w.Header().Set("X-User-Id", output.ID)
+	w.Header().Set("X-User-Email", output.Email)
+	w.Header().Set("X-User-Roles", strings.Join(principal.Roles, ","))
+	w.WriteHeader(http.StatusOK)
+}
+
+// Introspect godoc
+// @Sumary		Introspect token
+// @Description	Tell whether the bearer token is still valid, whatever the forward auth rules, for services verifying tokens themselves
+// @Tags		auth
+// @Security	ApiKeyAuth
+// @Success		200
+// @Header		200	{string}	X-User-Id		"user id"
+// @Header		200	{string}	X-User-Email	"user email"
+// @Header		200	{string}	X-User-Roles	"comma separated user roles"
+// @Failure		401			{object}	handler.UserHandlerMessageDTO
+// @Failure		500			{object}	handler.UserHandlerMessageDTO
+// @Router		/auth/introspect	[get]
+func (h *ForwardAuthHandler) Introspect(w http.ResponseWriter, r *http.Request) {
+	h.Verify(w, r)
+}
@@ -0,0 +1,89 @@
+package handler
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/sesaquecruz/go-auth-api/internal/usecase"
+
+	"github.com/go-chi/jwtauth"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func Test_ForwardAuthHandler_NewForwardAuthHandler(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	findUserUseCase := usecase.NewMockFindUserUseCaseInterface(ctrl)
+
+	forwardAuthHandler := NewForwardAuthHandler(findUserUseCase)
+	assert.NotNil(t, forwardAuthHandler)
+	assert.Equal(t, findUserUseCase, forwardAuthHandler.FindUserUseCase)
+}
+
+func Test_ForwardAuthHandler_Verify(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	sub := uuid.NewString()
+	input := usecase.FindUserUseCaseInputDTO{ID: sub}
+
+	findUserUseCase := usecase.NewMockFindUserUseCaseInterface(ctrl)
+	findUserUseCase.EXPECT().Execute(gomock.Any(), input).Return(&usecase.FindUserUseCaseOutputDTO{ID: sub, Email: "user@mail.com"}, nil).Times(1)
+	findUserUseCase.EXPECT().Execute(gomock.Any(), input).Return(nil, usecase.ErrFindUserUserNotExists).Times(1)
+	findUserUseCase.EXPECT().Execute(gomock.Any(), input).Return(nil, usecase.ErrFindUserInternalError).Times(1)
+
+	token, _, err := jwtauth.New("HS256", []byte("secret"), nil).Encode(map[string]interface{}{
+		"sub":   sub,
+		"roles": []string{"admin", "support"},
+	})
+	require.Nil(t, err)
+
+	handler := &ForwardAuthHandler{FindUserUseCase: findUserUseCase}
+	tests := []int{http.StatusOK, http.StatusUnauthorized, http.StatusInternalServerError}
+	for _, status := range tests {
+		req := httptest.NewRequest(http.MethodGet, "/auth/verify", nil)
+		req = req.WithContext(authenticated(t, jwtauth.NewContext(context.Background(), token, nil)))
+
+		rr := httptest.NewRecorder()
+		handler.Verify(rr, req)
+		assert.Equal(t, status, rr.Code)
+
+		if status == http.StatusOK {
+			assert.Equal(t, sub, rr.Header().Get("X-User-Id"))
+			assert.Equal(t, "user@mail.com", rr.Header().Get("X-User-Email"))
+			assert.Equal(t, "admin,support", rr.Header().Get("X-User-Roles"))
+		} else {
+			assert.Empty(t, rr.Header().Get("X-User-Id"))
+		}
+	}
+}
+
+func Test_ForwardAuthHandler_Introspect(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	sub := uuid.NewString()
+	findUserUseCase := usecase.NewMockFindUserUseCaseInterface(ctrl)
+	findUserUseCase.EXPECT().
+		Execute(gomock.Any(), usecase.FindUserUseCaseInputDTO{ID: sub}).
+		Return(&usecase.FindUserUseCaseOutputDTO{ID: sub, Email: "user@mail.com"}, nil).
+		Times(1)
+
+	token, _, err := jwtauth.New("HS256", []byte("secret"), nil).Encode(map[string]interface{}{"sub": sub})
+	require.Nil(t, err)
+
+	req := httptest.NewRequest(http.MethodGet, "/auth/introspect", nil)
+	req = req.WithContext(authenticated(t, jwtauth.NewContext(context.Background(), token, nil)))
+
+	rr := httptest.NewRecorder()
+	(&ForwardAuthHandler{FindUserUseCase: findUserUseCase}).Introspect(rr, req)
+
+	assert.Equal(t, http.StatusOK, rr.Code)
+	assert.Equal(t, sub, rr.Header().Get("X-User-Id"))
+}
@@ -0,0 +1,30 @@
+package handler
+
+import (
+	"encoding/json"
+	"net/http"
+
+	"github.com/lestrrat-go/jwx/jwk"
+)
+
+// jwksMaxAge is how long, in seconds, other services may cache the keys.
+const jwksMaxAge = "300"
+
+type JWKSHandler struct {
+	Keys jwk.Set
+}
+
+func NewJWKSHandler(keys jwk.Set) *JWKSHandler {
+	return &JWKSHandler{
+		Keys: keys,
+	}
+}
+
+// GetJWKS serves the public keys tokens are signed with as a JSON Web Key Set. It is published at
+// /.well-known/jwks.json, out of the API base path, so it is left out of the API documentation.
+func (h *JWKSHandler) GetJWKS(w http.ResponseWriter, r *http.Request) {
+	w.Header().Set("Content-Type", "application/json")
+	w.Header().Set("Cache-Control", "public, max-age="+jwksMaxAge)
+	w.WriteHeader(http.StatusOK)
+	json.NewEncoder(w).Encode(h.Keys)
+}
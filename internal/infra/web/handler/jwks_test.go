@@ -0,0 +1,49 @@
+package handler
+
+import (
+	"crypto/rand"
+	"crypto/rsa"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/lestrrat-go/jwx/jwk"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func Test_JWKSHandler_NewJWKSHandler(t *testing.T) {
+	keys := jwk.NewSet()
+
+	jwksHandler := NewJWKSHandler(keys)
+	assert.NotNil(t, jwksHandler)
+	assert.Equal(t, keys, jwksHandler.Keys)
+}
+
+func Test_JWKSHandler_GetJWKS(t *testing.T) {
+	privateKey, err := rsa.GenerateKey(rand.Reader, 2048)
+	require.Nil(t, err)
+
+	key, err := jwk.New(&privateKey.PublicKey)
+	require.Nil(t, err)
+	require.Nil(t, key.Set(jwk.KeyIDKey, "kid"))
+
+	keys := jwk.NewSet()
+	keys.Add(key)
+
+	rr := httptest.NewRecorder()
+	NewJWKSHandler(keys).GetJWKS(rr, httptest.NewRequest(http.MethodGet, "/.well-known/jwks.json", nil))
+	assert.Equal(t, http.StatusOK, rr.Code)
+	assert.Equal(t, "application/json", rr.Header().Get("Content-Type"))
+	assert.Equal(t, "public, max-age=300", rr.Header().Get("Cache-Control"))
+
+	published, err := jwk.Parse(rr.Body.Bytes())
+	require.Nil(t, err)
+	require.Equal(t, 1, published.Len())
+
+	publishedKey, ok := published.LookupKeyID("kid")
+	require.True(t, ok)
+	var publicKey rsa.PublicKey
+	require.Nil(t, publishedKey.Raw(&publicKey))
+	assert.Equal(t, privateKey.PublicKey, publicKey)
+}
@@ -0,0 +1,446 @@
+package handler
+
+import (
+	"encoding/json"
+	"net/http"
+
+	"github.com/sesaquecruz/go-auth-api/internal/infra/web/middleware"
+	"github.com/sesaquecruz/go-auth-api/internal/usecase"
+
+	"github.com/go-chi/chi/v5"
+	"github.com/go-chi/jwtauth"
+)
+
+type OrganizationHandlerInputDTO struct {
+	Name string `json:"name"`
+}
+
+type InvitationHandlerInputDTO struct {
+	Email string `json:"email"`
+	Role  string `json:"role"`
+}
+
+type InvitationTokenHandlerInputDTO struct {
+	Token string `json:"token"`
+}
+
+type MemberRoleHandlerInputDTO struct {
+	Role string `json:"role"`
+}
+
+type SwitchOrganizationHandlerInputDTO struct {
+	OrganizationID string `json:"organization_id"`
+}
+
+type OrganizationHandler struct {
+	JWTAuth                   *jwtauth.JWTAuth
+	Cookies                   CookieOptions
+	CreateOrganizationUseCase usecase.CreateOrganizationUseCaseInterface
+	InviteMemberUseCase       usecase.InviteMemberUseCaseInterface
+	RespondInvitationUseCase  usecase.RespondInvitationUseCaseInterface
+	ListMembersUseCase        usecase.ListMembersUseCaseInterface
+	SetMemberRoleUseCase      usecase.SetMemberRoleUseCaseInterface
+	RemoveMemberUseCase       usecase.RemoveMemberUseCaseInterface
+	SwitchOrganizationUseCase usecase.SwitchOrganizationUseCaseInterface
+}
+
+func NewOrganizationHandler(
+	jwtAuth *jwtauth.JWTAuth,
+	cookies CookieOptions,
+	createOrganizationUseCase usecase.CreateOrganizationUseCaseInterface,
+	inviteMemberUseCase usecase.InviteMemberUseCaseInterface,
+	respondInvitationUseCase usecase.RespondInvitationUseCaseInterface,
+	listMembersUseCase usecase.ListMembersUseCaseInterface,
+	setMemberRoleUseCase usecase.SetMemberRoleUseCaseInterface,
+	removeMemberUseCase usecase.RemoveMemberUseCaseInterface,
+	switchOrganizationUseCase usecase.SwitchOrganizationUseCaseInterface,
+) *OrganizationHandler {
+	return &OrganizationHandler{
+		JWTAuth:                   jwtAuth,
+		Cookies:                   cookies,
+		CreateOrganizationUseCase: createOrganizationUseCase,
+		InviteMemberUseCase:       inviteMemberUseCase,
+		RespondInvitationUseCase:  respondInvitationUseCase,
+		ListMembersUseCase:        listMembersUseCase,
+		SetMemberRoleUseCase:      setMemberRoleUseCase,
+		RemoveMemberUseCase:       removeMemberUseCase,
+		SwitchOrganizationUseCase: switchOrganizationUseCase,
+	}
+}
+
+// Create organization godoc
+// @Sumary		Create organization
+// @Description	Create an organization owned by the user
+// @Tags		organizations
+// @Accept		json
+// @Produce		json
+// @Param		request		body		handler.OrganizationHandlerInputDTO	true	"organization request"
+// @Success		201			{object}	usecase.CreateOrganizationUseCaseOutputDTO
+// @Failure		400			{object}	handler.UserHandlerMessageDTO
+// @Failure		401			{object}	handler.UserHandlerMessageDTO
+// @Failure		403			{object}	handler.UserHandlerMessageDTO
+// @Failure		500			{object}	handler.UserHandlerMessageDTO
+// @Router		/organizations	[post]
+// @Security	ApiKeyAuth
+func (h *OrganizationHandler) CreateOrganization(w http.ResponseWriter, r *http.Request) {
+	sub, ok := subject(w, r)
+	if !ok {
+		return
+	}
+
+	var data OrganizationHandlerInputDTO
+	err := json.NewDecoder(r.Body).Decode(&data)
+	if err != nil {
+		w.WriteHeader(http.StatusBadRequest)
+		return
+	}
+
+	output, err := h.CreateOrganizationUseCase.Execute(r.Context(), usecase.CreateOrganizationUseCaseInputDTO{
+		UserID: sub,
+		Name:   data.Name,
+	})
+	if err != nil {
+		writeOrganizationError(w, err)
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(http.StatusCreated)
+	json.NewEncoder(w).Encode(output)
+}
+
+// List members godoc
+// @Sumary		List members
+// @Description	List the members of an organization of the user
+// @Tags		organizations
+// @Produce		json
+// @Param		id			path		string	true	"organization id"
+// @Success		200			{object}	usecase.ListMembersUseCaseOutputDTO
+// @Failure		400			{object}	handler.UserHandlerMessageDTO
+// @Failure		401			{object}	handler.UserHandlerMessageDTO
+// @Failure		403			{object}	handler.UserHandlerMessageDTO
+// @Failure		404			{object}	handler.UserHandlerMessageDTO
+// @Failure		500			{object}	handler.UserHandlerMessageDTO
+// @Router		/organizations/{id}/members	[get]
+// @Security	ApiKeyAuth
+func (h *OrganizationHandler) ListMembers(w http.ResponseWriter, r *http.Request) {
+	sub, ok := subject(w, r)
+	if !ok {
+		return
+	}
+
+	output, err := h.ListMembersUseCase.Execute(r.Context(), usecase.ListMembersUseCaseInputDTO{
+		OrganizationID: chi.URLParam(r, "id"),
+		UserID:         sub,
+	})
+	if err != nil {
+		writeOrganizationError(w, err)
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(http.StatusOK)
+	json.NewEncoder(w).Encode(output)
+}
+
+// Invite member godoc
+// @Sumary		Invite member
+// @Description	Email an invite link to join an organization with a role
+// @Tags		organizations
+// @Accept		json
+// @Produce		json
+// @Param		id			path		string								true	"organization id"
+// @Param		request		body		handler.InvitationHandlerInputDTO	true	"invitation request"
+// @Success		201			{object}	usecase.InviteMemberUseCaseOutputDTO
+// @Failure		400			{object}	handler.UserHandlerMessageDTO
+// @Failure		401			{object}	handler.UserHandlerMessageDTO
+// @Failure		403			{object}	handler.UserHandlerMessageDTO
+// @Failure		404			{object}	handler.UserHandlerMessageDTO
+// @Failure		409			{object}	handler.UserHandlerMessageDTO
+// @Failure		500			{object}	handler.UserHandlerMessageDTO
+// @Router		/organizations/{id}/invitations	[post]
+// @Security	ApiKeyAuth
+func (h *OrganizationHandler) InviteMember(w http.ResponseWriter, r *http.Request) {
+	sub, ok := subject(w, r)
+	if !ok {
+		return
+	}
+
+	var data InvitationHandlerInputDTO
+	err := json.NewDecoder(r.Body).Decode(&data)
+	if err != nil {
+		w.WriteHeader(http.StatusBadRequest)
+		return
+	}
+
+	output, err := h.InviteMemberUseCase.Execute(r.Context(), usecase.InviteMemberUseCaseInputDTO{
+		OrganizationID: chi.URLParam(r, "id"),
+		UserID:         sub,
+		Email:          data.Email,
+		Role:           data.Role,
+	})
+	if err != nil {
+		writeOrganizationError(w, err)
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(http.StatusCreated)
+	json.NewEncoder(w).Encode(output)
+}
+
+// Set member role godoc
+// @Sumary		Set member role
+// @Description	Change the role of a member of an organization
+// @Tags		organizations
+// @Accept		json
+// @Produce		json
+// @Param		id			path		string								true	"organization id"
+// @Param		user_id		path		string								true	"member user id"
+// @Param		request		body		handler.MemberRoleHandlerInputDTO	true	"role request"
+// @Success		200
+// @Failure		400			{object}	handler.UserHandlerMessageDTO
+// @Failure		401			{object}	handler.UserHandlerMessageDTO
+// @Failure		403			{object}	handler.UserHandlerMessageDTO
+// @Failure		404			{object}	handler.UserHandlerMessageDTO
+// @Failure		409			{object}	handler.UserHandlerMessageDTO
+// @Failure		500			{object}	handler.UserHandlerMessageDTO
+// @Router		/organizations/{id}/members/{user_id}	[put]
+// @Security	ApiKeyAuth
+func (h *OrganizationHandler) SetMemberRole(w http.ResponseWriter, r *http.Request) {
+	sub, ok := subject(w, r)
+	if !ok {
+		return
+	}
+
+	var data MemberRoleHandlerInputDTO
+	err := json.NewDecoder(r.Body).Decode(&data)
+	if err != nil {
+		w.WriteHeader(http.StatusBadRequest)
+		return
+	}
+
+	err = h.SetMemberRoleUseCase.Execute(r.Context(), usecase.SetMemberRoleUseCaseInputDTO{
+		OrganizationID: chi.URLParam(r, "id"),
+		UserID:         sub,
+		MemberID:       chi.URLParam(r, "user_id"),
+		Role:           data.Role,
+	})
+	if err != nil {
+		writeOrganizationError(w, err)
+		return
+	}
+
+	w.WriteHeader(http.StatusOK)
+}
+
+// Remove member godoc
+// @Sumary		Remove member
+// @Description	Remove a member from an organization, or leave it
+// @Tags		organizations
+// @Produce		json
+// @Param		id			path		string	true	"organization id"
+// @Param		user_id		path		string	true	"member user id"
+// @Success		200
+// @Failure		400			{object}	handler.UserHandlerMessageDTO
+// @Failure		401			{object}	handler.UserHandlerMessageDTO
+// @Failure		403			{object}	handler.UserHandlerMessageDTO
+// @Failure		404			{object}	handler.UserHandlerMessageDTO
+// @Failure		409			{object}	handler.UserHandlerMessageDTO
+// @Failure		500			{object}	handler.UserHandlerMessageDTO
+// @Router		/organizations/{id}/members/{user_id}	[delete]
+// @Security	ApiKeyAuth
+func (h *OrganizationHandler) RemoveMember(w http.ResponseWriter, r *http.Request) {
+	sub, ok := subject(w, r)
+	if !ok {
+		return
+	}
+
+	err := h.RemoveMemberUseCase.Execute(r.Context(), usecase.RemoveMemberUseCaseInputDTO{
+		OrganizationID: chi.URLParam(r, "id"),
+		UserID:         sub,
+		MemberID:       chi.URLParam(r, "user_id"),
+	})
+	if err != nil {
+		writeOrganizationError(w, err)
+		return
+	}
+
+	w.WriteHeader(http.StatusOK)
+}
+
+// Accept invitation godoc
+// @Sumary		Accept invitation
+// @Description	Join the organization of an invitation sent to the email of the user
+// @Tags		organizations
+// @Accept		json
+// @Produce		json
+// @Param		request		body		handler.InvitationTokenHandlerInputDTO	true	"token of the invite link"
+// @Success		200			{object}	usecase.RespondInvitationUseCaseOutputDTO
+// @Failure		400			{object}	handler.UserHandlerMessageDTO
+// @Failure		401			{object}	handler.UserHandlerMessageDTO
+// @Failure		403			{object}	handler.UserHandlerMessageDTO
+// @Failure		404			{object}	handler.UserHandlerMessageDTO
+// @Failure		409			{object}	handler.UserHandlerMessageDTO
+// @Failure		410			{object}	handler.UserHandlerMessageDTO
+// @Failure		500			{object}	handler.UserHandlerMessageDTO
+// @Router		/invitations/accept	[post]
+// @Security	ApiKeyAuth
+func (h *OrganizationHandler) AcceptInvitation(w http.ResponseWriter, r *http.Request) {
+	h.respondInvitation(w, r, true)
+}
+
+// Decline invitation godoc
+// @Sumary		Decline invitation
+// @Description	Decline an invitation sent to the email of the user
+// @Tags		organizations
+// @Accept		json
+// @Produce		json
+// @Param		request		body		handler.InvitationTokenHandlerInputDTO	true	"token of the invite link"
+// @Success		200			{object}	usecase.RespondInvitationUseCaseOutputDTO
+// @Failure		400			{object}	handler.UserHandlerMessageDTO
+// @Failure		401			{object}	handler.UserHandlerMessageDTO
+// @Failure		403			{object}	handler.UserHandlerMessageDTO
+// @Failure		404			{object}	handler.UserHandlerMessageDTO
+// @Failure		410			{object}	handler.UserHandlerMessageDTO
+// @Failure		500			{object}	handler.UserHandlerMessageDTO
+// @Router		/invitations/decline	[post]
+// @Security	ApiKeyAuth
+func (h *OrganizationHandler) DeclineInvitation(w http.ResponseWriter, r *http.Request) {
+	h.respondInvitation(w, r, false)
+}
+
+func (h *OrganizationHandler) respondInvitation(w http.ResponseWriter, r *http.Request, accept bool) {
+	sub, ok := subject(w, r)
+	if !ok {
+		return
+	}
+
+	var data InvitationTokenHandlerInputDTO
+	err := json.NewDecoder(r.Body).Decode(&data)
+	if err != nil {
+		w.WriteHeader(http.StatusBadRequest)
+		return
+	}
+
+	output, err := h.RespondInvitationUseCase.Execute(r.Context(), usecase.RespondInvitationUseCaseInputDTO{
+		Token:  data.Token,
+		UserID: sub,
+		Accept: accept,
+	})
+	if err != nil {
+		writeOrganizationError(w, err)
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(http.StatusOK)
+	json.NewEncoder(w).Encode(output)
+}
+
+// Switch organization godoc
+// @Sumary		Switch organization
+// @Description	Re-issue the token of the user with an organization of theirs as the active one
+// @Tags		login
+// @Accept		json
+// @Produce		json
+// @Param		request		body		handler.SwitchOrganizationHandlerInputDTO	true	"organization to make active"
+// @Success		200
+// @Failure		400			{object}	handler.UserHandlerMessageDTO
+// @Failure		401			{object}	handler.UserHandlerMessageDTO
+// @Failure		403			{object}	handler.UserHandlerMessageDTO
+// @Failure		404			{object}	handler.UserHandlerMessageDTO
+// @Failure		500			{object}	handler.UserHandlerMessageDTO
+// @Router		/token/organization	[post]
+// @Security	ApiKeyAuth
+func (h *OrganizationHandler) SwitchOrganization(w http.ResponseWriter, r *http.Request) {
+	principal, ok := requestPrincipal(w, r)
+	if !ok {
+		return
+	}
+
+	// The token of an API key is never handed out.
+	if principal.AuthMethod == middleware.AuthMethodAPIKey {
+		writeMessage(w, http.StatusForbidden, "api keys cannot switch organization")
+		return
+	}
+
+	var data SwitchOrganizationHandlerInputDTO
+	err := json.NewDecoder(r.Body).Decode(&data)
+	if err != nil {
+		w.WriteHeader(http.StatusBadRequest)
+		return
+	}
+
+	output, err := h.SwitchOrganizationUseCase.Execute(r.Context(), usecase.SwitchOrganizationUseCaseInputDTO{
+		OrganizationID: data.OrganizationID,
+		UserID:         principal.UserID.String(),
+	})
+	if err != nil {
+		writeOrganizationError(w, err)
+		return
+	}
+
+	err = h.Cookies.reissueToken(w, r, h.JWTAuth, map[string]interface{}{
+		"oid":      output.OrganizationID,
+		"org_role": output.Role,
+	})
+	if err != nil {
+		writeMessage(w, http.StatusInternalServerError, err.Error())
+		return
+	}
+	w.WriteHeader(http.StatusOK)
+}
+
+// requestPrincipal is the principal middleware.Authenticator resolved for the request.
+func requestPrincipal(w http.ResponseWriter, r *http.Request) (*middleware.Principal, bool) {
+	principal, ok := middleware.PrincipalFromContext(r.Context())
+	if !ok {
+		w.WriteHeader(http.StatusUnauthorized)
+	}
+	return principal, ok
+}
+
+func subject(w http.ResponseWriter, r *http.Request) (string, bool) {
+	principal, ok := requestPrincipal(w, r)
+	if !ok {
+		return "", false
+	}
+	return principal.UserID.String(), true
+}
+
+func writeOrganizationError(w http.ResponseWriter, err error) {
+	switch err {
+	case usecase.ErrCreateOrganizationInternalError,
+		usecase.ErrInviteMemberInternalError,
+		usecase.ErrRespondInvitationInternalError,
+		usecase.ErrListMembersInternalError,
+		usecase.ErrSetMemberRoleInternalError,
+		usecase.ErrRemoveMemberInternalError,
+		usecase.ErrSwitchOrganizationInternalError:
+		writeMessage(w, http.StatusInternalServerError, err.Error())
+	case usecase.ErrInviteMemberOrganizationNotExists,
+		usecase.ErrRespondInvitationNotExists,
+		usecase.ErrListMembersOrganizationNotExists,
+		usecase.ErrSetMemberRoleOrganizationNotExists,
+		usecase.ErrSetMemberRoleMemberNotExists,
+		usecase.ErrRemoveMemberOrganizationNotExists,
+		usecase.ErrRemoveMemberMemberNotExists,
+		usecase.ErrSwitchOrganizationOrganizationNotExists:
+		writeMessage(w, http.StatusNotFound, err.Error())
+	case usecase.ErrInviteMemberForbidden,
+		usecase.ErrRespondInvitationForbidden,
+		usecase.ErrSetMemberRoleForbidden,
+		usecase.ErrRemoveMemberForbidden:
+		writeMessage(w, http.StatusForbidden, err.Error())
+	case usecase.ErrInviteMemberAlreadyMember,
+		usecase.ErrRespondInvitationAlreadyMember,
+		usecase.ErrSetMemberRoleLastOwner,
+		usecase.ErrRemoveMemberLastOwner:
+		writeMessage(w, http.StatusConflict, err.Error())
+	case usecase.ErrRespondInvitationExpired:
+		writeMessage(w, http.StatusGone, err.Error())
+	default:
+		writeMessage(w, http.StatusBadRequest, err.Error())
+	}
+}
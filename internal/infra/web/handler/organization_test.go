@@ -0,0 +1,383 @@
+package handler
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/sesaquecruz/go-auth-api/internal/infra/web/middleware"
+	"github.com/sesaquecruz/go-auth-api/internal/usecase"
+
+	"github.com/go-chi/chi/v5"
+	"github.com/go-chi/jwtauth"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func newOrganizationRouter(h *OrganizationHandler) http.Handler {
+	r := chi.NewRouter()
+	r.Post("/organizations", h.CreateOrganization)
+	r.Get("/organizations/{id}/members", h.ListMembers)
+	r.Post("/organizations/{id}/invitations", h.InviteMember)
+	r.Put("/organizations/{id}/members/{user_id}", h.SetMemberRole)
+	r.Delete("/organizations/{id}/members/{user_id}", h.RemoveMember)
+	r.Post("/invitations/accept", h.AcceptInvitation)
+	r.Post("/invitations/decline", h.DeclineInvitation)
+	r.Post("/token/organization", h.SwitchOrganization)
+	return r
+}
+
+// authenticated resolves the principal of the token in ctx, as middleware.Authenticator does for handlers.
+func authenticated(t *testing.T, ctx context.Context) context.Context {
+	req := httptest.NewRequest(http.MethodGet, "/", nil).WithContext(ctx)
+	middleware.Authenticator(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		ctx = r.Context()
+	})).ServeHTTP(httptest.NewRecorder(), req)
+
+	_, ok := middleware.PrincipalFromContext(ctx)
+	require.True(t, ok)
+	return ctx
+}
+
+// apiKeyContext is the context of a request VerifyAPIKey authenticated with a key of sub.
+func apiKeyContext(sub string) context.Context {
+	return middleware.WithPrincipal(context.Background(), &middleware.Principal{
+		UserID:     uuid.MustParse(sub),
+		APIKeyID:   uuid.New(),
+		AuthMethod: middleware.AuthMethodAPIKey,
+	})
+}
+
+// organizationRequest returns a request made with a token of sub, or without one when sub is empty.
+func organizationRequest(t *testing.T, method string, target string, sub string, body interface{}) *http.Request {
+	ctx := context.Background()
+	if sub != "" {
+		token, _, err := jwtauth.New("HS256", []byte("secret"), nil).Encode(map[string]interface{}{
+			"sub":   sub,
+			"tid":   uuid.NewString(),
+			"roles": []string{"support"},
+			"exp":   time.Now().Add(time.Minute).Truncate(time.Second),
+		})
+		require.Nil(t, err)
+		ctx = authenticated(t, jwtauth.NewContext(ctx, token, nil))
+	}
+
+	var data []byte
+	if body != nil {
+		var err error
+		data, err = json.Marshal(body)
+		require.Nil(t, err)
+	}
+
+	req, err := http.NewRequestWithContext(ctx, method, target, bytes.NewReader(data))
+	require.Nil(t, err)
+	return req
+}
+
+func Test_OrganizationHandler_NewOrganizationHandler(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	jwtAuth := jwtauth.New("HS256", []byte("secret"), nil)
+	createOrganizationUseCase := usecase.NewMockCreateOrganizationUseCaseInterface(ctrl)
+	inviteMemberUseCase := usecase.NewMockInviteMemberUseCaseInterface(ctrl)
+	respondInvitationUseCase := usecase.NewMockRespondInvitationUseCaseInterface(ctrl)
+	listMembersUseCase := usecase.NewMockListMembersUseCaseInterface(ctrl)
+	setMemberRoleUseCase := usecase.NewMockSetMemberRoleUseCaseInterface(ctrl)
+	removeMemberUseCase := usecase.NewMockRemoveMemberUseCaseInterface(ctrl)
+	switchOrganizationUseCase := usecase.NewMockSwitchOrganizationUseCaseInterface(ctrl)
+
+	organizationHandler := NewOrganizationHandler(
+		jwtAuth,
+		CookieOptions{Secure: true, SameSite: http.SameSiteLaxMode},
+		createOrganizationUseCase,
+		inviteMemberUseCase,
+		respondInvitationUseCase,
+		listMembersUseCase,
+		setMemberRoleUseCase,
+		removeMemberUseCase,
+		switchOrganizationUseCase,
+	)
+	assert.NotNil(t, organizationHandler)
+	assert.Equal(t, jwtAuth, organizationHandler.JWTAuth)
+	assert.Equal(t, CookieOptions{Secure: true, SameSite: http.SameSiteLaxMode}, organizationHandler.Cookies)
+	assert.Equal(t, createOrganizationUseCase, organizationHandler.CreateOrganizationUseCase)
+	assert.Equal(t, inviteMemberUseCase, organizationHandler.InviteMemberUseCase)
+	assert.Equal(t, respondInvitationUseCase, organizationHandler.RespondInvitationUseCase)
+	assert.Equal(t, listMembersUseCase, organizationHandler.ListMembersUseCase)
+	assert.Equal(t, setMemberRoleUseCase, organizationHandler.SetMemberRoleUseCase)
+	assert.Equal(t, removeMemberUseCase, organizationHandler.RemoveMemberUseCase)
+	assert.Equal(t, switchOrganizationUseCase, organizationHandler.SwitchOrganizationUseCase)
+}
+
+func Test_OrganizationHandler_CreateOrganization(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	sub := uuid.NewString()
+	output := &usecase.CreateOrganizationUseCaseOutputDTO{ID: uuid.NewString(), Name: "Acme"}
+
+	createOrganizationUseCase := usecase.NewMockCreateOrganizationUseCaseInterface(ctrl)
+	createOrganizationUseCase.EXPECT().
+		Execute(gomock.Any(), usecase.CreateOrganizationUseCaseInputDTO{UserID: sub, Name: "Acme"}).
+		Return(output, nil).
+		Times(1)
+
+	router := newOrganizationRouter(&OrganizationHandler{CreateOrganizationUseCase: createOrganizationUseCase})
+
+	rr := httptest.NewRecorder()
+	router.ServeHTTP(rr, organizationRequest(t, http.MethodPost, "/organizations", sub, OrganizationHandlerInputDTO{Name: "Acme"}))
+	assert.Equal(t, http.StatusCreated, rr.Code)
+
+	var body usecase.CreateOrganizationUseCaseOutputDTO
+	require.Nil(t, json.NewDecoder(rr.Body).Decode(&body))
+	assert.Equal(t, output.ID, body.ID)
+
+	rr = httptest.NewRecorder()
+	router.ServeHTTP(rr, organizationRequest(t, http.MethodPost, "/organizations", "", OrganizationHandlerInputDTO{Name: "Acme"}))
+	assert.Equal(t, http.StatusUnauthorized, rr.Code)
+}
+
+func Test_OrganizationHandler_ListMembers_WhenOrganizationNotExists(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	sub := uuid.NewString()
+	id := uuid.NewString()
+
+	listMembersUseCase := usecase.NewMockListMembersUseCaseInterface(ctrl)
+	listMembersUseCase.EXPECT().
+		Execute(gomock.Any(), usecase.ListMembersUseCaseInputDTO{OrganizationID: id, UserID: sub}).
+		Return(nil, usecase.ErrListMembersOrganizationNotExists).
+		Times(1)
+
+	router := newOrganizationRouter(&OrganizationHandler{ListMembersUseCase: listMembersUseCase})
+
+	rr := httptest.NewRecorder()
+	router.ServeHTTP(rr, organizationRequest(t, http.MethodGet, "/organizations/"+id+"/members", sub, nil))
+	assert.Equal(t, http.StatusNotFound, rr.Code)
+}
+
+func Test_OrganizationHandler_InviteMember(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	sub := uuid.NewString()
+	id := uuid.NewString()
+	input := usecase.InviteMemberUseCaseInputDTO{OrganizationID: id, UserID: sub, Email: "guest@mail.com", Role: "member"}
+
+	inviteMemberUseCase := usecase.NewMockInviteMemberUseCaseInterface(ctrl)
+	inviteMemberUseCase.EXPECT().Execute(gomock.Any(), input).Return(&usecase.InviteMemberUseCaseOutputDTO{ID: uuid.NewString()}, nil).Times(1)
+	inviteMemberUseCase.EXPECT().Execute(gomock.Any(), input).Return(nil, usecase.ErrInviteMemberForbidden).Times(1)
+	inviteMemberUseCase.EXPECT().Execute(gomock.Any(), input).Return(nil, usecase.ErrInviteMemberAlreadyMember).Times(1)
+
+	router := newOrganizationRouter(&OrganizationHandler{InviteMemberUseCase: inviteMemberUseCase})
+
+	for _, expected := range []int{http.StatusCreated, http.StatusForbidden, http.StatusConflict} {
+		rr := httptest.NewRecorder()
+		router.ServeHTTP(rr, organizationRequest(t, http.MethodPost, "/organizations/"+id+"/invitations", sub, InvitationHandlerInputDTO{Email: "guest@mail.com", Role: "member"}))
+		assert.Equal(t, expected, rr.Code)
+	}
+}
+
+func Test_OrganizationHandler_SetMemberRole(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	sub := uuid.NewString()
+	id := uuid.NewString()
+	memberID := uuid.NewString()
+	input := usecase.SetMemberRoleUseCaseInputDTO{OrganizationID: id, UserID: sub, MemberID: memberID, Role: "admin"}
+
+	setMemberRoleUseCase := usecase.NewMockSetMemberRoleUseCaseInterface(ctrl)
+	setMemberRoleUseCase.EXPECT().Execute(gomock.Any(), input).Return(nil).Times(1)
+	setMemberRoleUseCase.EXPECT().Execute(gomock.Any(), input).Return(usecase.ErrSetMemberRoleLastOwner).Times(1)
+
+	router := newOrganizationRouter(&OrganizationHandler{SetMemberRoleUseCase: setMemberRoleUseCase})
+
+	for _, expected := range []int{http.StatusOK, http.StatusConflict} {
+		rr := httptest.NewRecorder()
+		router.ServeHTTP(rr, organizationRequest(t, http.MethodPut, "/organizations/"+id+"/members/"+memberID, sub, MemberRoleHandlerInputDTO{Role: "admin"}))
+		assert.Equal(t, expected, rr.Code)
+	}
+}
+
+func Test_OrganizationHandler_RemoveMember(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	sub := uuid.NewString()
+	id := uuid.NewString()
+	memberID := uuid.NewString()
+	input := usecase.RemoveMemberUseCaseInputDTO{OrganizationID: id, UserID: sub, MemberID: memberID}
+
+	removeMemberUseCase := usecase.NewMockRemoveMemberUseCaseInterface(ctrl)
+	removeMemberUseCase.EXPECT().Execute(gomock.Any(), input).Return(nil).Times(1)
+	removeMemberUseCase.EXPECT().Execute(gomock.Any(), input).Return(usecase.ErrRemoveMemberForbidden).Times(1)
+
+	router := newOrganizationRouter(&OrganizationHandler{RemoveMemberUseCase: removeMemberUseCase})
+
+	for _, expected := range []int{http.StatusOK, http.StatusForbidden} {
+		rr := httptest.NewRecorder()
+		router.ServeHTTP(rr, organizationRequest(t, http.MethodDelete, "/organizations/"+id+"/members/"+memberID, sub, nil))
+		assert.Equal(t, expected, rr.Code)
+	}
+}
+
+func Test_OrganizationHandler_RespondInvitation(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	sub := uuid.NewString()
+
+	respondInvitationUseCase := usecase.NewMockRespondInvitationUseCaseInterface(ctrl)
+	respondInvitationUseCase.EXPECT().
+		Execute(gomock.Any(), usecase.RespondInvitationUseCaseInputDTO{Token: "abc", UserID: sub, Accept: true}).
+		Return(nil, usecase.ErrRespondInvitationExpired).
+		Times(1)
+	respondInvitationUseCase.EXPECT().
+		Execute(gomock.Any(), usecase.RespondInvitationUseCaseInputDTO{Token: "abc", UserID: sub, Accept: false}).
+		Return(&usecase.RespondInvitationUseCaseOutputDTO{OrganizationID: uuid.NewString(), Role: "member"}, nil).
+		Times(1)
+
+	router := newOrganizationRouter(&OrganizationHandler{RespondInvitationUseCase: respondInvitationUseCase})
+
+	rr := httptest.NewRecorder()
+	router.ServeHTTP(rr, organizationRequest(t, http.MethodPost, "/invitations/accept", sub, InvitationTokenHandlerInputDTO{Token: "abc"}))
+	assert.Equal(t, http.StatusGone, rr.Code)
+
+	rr = httptest.NewRecorder()
+	router.ServeHTTP(rr, organizationRequest(t, http.MethodPost, "/invitations/decline", sub, InvitationTokenHandlerInputDTO{Token: "abc"}))
+	assert.Equal(t, http.StatusOK, rr.Code)
+}
+
+func Test_OrganizationHandler_SwitchOrganization(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	jwtAuth := jwtauth.New("HS256", []byte("secret"), nil)
+	sub := uuid.NewString()
+	id := uuid.NewString()
+
+	switchOrganizationUseCase := usecase.NewMockSwitchOrganizationUseCaseInterface(ctrl)
+	switchOrganizationUseCase.EXPECT().
+		Execute(gomock.Any(), usecase.SwitchOrganizationUseCaseInputDTO{OrganizationID: id, UserID: sub}).
+		Return(&usecase.SwitchOrganizationUseCaseOutputDTO{OrganizationID: id, Role: "admin"}, nil).
+		Times(1)
+
+	router := newOrganizationRouter(&OrganizationHandler{JWTAuth: jwtAuth, SwitchOrganizationUseCase: switchOrganizationUseCase})
+
+	req := organizationRequest(t, http.MethodPost, "/token/organization", sub, SwitchOrganizationHandlerInputDTO{OrganizationID: id})
+	_, current, _ := jwtauth.FromContext(req.Context())
+
+	rr := httptest.NewRecorder()
+	router.ServeHTTP(rr, req)
+	require.Equal(t, http.StatusOK, rr.Code)
+
+	token, err := jwtAuth.Decode(strings.TrimPrefix(rr.Header().Get("Authorization"), "Bearer "))
+	require.Nil(t, err)
+
+	claims, err := token.AsMap(context.Background())
+	require.Nil(t, err)
+	assert.Equal(t, id, claims["oid"])
+	assert.Equal(t, "admin", claims["org_role"])
+	assert.Equal(t, sub, claims["sub"])
+	assert.Equal(t, current["tid"], claims["tid"])
+	assert.Equal(t, []interface{}{"support"}, claims["roles"])
+	assert.Equal(t, current["exp"], claims["exp"])
+}
+
+func Test_OrganizationHandler_SwitchOrganization_InBrowserMode(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	jwtAuth := jwtauth.New("HS256", []byte("secret"), nil)
+	sub := uuid.NewString()
+	id := uuid.NewString()
+
+	switchOrganizationUseCase := usecase.NewMockSwitchOrganizationUseCaseInterface(ctrl)
+	switchOrganizationUseCase.EXPECT().Execute(gomock.Any(), gomock.Any()).Return(&usecase.SwitchOrganizationUseCaseOutputDTO{OrganizationID: id, Role: "admin"}, nil).Times(1)
+
+	_, current, err := jwtAuth.Encode(map[string]interface{}{"sub": sub, "exp": time.Now().Add(time.Minute).Truncate(time.Second)})
+	require.Nil(t, err)
+
+	body, err := json.Marshal(SwitchOrganizationHandlerInputDTO{OrganizationID: id})
+	require.Nil(t, err)
+	req := httptest.NewRequest(http.MethodPost, "/token/organization", bytes.NewReader(body))
+	req.AddCookie(&http.Cookie{Name: middleware.SessionCookie, Value: current})
+
+	handler := middleware.Verifier(jwtAuth)(middleware.Authenticator(newOrganizationRouter(&OrganizationHandler{JWTAuth: jwtAuth, SwitchOrganizationUseCase: switchOrganizationUseCase})))
+	rr := httptest.NewRecorder()
+	handler.ServeHTTP(rr, req)
+	require.Equal(t, http.StatusOK, rr.Code)
+	assert.Empty(t, rr.Header().Get("Authorization"))
+
+	var session *http.Cookie
+	for _, cookie := range rr.Result().Cookies() {
+		if cookie.Name == middleware.SessionCookie {
+			session = cookie
+		}
+	}
+	require.NotNil(t, session)
+	assert.True(t, session.HttpOnly)
+
+	token, err := jwtAuth.Decode(session.Value)
+	require.Nil(t, err)
+	assert.Equal(t, id, token.PrivateClaims()["oid"])
+	assert.Equal(t, token.Expiration().Unix(), session.Expires.Unix())
+}
+
+func Test_OrganizationHandler_SwitchOrganization_WhenMadeWithAPIKey(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	jwtAuth := jwtauth.New("HS256", []byte("secret"), nil)
+
+	authAPIKeyUseCase := usecase.NewMockAuthAPIKeyUseCaseInterface(ctrl)
+	authAPIKeyUseCase.EXPECT().
+		Execute(gomock.Any(), usecase.AuthAPIKeyUseCaseInputDTO{Key: "ak_valid"}).
+		Return(&usecase.AuthAPIKeyUseCaseOutputDTO{ID: uuid.NewString(), TenantID: uuid.NewString(), KeyID: uuid.NewString()}, nil).
+		Times(1)
+
+	switchOrganizationUseCase := usecase.NewMockSwitchOrganizationUseCaseInterface(ctrl)
+	switchOrganizationUseCase.EXPECT().Execute(gomock.Any(), gomock.Any()).Times(0)
+
+	handler := chi.Chain(
+		middleware.Verifier(jwtAuth),
+		middleware.VerifyAPIKey(jwtAuth, authAPIKeyUseCase),
+		middleware.Authenticator,
+	).Handler(newOrganizationRouter(&OrganizationHandler{JWTAuth: jwtAuth, SwitchOrganizationUseCase: switchOrganizationUseCase}))
+
+	body, err := json.Marshal(SwitchOrganizationHandlerInputDTO{OrganizationID: uuid.NewString()})
+	require.Nil(t, err)
+	req := httptest.NewRequest(http.MethodPost, "/token/organization", bytes.NewReader(body))
+	req.Header.Set("Authorization", "ApiKey ak_valid")
+
+	rr := httptest.NewRecorder()
+	handler.ServeHTTP(rr, req)
+	assert.Equal(t, http.StatusForbidden, rr.Code)
+	assert.Empty(t, rr.Header().Get("Authorization"))
+	assert.Empty(t, rr.Result().Cookies())
+}
+
+func Test_OrganizationHandler_SwitchOrganization_WhenUserIsNotMember(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	switchOrganizationUseCase := usecase.NewMockSwitchOrganizationUseCaseInterface(ctrl)
+	switchOrganizationUseCase.EXPECT().Execute(gomock.Any(), gomock.Any()).Return(nil, usecase.ErrSwitchOrganizationOrganizationNotExists).Times(1)
+
+	router := newOrganizationRouter(&OrganizationHandler{SwitchOrganizationUseCase: switchOrganizationUseCase})
+
+	rr := httptest.NewRecorder()
+	router.ServeHTTP(rr, organizationRequest(t, http.MethodPost, "/token/organization", uuid.NewString(), SwitchOrganizationHandlerInputDTO{OrganizationID: uuid.NewString()}))
+	assert.Equal(t, http.StatusNotFound, rr.Code)
+	assert.Empty(t, rr.Header().Get("Authorization"))
+}
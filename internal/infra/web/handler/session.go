@@ -0,0 +1,139 @@
+package handler
+
+import (
+	"encoding/json"
+	"net/http"
+
+	"github.com/sesaquecruz/go-auth-api/internal/usecase"
+
+	"github.com/go-chi/chi/v5"
+	"github.com/google/uuid"
+)
+
+type SessionHandler struct {
+	Cookies              CookieOptions
+	ListSessionsUseCase  usecase.ListSessionsUseCaseInterface
+	RevokeSessionUseCase usecase.RevokeSessionUseCaseInterface
+}
+
+func NewSessionHandler(
+	cookies CookieOptions,
+	listSessionsUseCase usecase.ListSessionsUseCaseInterface,
+	revokeSessionUseCase usecase.RevokeSessionUseCaseInterface,
+) *SessionHandler {
+	return &SessionHandler{
+		Cookies:              cookies,
+		ListSessionsUseCase:  listSessionsUseCase,
+		RevokeSessionUseCase: revokeSessionUseCase,
+	}
+}
+
+// List sessions godoc
+// @Sumary		List sessions
+// @Description	List the active sessions of the user, marking the one of the token as current
+// @Tags		sessions
+// @Produce		json
+// @Success		200			{object}	usecase.ListSessionsUseCaseOutputDTO
+// @Failure		401			{object}	handler.UserHandlerMessageDTO
+// @Failure		403			{object}	handler.UserHandlerMessageDTO
+// @Failure		500			{object}	handler.UserHandlerMessageDTO
+// @Router		/users/sessions	[get]
+// @Security	ApiKeyAuth
+func (h *SessionHandler) ListSessions(w http.ResponseWriter, r *http.Request) {
+	principal, ok := requestPrincipal(w, r)
+	if !ok {
+		return
+	}
+
+	input := usecase.ListSessionsUseCaseInputDTO{UserID: principal.UserID.String()}
+	if principal.SessionID != uuid.Nil {
+		input.SessionID = principal.SessionID.String()
+	}
+
+	output, err := h.ListSessionsUseCase.Execute(r.Context(), input)
+	if err != nil {
+		writeSessionError(w, err)
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(http.StatusOK)
+	json.NewEncoder(w).Encode(output)
+}
+
+// Revoke session godoc
+// @Sumary		Revoke session
+// @Description	Revoke a session of the user, which logs out the tokens issued for it
+// @Tags		sessions
+// @Param		id			path		string	true	"session id"
+// @Success		200
+// @Failure		400			{object}	handler.UserHandlerMessageDTO
+// @Failure		401			{object}	handler.UserHandlerMessageDTO
+// @Failure		403			{object}	handler.UserHandlerMessageDTO
+// @Failure		404			{object}	handler.UserHandlerMessageDTO
+// @Failure		500			{object}	handler.UserHandlerMessageDTO
+// @Router		/users/sessions/{id}	[delete]
+// @Security	ApiKeyAuth
+func (h *SessionHandler) RevokeSession(w http.ResponseWriter, r *http.Request) {
+	sub, ok := subject(w, r)
+	if !ok {
+		return
+	}
+
+	err := h.RevokeSessionUseCase.Execute(r.Context(), usecase.RevokeSessionUseCaseInputDTO{
+		UserID: sub,
+		ID:     chi.URLParam(r, "id"),
+	})
+	if err != nil {
+		writeSessionError(w, err)
+		return
+	}
+
+	w.WriteHeader(http.StatusOK)
+}
+
+// Logout godoc
+// @Sumary		Logout
+// @Description	Revoke the session of the token and clear the browser session cookies
+// @Tags		sessions
+// @Param		X-CSRF-Token	header		string	false	"csrf token, required with the session cookie"
+// @Success		200
+// @Failure		400			{object}	handler.UserHandlerMessageDTO
+// @Failure		401			{object}	handler.UserHandlerMessageDTO
+// @Failure		403			{object}	handler.UserHandlerMessageDTO
+// @Failure		500			{object}	handler.UserHandlerMessageDTO
+// @Router		/logout		[post]
+// @Security	ApiKeyAuth
+func (h *SessionHandler) Logout(w http.ResponseWriter, r *http.Request) {
+	principal, ok := requestPrincipal(w, r)
+	if !ok {
+		return
+	}
+
+	// API keys carry no session, there is only the cookie to clear.
+	if principal.SessionID != uuid.Nil {
+		err := h.RevokeSessionUseCase.Execute(r.Context(), usecase.RevokeSessionUseCaseInputDTO{
+			UserID: principal.UserID.String(),
+			ID:     principal.SessionID.String(),
+		})
+		if err != nil && err != usecase.ErrRevokeSessionNotExists {
+			writeSessionError(w, err)
+			return
+		}
+	}
+
+	h.Cookies.clearSession(w)
+	w.WriteHeader(http.StatusOK)
+}
+
+func writeSessionError(w http.ResponseWriter, err error) {
+	switch err {
+	case usecase.ErrListSessionsInternalError,
+		usecase.ErrRevokeSessionInternalError:
+		writeMessage(w, http.StatusInternalServerError, err.Error())
+	case usecase.ErrRevokeSessionNotExists:
+		writeMessage(w, http.StatusNotFound, err.Error())
+	default:
+		writeMessage(w, http.StatusBadRequest, err.Error())
+	}
+}
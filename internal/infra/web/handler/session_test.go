@@ -0,0 +1,142 @@
+package handler
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/sesaquecruz/go-auth-api/internal/usecase"
+
+	"github.com/go-chi/chi/v5"
+	"github.com/go-chi/jwtauth"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func newSessionRouter(h *SessionHandler) http.Handler {
+	r := chi.NewRouter()
+	r.Get("/users/sessions", h.ListSessions)
+	r.Delete("/users/sessions/{id}", h.RevokeSession)
+	r.Post("/logout", h.Logout)
+	return r
+}
+
+func Test_SessionHandler_NewSessionHandler(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	listSessionsUseCase := usecase.NewMockListSessionsUseCaseInterface(ctrl)
+	revokeSessionUseCase := usecase.NewMockRevokeSessionUseCaseInterface(ctrl)
+
+	cookies := CookieOptions{Secure: true, SameSite: http.SameSiteStrictMode}
+	sessionHandler := NewSessionHandler(cookies, listSessionsUseCase, revokeSessionUseCase)
+	assert.NotNil(t, sessionHandler)
+	assert.Equal(t, cookies, sessionHandler.Cookies)
+	assert.Equal(t, listSessionsUseCase, sessionHandler.ListSessionsUseCase)
+	assert.Equal(t, revokeSessionUseCase, sessionHandler.RevokeSessionUseCase)
+}
+
+func Test_SessionHandler_ListSessions(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	sub := uuid.NewString()
+	sid := uuid.NewString()
+	output := &usecase.ListSessionsUseCaseOutputDTO{Sessions: []usecase.SessionOutputDTO{{ID: sid, Current: true}}}
+
+	listSessionsUseCase := usecase.NewMockListSessionsUseCaseInterface(ctrl)
+	listSessionsUseCase.EXPECT().
+		Execute(gomock.Any(), usecase.ListSessionsUseCaseInputDTO{UserID: sub, SessionID: sid}).
+		Return(output, nil).
+		Times(1)
+
+	token, _, err := jwtauth.New("HS256", []byte("secret"), nil).Encode(map[string]interface{}{"sub": sub, "sid": sid})
+	require.Nil(t, err)
+	req := httptest.NewRequest(http.MethodGet, "/users/sessions", nil)
+	req = req.WithContext(authenticated(t, jwtauth.NewContext(context.Background(), token, nil)))
+
+	rr := httptest.NewRecorder()
+	newSessionRouter(&SessionHandler{ListSessionsUseCase: listSessionsUseCase}).ServeHTTP(rr, req)
+	assert.Equal(t, http.StatusOK, rr.Code)
+
+	var body usecase.ListSessionsUseCaseOutputDTO
+	require.Nil(t, json.NewDecoder(rr.Body).Decode(&body))
+	assert.Equal(t, *output, body)
+}
+
+func Test_SessionHandler_RevokeSession(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	sub := uuid.NewString()
+	id := uuid.NewString()
+	input := usecase.RevokeSessionUseCaseInputDTO{UserID: sub, ID: id}
+
+	revokeSessionUseCase := usecase.NewMockRevokeSessionUseCaseInterface(ctrl)
+	revokeSessionUseCase.EXPECT().Execute(gomock.Any(), input).Return(nil).Times(1)
+	revokeSessionUseCase.EXPECT().Execute(gomock.Any(), input).Return(usecase.ErrRevokeSessionNotExists).Times(1)
+	revokeSessionUseCase.EXPECT().Execute(gomock.Any(), input).Return(usecase.ErrRevokeSessionInternalError).Times(1)
+
+	router := newSessionRouter(&SessionHandler{RevokeSessionUseCase: revokeSessionUseCase})
+
+	for _, expected := range []int{http.StatusOK, http.StatusNotFound, http.StatusInternalServerError} {
+		rr := httptest.NewRecorder()
+		router.ServeHTTP(rr, organizationRequest(t, http.MethodDelete, "/users/sessions/"+id, sub, nil))
+		assert.Equal(t, expected, rr.Code)
+	}
+
+	rr := httptest.NewRecorder()
+	router.ServeHTTP(rr, organizationRequest(t, http.MethodDelete, "/users/sessions/"+id, "", nil))
+	assert.Equal(t, http.StatusUnauthorized, rr.Code)
+}
+
+func Test_SessionHandler_Logout(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	sub := uuid.NewString()
+	sid := uuid.NewString()
+	input := usecase.RevokeSessionUseCaseInputDTO{UserID: sub, ID: sid}
+
+	revokeSessionUseCase := usecase.NewMockRevokeSessionUseCaseInterface(ctrl)
+	revokeSessionUseCase.EXPECT().Execute(gomock.Any(), input).Return(nil).Times(1)
+	revokeSessionUseCase.EXPECT().Execute(gomock.Any(), input).Return(usecase.ErrRevokeSessionNotExists).Times(1)
+	revokeSessionUseCase.EXPECT().Execute(gomock.Any(), input).Return(usecase.ErrRevokeSessionInternalError).Times(1)
+
+	jwtAuth := jwtauth.New("HS256", []byte("secret"), nil)
+	token, _, err := jwtAuth.Encode(map[string]interface{}{"sub": sub, "sid": sid})
+	require.Nil(t, err)
+
+	tests := []struct {
+		ctx  context.Context
+		code int
+	}{
+		{authenticated(t, jwtauth.NewContext(context.Background(), token, nil)), http.StatusOK},
+		{authenticated(t, jwtauth.NewContext(context.Background(), token, nil)), http.StatusOK},
+		{authenticated(t, jwtauth.NewContext(context.Background(), token, nil)), http.StatusInternalServerError},
+		{apiKeyContext(sub), http.StatusOK},
+	}
+
+	handler := newSessionRouter(&SessionHandler{RevokeSessionUseCase: revokeSessionUseCase})
+	for _, test := range tests {
+		req := httptest.NewRequest(http.MethodPost, "/logout", nil)
+		req = req.WithContext(test.ctx)
+
+		rr := httptest.NewRecorder()
+		handler.ServeHTTP(rr, req)
+		assert.Equal(t, test.code, rr.Code)
+
+		if test.code == http.StatusOK {
+			cookies := rr.Result().Cookies()
+			require.Len(t, cookies, 2)
+			for _, cookie := range cookies {
+				assert.Equal(t, -1, cookie.MaxAge)
+				assert.Empty(t, cookie.Value)
+			}
+		}
+	}
+}
@@ -2,9 +2,14 @@ package handler
 
 import (
 	"encoding/json"
+	"fmt"
+	"net"
 	"net/http"
+	"strconv"
+	"strings"
 	"time"
 
+	"github.com/sesaquecruz/go-auth-api/internal/infra/web/middleware"
 	"github.com/sesaquecruz/go-auth-api/internal/usecase"
 
 	"github.com/go-chi/jwtauth"
@@ -15,37 +20,62 @@ type UserHandlerInputDTO struct {
 	Password string `json:"password"`
 }
 
+type ReauthUserHandlerInputDTO struct {
+	Password string `json:"password"`
+}
+
+// amrPassword is the "amr" claim value of RFC 8176 for authentication by password.
+const amrPassword = "pwd"
+
 type UserHandlerMessageDTO struct {
 	Message string `json:"message"`
 }
 
 type UserHandler struct {
-	JWTAuth           *jwtauth.JWTAuth
-	JWTExpiration     time.Duration
-	CreateUserUseCase usecase.CreateUserUseCaseInterface
-	AuthUserUseCase   usecase.AuthUserUseCaseInterface
-	UpdateUserUseCase usecase.UpdateUserUseCaseInterface
-	DeleteUserUseCase usecase.DeleteUserUseCaseInterface
-	FindUserUseCase   usecase.FindUserUseCaseInterface
+	JWTAuth             *jwtauth.JWTAuth
+	JWTExpiration       time.Duration
+	JWTIssuer           string
+	JWTAudience         string
+	ConcealRegistration bool
+	Cookies             CookieOptions
+	CreateUserUseCase   usecase.CreateUserUseCaseInterface
+	AuthUserUseCase     usecase.AuthUserUseCaseInterface
+	ReauthUserUseCase   usecase.ReauthUserUseCaseInterface
+	UpdateUserUseCase   usecase.UpdateUserUseCaseInterface
+	DeleteUserUseCase   usecase.DeleteUserUseCaseInterface
+	FindUserUseCase     usecase.FindUserUseCaseInterface
+	RestoreUserUseCase  usecase.RestoreUserUseCaseInterface
 }
 
 func NewUserHandler(
 	jwtAuth *jwtauth.JWTAuth,
 	jwtExpiration time.Duration,
+	jwtIssuer string,
+	jwtAudience string,
+	concealRegistration bool,
+	cookies CookieOptions,
 	createUserUseCase usecase.CreateUserUseCaseInterface,
 	authUserUseCase usecase.AuthUserUseCaseInterface,
+	reauthUserUseCase usecase.ReauthUserUseCaseInterface,
 	updateUserUseCase usecase.UpdateUserUseCaseInterface,
 	deleteUserUseCase usecase.DeleteUserUseCaseInterface,
 	findUserUseCase usecase.FindUserUseCaseInterface,
+	restoreUserUseCase usecase.RestoreUserUseCaseInterface,
 ) *UserHandler {
 	return &UserHandler{
-		JWTAuth:           jwtAuth,
-		JWTExpiration:     jwtExpiration,
-		CreateUserUseCase: createUserUseCase,
-		AuthUserUseCase:   authUserUseCase,
-		UpdateUserUseCase: updateUserUseCase,
-		DeleteUserUseCase: deleteUserUseCase,
-		FindUserUseCase:   findUserUseCase,
+		JWTAuth:             jwtAuth,
+		JWTExpiration:       jwtExpiration,
+		JWTIssuer:           jwtIssuer,
+		JWTAudience:         jwtAudience,
+		ConcealRegistration: concealRegistration,
+		Cookies:             cookies,
+		CreateUserUseCase:   createUserUseCase,
+		AuthUserUseCase:     authUserUseCase,
+		ReauthUserUseCase:   reauthUserUseCase,
+		UpdateUserUseCase:   updateUserUseCase,
+		DeleteUserUseCase:   deleteUserUseCase,
+		FindUserUseCase:     findUserUseCase,
+		RestoreUserUseCase:  restoreUserUseCase,
 	}
 }
 
@@ -56,7 +86,9 @@ func NewUserHandler(
 // @Accept		json
 // @Produce		json
 // @Param		request		body		handler.UserHandlerInputDTO		true	"user request"
+// @Param		X-Tenant	header		string							false	"tenant slug, the request host or the default tenant when missing"
 // @Success		201
+// @Success		202
 // @Failure		400			{object}	handler.UserHandlerMessageDTO
 // @Failure		500			{object}	handler.UserHandlerMessageDTO
 // @Router		/users		[post]
@@ -68,7 +100,7 @@ func (h *UserHandler) CreateUser(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
-	err = h.CreateUserUseCase.Execute(r.Context(), usecase.CreateUserUseCaseInputDTO{
+	_, err = h.CreateUserUseCase.Execute(r.Context(), usecase.CreateUserUseCaseInputDTO{
 		Email:    data.Email,
 		Password: data.Password},
 	)
@@ -85,6 +117,11 @@ func (h *UserHandler) CreateUser(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
+	if h.ConcealRegistration {
+		w.WriteHeader(http.StatusAccepted)
+		return
+	}
+
 	w.WriteHeader(http.StatusCreated)
 }
 
@@ -95,9 +132,13 @@ func (h *UserHandler) CreateUser(w http.ResponseWriter, r *http.Request) {
 // @Accept		json
 // @Produce		json
 // @Param		request		body		handler.UserHandlerInputDTO		true	"user credentials"
+// @Param		X-Tenant	header		string							false	"tenant slug, the request host or the default tenant when missing"
+// @Param		mode		query		string							false	"browser to get the token in an HttpOnly cookie rather than the Authorization header"
+// @Param		scope		query		string							false	"space separated scopes of the token, every scope when missing"
 // @Success		200
 // @Failure		400			{object}	handler.UserHandlerMessageDTO
 // @Failure		401			{object}	handler.UserHandlerMessageDTO
+// @Failure		403			{object}	handler.UserHandlerMessageDTO
 // @Failure		500			{object}	handler.UserHandlerMessageDTO
 // @Router		/login		[post]
 func (h *UserHandler) AuthUser(w http.ResponseWriter, r *http.Request) {
@@ -109,8 +150,12 @@ func (h *UserHandler) AuthUser(w http.ResponseWriter, r *http.Request) {
 	}
 
 	output, err := h.AuthUserUseCase.Execute(r.Context(), usecase.AuthUserUseCaseInputDTO{
-		Email:    data.Email,
-		Password: data.Password,
+		Email:     data.Email,
+		Password:  data.Password,
+		Scope:     r.URL.Query().Get("scope"),
+		IP:        clientIP(r),
+		UserAgent: r.UserAgent(),
+		TTL:       h.JWTExpiration,
 	})
 	if err != nil {
 		w.Header().Set("Content-Type", "application/json")
@@ -119,6 +164,10 @@ func (h *UserHandler) AuthUser(w http.ResponseWriter, r *http.Request) {
 			w.WriteHeader(http.StatusInternalServerError)
 		} else if err == usecase.ErrAuthUserUseCaseInvalidCredentials {
 			w.WriteHeader(http.StatusUnauthorized)
+		} else if err == usecase.ErrAuthUserUseCaseAccountSuspended ||
+			err == usecase.ErrAuthUserUseCaseAccountLocked ||
+			err == usecase.ErrAuthUserUseCaseAccountPendingVerification {
+			w.WriteHeader(http.StatusForbidden)
 		} else {
 			w.WriteHeader(http.StatusBadRequest)
 		}
@@ -128,8 +177,21 @@ func (h *UserHandler) AuthUser(w http.ResponseWriter, r *http.Request) {
 	}
 
 	payload := map[string]interface{}{
-		"sub": output.ID,
-		"exp": jwtauth.ExpireIn(h.JWTExpiration),
+		"sub":         output.ID,
+		"tid":         output.TenantID,
+		"sid":         output.SessionID,
+		"exp":         output.ExpiresAt.Unix(),
+		"roles":       output.Roles,
+		"permissions": output.Permissions,
+		"scope":       strings.Join(output.Scopes, " "),
+		"auth_time":   output.AuthTime.Unix(),
+		"amr":         []string{amrPassword},
+	}
+	if h.JWTIssuer != "" {
+		payload["iss"] = h.JWTIssuer
+	}
+	if h.JWTAudience != "" {
+		payload["aud"] = h.JWTAudience
 	}
 
 	_, token, err := h.JWTAuth.Encode(payload)
@@ -139,7 +201,78 @@ func (h *UserHandler) AuthUser(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
-	w.Header().Set("Authorization", "Bearer "+token)
+	if r.URL.Query().Get("mode") == browserMode {
+		err = h.Cookies.setSession(w, token, output.ExpiresAt)
+		if err != nil {
+			w.WriteHeader(http.StatusInternalServerError)
+			json.NewEncoder(w).Encode(UserHandlerMessageDTO{Message: err.Error()})
+			return
+		}
+	} else {
+		w.Header().Set("Authorization", "Bearer "+token)
+	}
+	w.WriteHeader(http.StatusOK)
+}
+
+// Reauth user godoc
+// @Sumary		Reauth user
+// @Description	Check the password of the user again, re-issuing the token with a fresh auth_time for the operations requiring a recent authentication
+// @Tags		login
+// @Accept		json
+// @Produce		json
+// @Param		request		body		handler.ReauthUserHandlerInputDTO	true	"password of the user"
+// @Param		X-CSRF-Token	header	string								false	"value of the csrf_token cookie, required when authenticated by the session cookie"
+// @Success		200
+// @Failure		400			{object}	handler.UserHandlerMessageDTO
+// @Failure		401			{object}	handler.UserHandlerMessageDTO
+// @Failure		403			{object}	handler.UserHandlerMessageDTO
+// @Failure		500			{object}	handler.UserHandlerMessageDTO
+// @Router		/login/reauth	[post]
+// @Security	ApiKeyAuth
+func (h *UserHandler) ReauthUser(w http.ResponseWriter, r *http.Request) {
+	principal, ok := requestPrincipal(w, r)
+	if !ok {
+		return
+	}
+
+	// The token of an API key is never handed out.
+	if principal.AuthMethod == middleware.AuthMethodAPIKey {
+		writeMessage(w, http.StatusForbidden, "api keys cannot reauthenticate")
+		return
+	}
+
+	var data ReauthUserHandlerInputDTO
+	err := json.NewDecoder(r.Body).Decode(&data)
+	if err != nil {
+		w.WriteHeader(http.StatusBadRequest)
+		return
+	}
+
+	output, err := h.ReauthUserUseCase.Execute(r.Context(), usecase.ReauthUserUseCaseInputDTO{
+		ID:       principal.UserID.String(),
+		Password: data.Password,
+	})
+	switch err {
+	case nil:
+	case usecase.ErrReauthUserInternalError:
+		writeMessage(w, http.StatusInternalServerError, err.Error())
+		return
+	case usecase.ErrReauthUserInvalidCredentials:
+		writeMessage(w, http.StatusUnauthorized, err.Error())
+		return
+	default:
+		writeMessage(w, http.StatusBadRequest, err.Error())
+		return
+	}
+
+	err = h.Cookies.reissueToken(w, r, h.JWTAuth, map[string]interface{}{
+		"auth_time": output.AuthTime.Unix(),
+		"amr":       []string{amrPassword},
+	})
+	if err != nil {
+		writeMessage(w, http.StatusInternalServerError, err.Error())
+		return
+	}
 	w.WriteHeader(http.StatusOK)
 }
 
@@ -150,17 +283,27 @@ func (h *UserHandler) AuthUser(w http.ResponseWriter, r *http.Request) {
 // @Accept		json
 // @Produce		json
 // @Param		request		body		handler.UserHandlerInputDTO	true	"user request"
+// @Param		If-Match	header		string						true	"ETag of the user"
+// @Param		X-CSRF-Token	header	string						false	"value of the csrf_token cookie, required when authenticated by the session cookie"
 // @Success		200
+// @Header		200			{string}	ETag	"new ETag of the user"
 // @Failure		400			{object}	handler.UserHandlerMessageDTO
 // @Failure		401			{object}	handler.UserHandlerMessageDTO
+// @Failure		403			{object}	handler.UserHandlerMessageDTO
+// @Failure		412			{object}	handler.UserHandlerMessageDTO
+// @Failure		428			{object}	handler.UserHandlerMessageDTO
 // @Failure		500			{object}	handler.UserHandlerMessageDTO
 // @Router		/users 		[put]
 // @Security	ApiKeyAuth
 func (h *UserHandler) UpdateUser(w http.ResponseWriter, r *http.Request) {
-	_, claims, _ := jwtauth.FromContext(r.Context())
-	sub, ok := claims["sub"].(string)
+	sub, ok := subject(w, r)
 	if !ok {
-		w.WriteHeader(http.StatusUnauthorized)
+		return
+	}
+
+	version, ok := ifMatchVersion(r)
+	if !ok {
+		writePreconditionRequired(w)
 		return
 	}
 
@@ -171,16 +314,19 @@ func (h *UserHandler) UpdateUser(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
-	_, err = h.UpdateUserUseCase.Execute(r.Context(), usecase.UpdateUserUseCaseInputDTO{
+	output, err := h.UpdateUserUseCase.Execute(r.Context(), usecase.UpdateUserUseCaseInputDTO{
 		ID:       sub,
 		Email:    data.Email,
 		Password: data.Password,
+		Version:  version,
 	})
 	if err != nil {
 		w.Header().Set("Content-Type", "application/json")
 
 		if err == usecase.ErrUpdateUserInternalError {
 			w.WriteHeader(http.StatusInternalServerError)
+		} else if err == usecase.ErrUpdateUserVersionConflict {
+			w.WriteHeader(http.StatusPreconditionFailed)
 		} else {
 			w.WriteHeader(http.StatusBadRequest)
 		}
@@ -189,8 +335,15 @@ func (h *UserHandler) UpdateUser(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
-	token := r.Header.Get("Authorization")
-	w.Header().Set("Authorization", token)
+	if output.PasswordChanged {
+		// The session of the request was revoked with the others, so its token is of no use anymore.
+		if middleware.AuthenticatedByCookie(r.Context()) {
+			h.Cookies.clearSession(w)
+		}
+	} else if token := r.Header.Get("Authorization"); token != "" {
+		w.Header().Set("Authorization", token)
+	}
+	w.Header().Set("ETag", etag(output.Version))
 	w.WriteHeader(http.StatusOK)
 }
 
@@ -200,28 +353,40 @@ func (h *UserHandler) UpdateUser(w http.ResponseWriter, r *http.Request) {
 // @Tags		users
 // @Accept		*/*
 // @Produce		json
+// @Param		If-Match	header		string						true	"ETag of the user"
+// @Param		X-CSRF-Token	header	string						false	"value of the csrf_token cookie, required when authenticated by the session cookie"
 // @Success		200
 // @Failure		400			{object}	handler.UserHandlerMessageDTO
 // @Failure		401			{object}	handler.UserHandlerMessageDTO
+// @Failure		403			{object}	handler.UserHandlerMessageDTO
+// @Failure		412			{object}	handler.UserHandlerMessageDTO
+// @Failure		428			{object}	handler.UserHandlerMessageDTO
 // @Failure		500			{object}	handler.UserHandlerMessageDTO
 // @Router		/users 		[delete]
 // @Security	ApiKeyAuth
 func (h *UserHandler) DeleteUser(w http.ResponseWriter, r *http.Request) {
-	_, claims, _ := jwtauth.FromContext(r.Context())
-	sub, ok := claims["sub"].(string)
+	sub, ok := subject(w, r)
+	if !ok {
+		return
+	}
+
+	version, ok := ifMatchVersion(r)
 	if !ok {
-		w.WriteHeader(http.StatusUnauthorized)
+		writePreconditionRequired(w)
 		return
 	}
 
 	err := h.DeleteUserUseCase.Execute(r.Context(), usecase.DeleteUserUseCaseInputDTO{
-		ID: sub,
+		ID:      sub,
+		Version: version,
 	})
 	if err != nil {
 		w.Header().Set("Content-Type", "application/json")
 
-		if err == usecase.ErrUpdateUserInternalError {
+		if err == usecase.ErrDeleteUserInternalError {
 			w.WriteHeader(http.StatusInternalServerError)
+		} else if err == usecase.ErrDeleteUserVersionConflict {
+			w.WriteHeader(http.StatusPreconditionFailed)
 		} else {
 			w.WriteHeader(http.StatusBadRequest)
 		}
@@ -240,16 +405,16 @@ func (h *UserHandler) DeleteUser(w http.ResponseWriter, r *http.Request) {
 // @Accept		*/*
 // @Produce		json
 // @Success		200			{object}	usecase.FindUserUseCaseOutputDTO
+// @Header		200			{string}	ETag	"ETag of the user"
 // @Failure		400			{object}	handler.UserHandlerMessageDTO
 // @Failure		401			{object}	handler.UserHandlerMessageDTO
+// @Failure		403			{object}	handler.UserHandlerMessageDTO
 // @Failure		500			{object}	handler.UserHandlerMessageDTO
 // @Router		/users 		[get]
 // @Security	ApiKeyAuth
 func (h *UserHandler) FindUser(w http.ResponseWriter, r *http.Request) {
-	_, claims, _ := jwtauth.FromContext(r.Context())
-	sub, ok := claims["sub"].(string)
+	sub, ok := subject(w, r)
 	if !ok {
-		w.WriteHeader(http.StatusUnauthorized)
 		return
 	}
 
@@ -269,9 +434,95 @@ func (h *UserHandler) FindUser(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
-	token := r.Header.Get("Authorization")
-	w.Header().Set("Authorization", token)
+	if token := r.Header.Get("Authorization"); token != "" {
+		w.Header().Set("Authorization", token)
+	}
 	w.Header().Set("Content-Type", "application/json")
+	w.Header().Set("ETag", etag(output.Version))
 	w.WriteHeader(http.StatusOK)
 	json.NewEncoder(w).Encode(output)
 }
+
+// Restore user godoc
+// @Sumary		Restore user
+// @Description	Restore a deleted user within the grace period
+// @Tags		users
+// @Accept		json
+// @Produce		json
+// @Param		request		body		handler.UserHandlerInputDTO		true	"user credentials"
+// @Param		X-Tenant	header		string							false	"tenant slug, the request host or the default tenant when missing"
+// @Success		200
+// @Failure		400			{object}	handler.UserHandlerMessageDTO
+// @Failure		401			{object}	handler.UserHandlerMessageDTO
+// @Failure		410			{object}	handler.UserHandlerMessageDTO
+// @Failure		500			{object}	handler.UserHandlerMessageDTO
+// @Router		/users/restore	[post]
+func (h *UserHandler) RestoreUser(w http.ResponseWriter, r *http.Request) {
+	var data UserHandlerInputDTO
+	err := json.NewDecoder(r.Body).Decode(&data)
+	if err != nil {
+		w.WriteHeader(http.StatusBadRequest)
+		return
+	}
+
+	_, err = h.RestoreUserUseCase.Execute(r.Context(), usecase.RestoreUserUseCaseInputDTO{
+		Email:    data.Email,
+		Password: data.Password,
+	})
+	if err != nil {
+		w.Header().Set("Content-Type", "application/json")
+
+		if err == usecase.ErrRestoreUserInternalError {
+			w.WriteHeader(http.StatusInternalServerError)
+		} else if err == usecase.ErrRestoreUserInvalidCredentials {
+			w.WriteHeader(http.StatusUnauthorized)
+		} else if err == usecase.ErrRestoreUserGracePeriodExpired {
+			w.WriteHeader(http.StatusGone)
+		} else {
+			w.WriteHeader(http.StatusBadRequest)
+		}
+
+		json.NewEncoder(w).Encode(UserHandlerMessageDTO{Message: err.Error()})
+		return
+	}
+
+	w.WriteHeader(http.StatusOK)
+}
+
+func etag(version int64) string {
+	return fmt.Sprintf(`"%d"`, version)
+}
+
+// ifMatchVersion returns the user version required by the If-Match header, and false when it is missing.
+// The version is zero for "*" and negative, so it never matches, when the value is not one of our ETags.
+func ifMatchVersion(r *http.Request) (int64, bool) {
+	value := strings.TrimSpace(r.Header.Get("If-Match"))
+	if value == "" {
+		return 0, false
+	}
+	if value == "*" {
+		return 0, true
+	}
+
+	unquoted := strings.TrimSuffix(strings.TrimPrefix(value, `"`), `"`)
+	version, err := strconv.ParseInt(unquoted, 10, 64)
+	if err != nil || version < 1 || len(unquoted) != len(value)-2 {
+		return -1, true
+	}
+
+	return version, true
+}
+
+func writePreconditionRequired(w http.ResponseWriter) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(http.StatusPreconditionRequired)
+	json.NewEncoder(w).Encode(UserHandlerMessageDTO{Message: "if-match header required"})
+}
+
+func clientIP(r *http.Request) string {
+	host, _, err := net.SplitHostPort(r.RemoteAddr)
+	if err != nil {
+		return r.RemoteAddr
+	}
+	return host
+}
@@ -6,9 +6,11 @@ import (
 	"encoding/json"
 	"net/http"
 	"net/http/httptest"
+	"strings"
 	"testing"
 	"time"
 
+	"github.com/sesaquecruz/go-auth-api/internal/infra/web/middleware"
 	"github.com/sesaquecruz/go-auth-api/internal/usecase"
 
 	"github.com/go-chi/jwtauth"
@@ -24,9 +26,11 @@ func Test_UserHandler_NewUserHandler(t *testing.T) {
 
 	createUserUseCase := usecase.NewMockCreateUserUseCaseInterface(ctrl)
 	authUserUseCase := usecase.NewMockAuthUserUseCaseInterface(ctrl)
+	reauthUserUseCase := usecase.NewMockReauthUserUseCaseInterface(ctrl)
 	updateUserUsecase := usecase.NewMockUpdateUserUseCaseInterface(ctrl)
 	deleteUserUseCase := usecase.NewMockDeleteUserUseCaseInterface(ctrl)
 	findUserUseCase := usecase.NewMockFindUserUseCaseInterface(ctrl)
+	restoreUserUseCase := usecase.NewMockRestoreUserUseCaseInterface(ctrl)
 
 	jwtAuth := jwtauth.New("HS256", []byte("secret"), nil)
 	jwtxpiration := time.Duration(300) * time.Second
@@ -34,17 +38,29 @@ func Test_UserHandler_NewUserHandler(t *testing.T) {
 	userHander := NewUserHandler(
 		jwtAuth,
 		jwtxpiration,
+		"https://auth.example.com",
+		"api",
+		true,
+		CookieOptions{Secure: true, SameSite: http.SameSiteStrictMode},
 		createUserUseCase,
 		authUserUseCase,
+		reauthUserUseCase,
 		updateUserUsecase,
 		deleteUserUseCase,
 		findUserUseCase,
+		restoreUserUseCase,
 	)
 	assert.NotNil(t, userHander)
 	assert.Equal(t, jwtAuth, userHander.JWTAuth)
 	assert.Equal(t, jwtxpiration, userHander.JWTExpiration)
+	assert.Equal(t, "https://auth.example.com", userHander.JWTIssuer)
+	assert.Equal(t, "api", userHander.JWTAudience)
+	assert.True(t, userHander.ConcealRegistration)
+	assert.Equal(t, CookieOptions{Secure: true, SameSite: http.SameSiteStrictMode}, userHander.Cookies)
 	assert.Equal(t, createUserUseCase, userHander.CreateUserUseCase)
 	assert.Equal(t, authUserUseCase, userHander.AuthUserUseCase)
+	assert.Equal(t, reauthUserUseCase, userHander.ReauthUserUseCase)
+	assert.Equal(t, restoreUserUseCase, userHander.RestoreUserUseCase)
 }
 
 func Test_UserHandler_CreateUser(t *testing.T) {
@@ -57,7 +73,7 @@ func Test_UserHandler_CreateUser(t *testing.T) {
 		CreateUserUseCase: createUserUseCase,
 	}
 
-	createUserUseCase.EXPECT().Execute(gomock.Any(), gomock.Any()).Return(nil).Times(1)
+	createUserUseCase.EXPECT().Execute(gomock.Any(), gomock.Any()).Return(&usecase.CreateUserUseCaseOutputDTO{}, nil).Times(1)
 
 	ts := httptest.NewServer(http.HandlerFunc(userHander.CreateUser))
 	defer ts.Close()
@@ -78,6 +94,32 @@ func Test_UserHandler_CreateUser(t *testing.T) {
 	assert.Equal(t, http.StatusCreated, response.StatusCode)
 }
 
+func Test_UserHandler_CreateUser_WhenRegistrationIsConcealed(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	createUserUseCase := usecase.NewMockCreateUserUseCaseInterface(ctrl)
+
+	userHander := UserHandler{
+		ConcealRegistration: true,
+		CreateUserUseCase:   createUserUseCase,
+	}
+
+	createUserUseCase.EXPECT().Execute(gomock.Any(), gomock.Any()).Return(&usecase.CreateUserUseCaseOutputDTO{}, nil).Times(1)
+
+	ts := httptest.NewServer(http.HandlerFunc(userHander.CreateUser))
+	defer ts.Close()
+
+	body, err := json.Marshal(UserHandlerInputDTO{Email: "user@mail.com", Password: "12345"})
+	require.Nil(t, err)
+
+	response, err := http.Post(ts.URL, "application/json", bytes.NewReader(body))
+	assert.Nil(t, err)
+	defer response.Body.Close()
+
+	assert.Equal(t, http.StatusAccepted, response.StatusCode)
+}
+
 func Test_UserHandler_AuthUser(t *testing.T) {
 	ctrl := gomock.NewController(t)
 	defer ctrl.Finish()
@@ -90,8 +132,24 @@ func Test_UserHandler_AuthUser(t *testing.T) {
 		AuthUserUseCase: authUserUseCase,
 	}
 
-	output := &usecase.AuthUserUseCaseOutputDTO{ID: uuid.NewString()}
-	authUserUseCase.EXPECT().Execute(gomock.Any(), gomock.Any()).Return(output, nil).Times(1)
+	output := &usecase.AuthUserUseCaseOutputDTO{
+		ID:          uuid.NewString(),
+		TenantID:    uuid.NewString(),
+		Roles:       []string{"admin"},
+		Permissions: []string{"users:admin"},
+		Scopes:      []string{"profile:read"},
+		SessionID:   uuid.NewString(),
+		AuthTime:    time.Now().Truncate(time.Second),
+		ExpiresAt:   time.Now().Add(time.Minute).Truncate(time.Second),
+	}
+	authUserUseCase.EXPECT().Execute(gomock.Any(), gomock.Any()).
+		DoAndReturn(func(_ context.Context, input usecase.AuthUserUseCaseInputDTO) (*usecase.AuthUserUseCaseOutputDTO, error) {
+			assert.Equal(t, "profile:read", input.Scope)
+			assert.Equal(t, "127.0.0.1", input.IP)
+			assert.Equal(t, "Go-http-client/1.1", input.UserAgent)
+			assert.Equal(t, userHander.JWTExpiration, input.TTL)
+			return output, nil
+		}).Times(1)
 
 	ts := httptest.NewServer(http.HandlerFunc(userHander.AuthUser))
 	defer ts.Close()
@@ -105,12 +163,197 @@ func Test_UserHandler_AuthUser(t *testing.T) {
 	body, err := json.Marshal(data)
 	require.Nil(t, err)
 
-	response, err := http.Post(ts.URL, contentType, bytes.NewReader(body))
+	response, err := http.Post(ts.URL+"?scope=profile:read", contentType, bytes.NewReader(body))
 	assert.Nil(t, err)
 	defer response.Body.Close()
 
 	assert.Equal(t, http.StatusOK, response.StatusCode)
 	assert.NotEmpty(t, response.Header.Get("Authorization"))
+
+	token, err := jwtauth.VerifyToken(userHander.JWTAuth, strings.TrimPrefix(response.Header.Get("Authorization"), "Bearer "))
+	require.Nil(t, err)
+
+	claims, err := token.AsMap(context.Background())
+	require.Nil(t, err)
+	assert.Equal(t, output.ID, claims["sub"])
+	assert.Equal(t, output.TenantID, claims["tid"])
+	assert.Equal(t, []interface{}{"admin"}, claims["roles"])
+	assert.Equal(t, []interface{}{"users:admin"}, claims["permissions"])
+	assert.Equal(t, "profile:read", claims["scope"])
+	assert.Equal(t, float64(output.AuthTime.Unix()), claims["auth_time"])
+	assert.Equal(t, []interface{}{"pwd"}, claims["amr"])
+	assert.Equal(t, output.SessionID, claims["sid"])
+	assert.Equal(t, output.ExpiresAt.Unix(), token.Expiration().Unix())
+	assert.Empty(t, token.Issuer())
+	assert.Empty(t, token.Audience())
+}
+
+func Test_UserHandler_ReauthUser(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	sub := uuid.NewString()
+	authTime := time.Now().Truncate(time.Second)
+
+	reauthUserUseCase := usecase.NewMockReauthUserUseCaseInterface(ctrl)
+	reauthUserUseCase.EXPECT().
+		Execute(gomock.Any(), usecase.ReauthUserUseCaseInputDTO{ID: sub, Password: "12345"}).
+		Return(&usecase.ReauthUserUseCaseOutputDTO{AuthTime: authTime}, nil).
+		Times(1)
+	reauthUserUseCase.EXPECT().
+		Execute(gomock.Any(), usecase.ReauthUserUseCaseInputDTO{ID: sub, Password: "54321"}).
+		Return(nil, usecase.ErrReauthUserInvalidCredentials).
+		Times(1)
+
+	userHandler := UserHandler{JWTAuth: jwtauth.New("HS256", []byte("secret"), nil), ReauthUserUseCase: reauthUserUseCase}
+
+	req := organizationRequest(t, http.MethodPost, "/login/reauth", sub, ReauthUserHandlerInputDTO{Password: "54321"})
+	rr := httptest.NewRecorder()
+	userHandler.ReauthUser(rr, req)
+	assert.Equal(t, http.StatusUnauthorized, rr.Code)
+	assert.Empty(t, rr.Header().Get("Authorization"))
+
+	req = organizationRequest(t, http.MethodPost, "/login/reauth", sub, ReauthUserHandlerInputDTO{Password: "12345"})
+	_, current, _ := jwtauth.FromContext(req.Context())
+	rr = httptest.NewRecorder()
+	userHandler.ReauthUser(rr, req)
+	require.Equal(t, http.StatusOK, rr.Code)
+
+	token, err := userHandler.JWTAuth.Decode(strings.TrimPrefix(rr.Header().Get("Authorization"), "Bearer "))
+	require.Nil(t, err)
+
+	claims, err := token.AsMap(context.Background())
+	require.Nil(t, err)
+	assert.Equal(t, float64(authTime.Unix()), claims["auth_time"])
+	assert.Equal(t, []interface{}{"pwd"}, claims["amr"])
+	assert.Equal(t, sub, claims["sub"])
+	assert.Equal(t, current["tid"], claims["tid"])
+	assert.Equal(t, current["exp"], claims["exp"])
+}
+
+func Test_UserHandler_ReauthUser_WhenMadeWithAPIKey(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	reauthUserUseCase := usecase.NewMockReauthUserUseCaseInterface(ctrl)
+	reauthUserUseCase.EXPECT().Execute(gomock.Any(), gomock.Any()).Times(0)
+
+	req := organizationRequest(t, http.MethodPost, "/login/reauth", "", ReauthUserHandlerInputDTO{Password: "12345"})
+	req = req.WithContext(apiKeyContext(uuid.NewString()))
+
+	rr := httptest.NewRecorder()
+	(&UserHandler{ReauthUserUseCase: reauthUserUseCase}).ReauthUser(rr, req)
+	assert.Equal(t, http.StatusForbidden, rr.Code)
+	assert.Empty(t, rr.Header().Get("Authorization"))
+}
+
+func Test_UserHandler_AuthUser_WithIssuerAndAudience(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	authUserUseCase := usecase.NewMockAuthUserUseCaseInterface(ctrl)
+
+	userHander := UserHandler{
+		JWTAuth:         jwtauth.New("HS256", []byte("secret"), nil),
+		JWTExpiration:   time.Duration(300) * time.Second,
+		JWTIssuer:       "https://auth.example.com",
+		JWTAudience:     "api",
+		AuthUserUseCase: authUserUseCase,
+	}
+
+	output := &usecase.AuthUserUseCaseOutputDTO{ID: uuid.NewString(), ExpiresAt: time.Now().Add(time.Minute)}
+	authUserUseCase.EXPECT().Execute(gomock.Any(), gomock.Any()).Return(output, nil).Times(1)
+
+	body, err := json.Marshal(UserHandlerInputDTO{Email: "user@mail.com", Password: "12345"})
+	require.Nil(t, err)
+
+	rr := httptest.NewRecorder()
+	userHander.AuthUser(rr, httptest.NewRequest(http.MethodPost, "/login", bytes.NewReader(body)))
+	require.Equal(t, http.StatusOK, rr.Code)
+
+	token, err := jwtauth.VerifyToken(userHander.JWTAuth, strings.TrimPrefix(rr.Header().Get("Authorization"), "Bearer "))
+	require.Nil(t, err)
+	assert.Equal(t, "https://auth.example.com", token.Issuer())
+	assert.Equal(t, []string{"api"}, token.Audience())
+}
+
+func Test_UserHandler_AuthUser_InBrowserMode(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	authUserUseCase := usecase.NewMockAuthUserUseCaseInterface(ctrl)
+
+	userHander := UserHandler{
+		JWTAuth:         jwtauth.New("HS256", []byte("secret"), nil),
+		JWTExpiration:   time.Duration(300) * time.Second,
+		Cookies:         CookieOptions{Secure: true, SameSite: http.SameSiteStrictMode},
+		AuthUserUseCase: authUserUseCase,
+	}
+
+	output := &usecase.AuthUserUseCaseOutputDTO{
+		ID:        uuid.NewString(),
+		TenantID:  uuid.NewString(),
+		SessionID: uuid.NewString(),
+		ExpiresAt: time.Now().Add(time.Minute).Truncate(time.Second),
+	}
+	authUserUseCase.EXPECT().Execute(gomock.Any(), gomock.Any()).Return(output, nil).Times(1)
+
+	body, err := json.Marshal(UserHandlerInputDTO{Email: "user@mail.com", Password: "12345"})
+	require.Nil(t, err)
+
+	rr := httptest.NewRecorder()
+	userHander.AuthUser(rr, httptest.NewRequest(http.MethodPost, "/login?mode=browser", bytes.NewReader(body)))
+	assert.Equal(t, http.StatusOK, rr.Code)
+	assert.Empty(t, rr.Header().Get("Authorization"))
+
+	cookies := make(map[string]*http.Cookie)
+	for _, cookie := range rr.Result().Cookies() {
+		cookies[cookie.Name] = cookie
+	}
+
+	session := cookies["session"]
+	require.NotNil(t, session)
+	assert.True(t, session.HttpOnly)
+	assert.True(t, session.Secure)
+	assert.Equal(t, http.SameSiteStrictMode, session.SameSite)
+	assert.Equal(t, "/", session.Path)
+	assert.Equal(t, output.ExpiresAt.Unix(), session.Expires.Unix())
+
+	token, err := jwtauth.VerifyToken(userHander.JWTAuth, session.Value)
+	require.Nil(t, err)
+	assert.Equal(t, output.ID, token.Subject())
+
+	csrf := cookies["csrf_token"]
+	require.NotNil(t, csrf)
+	assert.False(t, csrf.HttpOnly)
+	assert.True(t, csrf.Secure)
+	assert.NotEmpty(t, csrf.Value)
+}
+
+func Test_UserHandler_AuthUser_WhenAccountIsSuspended(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	authUserUseCase := usecase.NewMockAuthUserUseCaseInterface(ctrl)
+	authUserUseCase.EXPECT().Execute(gomock.Any(), gomock.Any()).
+		Return(nil, usecase.ErrAuthUserUseCaseAccountSuspended).
+		Times(1)
+
+	userHander := UserHandler{
+		JWTAuth:         jwtauth.New("HS256", []byte("secret"), nil),
+		JWTExpiration:   time.Duration(300) * time.Second,
+		AuthUserUseCase: authUserUseCase,
+	}
+
+	body, err := json.Marshal(UserHandlerInputDTO{Email: "user@mail.com", Password: "12345"})
+	require.Nil(t, err)
+
+	req := httptest.NewRequest(http.MethodPost, "/", bytes.NewReader(body))
+	rr := httptest.NewRecorder()
+	userHander.AuthUser(rr, req)
+
+	assert.Equal(t, http.StatusForbidden, rr.Code)
+	assert.Empty(t, rr.Header().Get("Authorization"))
 }
 
 func Test_UserHandler_UpdateUser(t *testing.T) {
@@ -126,16 +369,23 @@ func Test_UserHandler_UpdateUser(t *testing.T) {
 	defer ctrl.Finish()
 
 	updateUserUseCase := usecase.NewMockUpdateUserUseCaseInterface(ctrl)
-	updateUserUseCase.EXPECT().Execute(gomock.Any(), gomock.Any()).Return(nil, nil).Times(1)
+	updateUserUseCase.EXPECT().
+		Execute(gomock.Any(), gomock.Any()).
+		DoAndReturn(func(ctx context.Context, input usecase.UpdateUserUseCaseInputDTO) (*usecase.UpdateUserUseCaseOutputDTO, error) {
+			assert.Equal(t, int64(3), input.Version)
+			return &usecase.UpdateUserUseCaseOutputDTO{Version: 4}, nil
+		}).
+		Times(1)
 
 	userHandler := UserHandler{UpdateUserUseCase: updateUserUseCase}
 
 	body, err := json.Marshal(UserHandlerInputDTO{Email: "user@mail.com", Password: "12345"})
 	require.Nil(t, err)
 
-	ctx := jwtauth.NewContext(context.Background(), token, nil)
+	ctx := authenticated(t, jwtauth.NewContext(context.Background(), token, nil))
 	req, err := http.NewRequestWithContext(ctx, http.MethodPut, "/", bytes.NewReader(body))
 	require.Nil(t, err)
+	req.Header.Set("If-Match", `"3"`)
 
 	rr := httptest.NewRecorder()
 	userHandler.UpdateUser(rr, req)
@@ -144,6 +394,147 @@ func Test_UserHandler_UpdateUser(t *testing.T) {
 	defer res.Body.Close()
 
 	assert.Equal(t, http.StatusOK, res.StatusCode)
+	assert.Equal(t, `"4"`, res.Header.Get("ETag"))
+}
+
+func Test_UserHandler_UpdateUser_WhenPasswordChanges(t *testing.T) {
+	jwtAuth := jwtauth.New("HS256", []byte("secret"), nil)
+	_, token, err := jwtAuth.Encode(map[string]interface{}{
+		"sub": uuid.NewString(),
+		"exp": jwtauth.ExpireIn(time.Duration(300) * time.Second),
+	})
+	require.Nil(t, err)
+
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	updateUserUseCase := usecase.NewMockUpdateUserUseCaseInterface(ctrl)
+	updateUserUseCase.EXPECT().
+		Execute(gomock.Any(), gomock.Any()).
+		Return(&usecase.UpdateUserUseCaseOutputDTO{Version: 4, PasswordChanged: false}, nil).
+		Times(1)
+	updateUserUseCase.EXPECT().
+		Execute(gomock.Any(), gomock.Any()).
+		Return(&usecase.UpdateUserUseCaseOutputDTO{Version: 4, PasswordChanged: true}, nil).
+		Times(2)
+
+	body, err := json.Marshal(UserHandlerInputDTO{Email: "user@mail.com", Password: "12345"})
+	require.Nil(t, err)
+
+	handler := middleware.Verifier(jwtAuth)(middleware.Authenticator(http.HandlerFunc((&UserHandler{UpdateUserUseCase: updateUserUseCase}).UpdateUser)))
+	tests := []struct {
+		name    string
+		cookie  bool
+		echoed  bool
+		cleared bool
+	}{
+		{"unchanged", false, true, false},
+		{"changed", false, false, false},
+		{"changed in browser mode", true, false, true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			req := httptest.NewRequest(http.MethodPut, "/", bytes.NewReader(body))
+			req.Header.Set("If-Match", `"3"`)
+			if tt.cookie {
+				req.AddCookie(&http.Cookie{Name: middleware.SessionCookie, Value: token})
+			} else {
+				req.Header.Set("Authorization", "Bearer "+token)
+			}
+
+			rr := httptest.NewRecorder()
+			handler.ServeHTTP(rr, req)
+			require.Equal(t, http.StatusOK, rr.Code)
+
+			if tt.echoed {
+				assert.Equal(t, "Bearer "+token, rr.Header().Get("Authorization"))
+			} else {
+				assert.Empty(t, rr.Header().Get("Authorization"))
+			}
+
+			cookies := rr.Result().Cookies()
+			if tt.cleared {
+				require.Len(t, cookies, 2)
+				for _, cookie := range cookies {
+					assert.Equal(t, -1, cookie.MaxAge)
+					assert.Empty(t, cookie.Value)
+				}
+			} else {
+				assert.Empty(t, cookies)
+			}
+		})
+	}
+}
+
+func Test_UserHandler_UpdateUser_WhenIfMatchIsMissing(t *testing.T) {
+	jwtAuth := jwtauth.New("HS256", []byte("secret"), nil)
+	payload := map[string]interface{}{
+		"sub": uuid.NewString(),
+		"exp": jwtauth.ExpireIn(time.Duration(300) * time.Second),
+	}
+	token, _, err := jwtAuth.Encode(payload)
+	require.Nil(t, err)
+
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	updateUserUseCase := usecase.NewMockUpdateUserUseCaseInterface(ctrl)
+	updateUserUseCase.EXPECT().Execute(gomock.Any(), gomock.Any()).Times(0)
+
+	userHandler := UserHandler{UpdateUserUseCase: updateUserUseCase}
+
+	body, err := json.Marshal(UserHandlerInputDTO{Email: "user@mail.com", Password: "12345"})
+	require.Nil(t, err)
+
+	ctx := authenticated(t, jwtauth.NewContext(context.Background(), token, nil))
+	req, err := http.NewRequestWithContext(ctx, http.MethodPut, "/", bytes.NewReader(body))
+	require.Nil(t, err)
+
+	rr := httptest.NewRecorder()
+	userHandler.UpdateUser(rr, req)
+
+	res := rr.Result()
+	defer res.Body.Close()
+
+	assert.Equal(t, http.StatusPreconditionRequired, res.StatusCode)
+}
+
+func Test_UserHandler_UpdateUser_WhenVersionConflicts(t *testing.T) {
+	jwtAuth := jwtauth.New("HS256", []byte("secret"), nil)
+	payload := map[string]interface{}{
+		"sub": uuid.NewString(),
+		"exp": jwtauth.ExpireIn(time.Duration(300) * time.Second),
+	}
+	token, _, err := jwtAuth.Encode(payload)
+	require.Nil(t, err)
+
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	updateUserUseCase := usecase.NewMockUpdateUserUseCaseInterface(ctrl)
+	updateUserUseCase.EXPECT().
+		Execute(gomock.Any(), gomock.Any()).
+		Return(nil, usecase.ErrUpdateUserVersionConflict).
+		Times(1)
+
+	userHandler := UserHandler{UpdateUserUseCase: updateUserUseCase}
+
+	body, err := json.Marshal(UserHandlerInputDTO{Email: "user@mail.com", Password: "12345"})
+	require.Nil(t, err)
+
+	ctx := authenticated(t, jwtauth.NewContext(context.Background(), token, nil))
+	req, err := http.NewRequestWithContext(ctx, http.MethodPut, "/", bytes.NewReader(body))
+	require.Nil(t, err)
+	req.Header.Set("If-Match", `"1"`)
+
+	rr := httptest.NewRecorder()
+	userHandler.UpdateUser(rr, req)
+
+	res := rr.Result()
+	defer res.Body.Close()
+
+	assert.Equal(t, http.StatusPreconditionFailed, res.StatusCode)
 }
 
 func Test_UserHandler_DeleteUser(t *testing.T) {
@@ -159,13 +550,20 @@ func Test_UserHandler_DeleteUser(t *testing.T) {
 	defer ctrl.Finish()
 
 	deleteUserUseCase := usecase.NewMockDeleteUserUseCaseInterface(ctrl)
-	deleteUserUseCase.EXPECT().Execute(gomock.Any(), gomock.Any()).Return(nil).Times(1)
+	deleteUserUseCase.EXPECT().
+		Execute(gomock.Any(), gomock.Any()).
+		DoAndReturn(func(ctx context.Context, input usecase.DeleteUserUseCaseInputDTO) error {
+			assert.Equal(t, int64(0), input.Version)
+			return nil
+		}).
+		Times(1)
 
 	userHandler := UserHandler{DeleteUserUseCase: deleteUserUseCase}
 
-	ctx := jwtauth.NewContext(context.Background(), token, nil)
+	ctx := authenticated(t, jwtauth.NewContext(context.Background(), token, nil))
 	req, err := http.NewRequestWithContext(ctx, http.MethodDelete, "/", nil)
 	require.Nil(t, err)
+	req.Header.Set("If-Match", "*")
 
 	rr := httptest.NewRecorder()
 	userHandler.DeleteUser(rr, req)
@@ -176,6 +574,40 @@ func Test_UserHandler_DeleteUser(t *testing.T) {
 	assert.Equal(t, http.StatusOK, res.StatusCode)
 }
 
+func Test_UserHandler_DeleteUser_WhenVersionConflicts(t *testing.T) {
+	jwtAuth := jwtauth.New("HS256", []byte("secret"), nil)
+	payload := map[string]interface{}{
+		"sub": uuid.NewString(),
+		"exp": jwtauth.ExpireIn(time.Duration(300) * time.Second),
+	}
+	token, _, err := jwtAuth.Encode(payload)
+	require.Nil(t, err)
+
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	deleteUserUseCase := usecase.NewMockDeleteUserUseCaseInterface(ctrl)
+	deleteUserUseCase.EXPECT().
+		Execute(gomock.Any(), gomock.Any()).
+		Return(usecase.ErrDeleteUserVersionConflict).
+		Times(1)
+
+	userHandler := UserHandler{DeleteUserUseCase: deleteUserUseCase}
+
+	ctx := authenticated(t, jwtauth.NewContext(context.Background(), token, nil))
+	req, err := http.NewRequestWithContext(ctx, http.MethodDelete, "/", nil)
+	require.Nil(t, err)
+	req.Header.Set("If-Match", "W/\"1\"")
+
+	rr := httptest.NewRecorder()
+	userHandler.DeleteUser(rr, req)
+
+	res := rr.Result()
+	defer res.Body.Close()
+
+	assert.Equal(t, http.StatusPreconditionFailed, res.StatusCode)
+}
+
 func Test_UserHandler_FindUser(t *testing.T) {
 	jwtAuth := jwtauth.New("HS256", []byte("secret"), nil)
 	payload := map[string]interface{}{
@@ -188,14 +620,14 @@ func Test_UserHandler_FindUser(t *testing.T) {
 	ctrl := gomock.NewController(t)
 	defer ctrl.Finish()
 
-	output := &usecase.FindUserUseCaseOutputDTO{Email: "user@mail.com"}
+	output := &usecase.FindUserUseCaseOutputDTO{Email: "user@mail.com", Version: 2}
 
 	findUserUseCase := usecase.NewMockFindUserUseCaseInterface(ctrl)
 	findUserUseCase.EXPECT().Execute(gomock.Any(), gomock.Any()).Return(output, nil).Times(1)
 
 	userHandler := UserHandler{FindUserUseCase: findUserUseCase}
 
-	ctx := jwtauth.NewContext(context.Background(), token, nil)
+	ctx := authenticated(t, jwtauth.NewContext(context.Background(), token, nil))
 	req, err := http.NewRequestWithContext(ctx, http.MethodGet, "/", nil)
 	require.Nil(t, err)
 
@@ -210,4 +642,55 @@ func Test_UserHandler_FindUser(t *testing.T) {
 
 	assert.Equal(t, http.StatusOK, res.StatusCode)
 	assert.Equal(t, output.Email, body.Email)
+	assert.Equal(t, `"2"`, res.Header.Get("ETag"))
+}
+
+func Test_UserHandler_RestoreUser(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	restoreUserUseCase := usecase.NewMockRestoreUserUseCaseInterface(ctrl)
+	restoreUserUseCase.EXPECT().
+		Execute(gomock.Any(), usecase.RestoreUserUseCaseInputDTO{Email: "user@mail.com", Password: "12345"}).
+		Return(&usecase.RestoreUserUseCaseOutputDTO{ID: uuid.NewString()}, nil).
+		Times(1)
+
+	userHandler := UserHandler{RestoreUserUseCase: restoreUserUseCase}
+
+	body, err := json.Marshal(UserHandlerInputDTO{Email: "user@mail.com", Password: "12345"})
+	require.Nil(t, err)
+
+	req := httptest.NewRequest(http.MethodPost, "/", bytes.NewReader(body))
+	rr := httptest.NewRecorder()
+	userHandler.RestoreUser(rr, req)
+
+	res := rr.Result()
+	defer res.Body.Close()
+
+	assert.Equal(t, http.StatusOK, res.StatusCode)
+}
+
+func Test_UserHandler_RestoreUser_WhenGracePeriodExpired(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	restoreUserUseCase := usecase.NewMockRestoreUserUseCaseInterface(ctrl)
+	restoreUserUseCase.EXPECT().
+		Execute(gomock.Any(), gomock.Any()).
+		Return(nil, usecase.ErrRestoreUserGracePeriodExpired).
+		Times(1)
+
+	userHandler := UserHandler{RestoreUserUseCase: restoreUserUseCase}
+
+	body, err := json.Marshal(UserHandlerInputDTO{Email: "user@mail.com", Password: "12345"})
+	require.Nil(t, err)
+
+	req := httptest.NewRequest(http.MethodPost, "/", bytes.NewReader(body))
+	rr := httptest.NewRecorder()
+	userHandler.RestoreUser(rr, req)
+
+	res := rr.Result()
+	defer res.Body.Close()
+
+	assert.Equal(t, http.StatusGone, res.StatusCode)
 }
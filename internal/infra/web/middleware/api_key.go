@@ -0,0 +1,72 @@
+package middleware
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/sesaquecruz/go-auth-api/internal/entity"
+	"github.com/sesaquecruz/go-auth-api/internal/usecase"
+
+	"github.com/go-chi/jwtauth"
+)
+
+// APIKeyScheme is the scheme of the Authorization header of requests made with an API key.
+const APIKeyScheme = "ApiKey"
+
+var ErrInvalidAPIKey = errors.New("invalid api key")
+
+type apiKeyKey struct{}
+
+// VerifyAPIKey authenticates requests made with "Authorization: ApiKey <key>". It replaces the token found by
+// jwtauth.Verifier, which it must follow, with a short lived one holding the claims of the key, so the
+// middlewares after Authenticator need not tell keys from logins. The key itself is marked in the context,
+// never in the token, and the handlers re-issuing tokens refuse requests made with a key.
+// Keys can only read the profile of their user.
+func VerifyAPIKey(jwtAuth *jwtauth.JWTAuth, authAPIKeyUseCase usecase.AuthAPIKeyUseCaseInterface) func(http.Handler) http.Handler {
+	return func(next http.Handler) http.Handler {
+		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			scheme, key, _ := strings.Cut(r.Header.Get("Authorization"), " ")
+			if !strings.EqualFold(scheme, APIKeyScheme) {
+				next.ServeHTTP(w, r)
+				return
+			}
+
+			ctx := r.Context()
+			output, err := authAPIKeyUseCase.Execute(ctx, usecase.AuthAPIKeyUseCaseInputDTO{Key: strings.TrimSpace(key)})
+			if err == usecase.ErrAuthAPIKeyUseCaseInternalError {
+				writeMessage(w, http.StatusInternalServerError, "internal error")
+				return
+			}
+			if err != nil {
+				next.ServeHTTP(w, r.WithContext(jwtauth.NewContext(ctx, nil, ErrInvalidAPIKey)))
+				return
+			}
+
+			keyID, err := uuid.Parse(output.KeyID)
+			if err != nil {
+				writeMessage(w, http.StatusInternalServerError, "internal error")
+				return
+			}
+
+			token, _, err := jwtAuth.Encode(map[string]interface{}{
+				"sub":         output.ID,
+				"tid":         output.TenantID,
+				"exp":         jwtauth.ExpireIn(time.Minute),
+				"roles":       []string{},
+				"permissions": output.Permissions,
+				"scope":       entity.ScopeProfileRead,
+			})
+			if err != nil {
+				writeMessage(w, http.StatusInternalServerError, "internal error")
+				return
+			}
+
+			ctx = context.WithValue(jwtauth.NewContext(ctx, token, nil), apiKeyKey{}, keyID)
+			next.ServeHTTP(w, r.WithContext(ctx))
+		})
+	}
+}
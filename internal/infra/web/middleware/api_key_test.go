@@ -0,0 +1,99 @@
+package middleware
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/sesaquecruz/go-auth-api/internal/entity"
+	"github.com/sesaquecruz/go-auth-api/internal/usecase"
+
+	"github.com/go-chi/chi/v5"
+	"github.com/go-chi/jwtauth"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func Test_VerifyAPIKey(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	jwtAuth := jwtauth.New("HS256", []byte("secret"), nil)
+	output := &usecase.AuthAPIKeyUseCaseOutputDTO{
+		ID:          uuid.NewString(),
+		TenantID:    uuid.NewString(),
+		KeyID:       uuid.NewString(),
+		Permissions: []string{"users:read"},
+	}
+
+	authAPIKeyUseCase := usecase.NewMockAuthAPIKeyUseCaseInterface(ctrl)
+	authAPIKeyUseCase.EXPECT().Execute(gomock.Any(), usecase.AuthAPIKeyUseCaseInputDTO{Key: "ak_valid"}).Return(output, nil).Times(1)
+	authAPIKeyUseCase.EXPECT().Execute(gomock.Any(), usecase.AuthAPIKeyUseCaseInputDTO{Key: "ak_invalid"}).Return(nil, usecase.ErrAuthAPIKeyUseCaseInvalidKey).Times(1)
+	authAPIKeyUseCase.EXPECT().Execute(gomock.Any(), usecase.AuthAPIKeyUseCaseInputDTO{Key: "ak_failing"}).Return(nil, usecase.ErrAuthAPIKeyUseCaseInternalError).Times(1)
+
+	var claims map[string]interface{}
+	var principal *Principal
+	handler := chi.Chain(
+		jwtauth.Verifier(jwtAuth),
+		VerifyAPIKey(jwtAuth, authAPIKeyUseCase),
+		Authenticator,
+		RequirePermission("users:read"),
+	).HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		_, claims, _ = jwtauth.FromContext(r.Context())
+		principal, _ = PrincipalFromContext(r.Context())
+		w.WriteHeader(http.StatusOK)
+	})
+
+	_, bearer, err := jwtAuth.Encode(map[string]interface{}{"sub": uuid.NewString(), "permissions": []string{"users:read"}})
+	assert.Nil(t, err)
+
+	// Only VerifyAPIKey tells a request is made with a key.
+	_, forged, err := jwtAuth.Encode(map[string]interface{}{"sub": uuid.NewString(), "akid": uuid.NewString(), "permissions": []string{"users:read"}})
+	assert.Nil(t, err)
+
+	tests := []struct {
+		name          string
+		authorization string
+		status        int
+	}{
+		{"api key", "ApiKey ak_valid", http.StatusOK},
+		{"invalid api key", "ApiKey ak_invalid", http.StatusUnauthorized},
+		{"failing api key", "apikey ak_failing", http.StatusInternalServerError},
+		{"bearer token", "Bearer " + bearer, http.StatusOK},
+		{"bearer token claiming a key", "Bearer " + forged, http.StatusUnauthorized},
+		{"missing", "", http.StatusUnauthorized},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			claims = nil
+
+			req := httptest.NewRequest(http.MethodGet, "/", nil)
+			if tt.authorization != "" {
+				req.Header.Set("Authorization", tt.authorization)
+			}
+			rr := httptest.NewRecorder()
+			handler.ServeHTTP(rr, req)
+
+			assert.Equal(t, tt.status, rr.Code)
+		})
+	}
+
+	req := httptest.NewRequest(http.MethodGet, "/", nil)
+	req.Header.Set("Authorization", "ApiKey ak_valid")
+	authAPIKeyUseCase.EXPECT().Execute(gomock.Any(), gomock.Any()).Return(output, nil).Times(1)
+	handler.ServeHTTP(httptest.NewRecorder(), req)
+
+	assert.Equal(t, output.ID, claims["sub"])
+	assert.Equal(t, output.TenantID, claims["tid"])
+	assert.NotContains(t, claims, "akid")
+	assert.NotNil(t, claims["exp"])
+	assert.Equal(t, []string{"users:read"}, claims["permissions"])
+	assert.Equal(t, []string{}, claims["roles"])
+	assert.Equal(t, entity.ScopeProfileRead, claims["scope"])
+	require.NotNil(t, principal)
+	assert.Equal(t, AuthMethodAPIKey, principal.AuthMethod)
+	assert.Equal(t, output.KeyID, principal.APIKeyID.String())
+}
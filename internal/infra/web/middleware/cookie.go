@@ -0,0 +1,99 @@
+package middleware
+
+import (
+	"context"
+	"crypto/rand"
+	"crypto/subtle"
+	"encoding/base64"
+	"net/http"
+
+	"github.com/go-chi/jwtauth"
+	"github.com/lestrrat-go/jwx/jwt"
+)
+
+const (
+	// SessionCookie holds the token of browser sessions, out of reach of scripts.
+	SessionCookie = "session"
+	// CSRFCookie holds a random value that scripts of the frontend echo in CSRFHeader, which other sites cannot read.
+	CSRFCookie = "csrf_token"
+	CSRFHeader = "X-CSRF-Token"
+)
+
+const csrfTokenLen = 32
+
+type cookieAuthKey struct{}
+
+// Verifier is jwtauth.Verifier reading the token from the Authorization header or, when the request has none,
+// from SessionCookie. Requests authenticated by the cookie are marked for RequireCSRF. Tokens must also pass
+// the validations in options, such as their issuer and audience.
+func Verifier(jwtAuth *jwtauth.JWTAuth, options ...jwt.ValidateOption) func(http.Handler) http.Handler {
+	return func(next http.Handler) http.Handler {
+		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			ctx := r.Context()
+			findToken := jwtauth.TokenFromHeader
+			if r.Header.Get("Authorization") == "" {
+				ctx = context.WithValue(ctx, cookieAuthKey{}, true)
+				findToken = tokenFromSessionCookie
+			}
+
+			token, err := jwtauth.VerifyRequest(jwtAuth, r, findToken)
+			if err == nil && len(options) > 0 {
+				err = jwt.Validate(token, options...)
+			}
+			next.ServeHTTP(w, r.WithContext(jwtauth.NewContext(ctx, token, err)))
+		})
+	}
+}
+
+// AuthenticatedByCookie tells whether the token of the request came from SessionCookie.
+func AuthenticatedByCookie(ctx context.Context) bool {
+	byCookie, _ := ctx.Value(cookieAuthKey{}).(bool)
+	return byCookie
+}
+
+// RequireCSRF rejects requests authenticated by SessionCookie that may change state, unless CSRFHeader matches
+// CSRFCookie. Requests with an Authorization header need no check, since other sites cannot set it.
+// It must run after Verifier.
+func RequireCSRF(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if !isSafeMethod(r.Method) && AuthenticatedByCookie(r.Context()) && !hasValidCSRFToken(r) {
+			writeMessage(w, http.StatusForbidden, "invalid csrf token")
+			return
+		}
+
+		next.ServeHTTP(w, r)
+	})
+}
+
+func NewCSRFToken() (string, error) {
+	b := make([]byte, csrfTokenLen)
+	_, err := rand.Read(b)
+	if err != nil {
+		return "", err
+	}
+	return base64.RawURLEncoding.EncodeToString(b), nil
+}
+
+func isSafeMethod(method string) bool {
+	switch method {
+	case http.MethodGet, http.MethodHead, http.MethodOptions:
+		return true
+	}
+	return false
+}
+
+func hasValidCSRFToken(r *http.Request) bool {
+	cookie, err := r.Cookie(CSRFCookie)
+	if err != nil || cookie.Value == "" {
+		return false
+	}
+	return subtle.ConstantTimeCompare([]byte(cookie.Value), []byte(r.Header.Get(CSRFHeader))) == 1
+}
+
+func tokenFromSessionCookie(r *http.Request) string {
+	cookie, err := r.Cookie(SessionCookie)
+	if err != nil {
+		return ""
+	}
+	return cookie.Value
+}
@@ -0,0 +1,144 @@
+package middleware
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/go-chi/chi/v5"
+	"github.com/go-chi/jwtauth"
+	"github.com/lestrrat-go/jwx/jwt"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func Test_Verifier(t *testing.T) {
+	jwtAuth := jwtauth.New("HS256", []byte("secret"), nil)
+	_, token, err := jwtAuth.Encode(map[string]interface{}{"sub": "user", "exp": jwtauth.ExpireIn(time.Minute)})
+	require.Nil(t, err)
+
+	var byCookie bool
+	handler := chi.Chain(Verifier(jwtAuth), jwtauth.Authenticator).HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		byCookie = AuthenticatedByCookie(r.Context())
+		w.WriteHeader(http.StatusOK)
+	})
+
+	tests := []struct {
+		name     string
+		header   string
+		cookies  map[string]string
+		status   int
+		byCookie bool
+	}{
+		{"header", "Bearer " + token, nil, http.StatusOK, false},
+		{"cookie", "", map[string]string{SessionCookie: token}, http.StatusOK, true},
+		{"header before cookie", "Bearer invalid", map[string]string{SessionCookie: token}, http.StatusUnauthorized, false},
+		{"jwt cookie", "", map[string]string{"jwt": token}, http.StatusUnauthorized, false},
+		{"invalid cookie", "", map[string]string{SessionCookie: "invalid"}, http.StatusUnauthorized, false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			byCookie = false
+
+			req := httptest.NewRequest(http.MethodGet, "/", nil)
+			if tt.header != "" {
+				req.Header.Set("Authorization", tt.header)
+			}
+			for name, value := range tt.cookies {
+				req.AddCookie(&http.Cookie{Name: name, Value: value})
+			}
+			rr := httptest.NewRecorder()
+			handler.ServeHTTP(rr, req)
+
+			assert.Equal(t, tt.status, rr.Code)
+			assert.Equal(t, tt.byCookie, byCookie)
+		})
+	}
+}
+
+func Test_Verifier_WithValidateOptions(t *testing.T) {
+	jwtAuth := jwtauth.New("HS256", []byte("secret"), nil)
+	handler := chi.Chain(Verifier(jwtAuth, jwt.WithClaimValue(jwt.IssuerKey, "auth"), jwt.WithAudience("api")), jwtauth.Authenticator).
+		HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			w.WriteHeader(http.StatusOK)
+		})
+
+	tests := []struct {
+		name   string
+		claims map[string]interface{}
+		status int
+	}{
+		{"issuer and audience", map[string]interface{}{"iss": "auth", "aud": "api"}, http.StatusOK},
+		{"other issuer", map[string]interface{}{"iss": "other", "aud": "api"}, http.StatusUnauthorized},
+		{"other audience", map[string]interface{}{"iss": "auth", "aud": "other"}, http.StatusUnauthorized},
+		{"no issuer", map[string]interface{}{"aud": "api"}, http.StatusUnauthorized},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tt.claims["sub"] = "user"
+			tt.claims["exp"] = jwtauth.ExpireIn(time.Minute)
+			_, token, err := jwtAuth.Encode(tt.claims)
+			require.Nil(t, err)
+
+			req := httptest.NewRequest(http.MethodGet, "/", nil)
+			req.Header.Set("Authorization", "Bearer "+token)
+			rr := httptest.NewRecorder()
+			handler.ServeHTTP(rr, req)
+
+			assert.Equal(t, tt.status, rr.Code)
+		})
+	}
+}
+
+func Test_RequireCSRF(t *testing.T) {
+	jwtAuth := jwtauth.New("HS256", []byte("secret"), nil)
+	_, token, err := jwtAuth.Encode(map[string]interface{}{"sub": "user", "exp": jwtauth.ExpireIn(time.Minute)})
+	require.Nil(t, err)
+
+	csrfToken, err := NewCSRFToken()
+	require.Nil(t, err)
+
+	handler := chi.Chain(Verifier(jwtAuth), jwtauth.Authenticator, RequireCSRF).HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusOK)
+	})
+
+	tests := []struct {
+		name   string
+		method string
+		bearer bool
+		cookie string
+		header string
+		status int
+	}{
+		{"safe method", http.MethodGet, false, "", "", http.StatusOK},
+		{"bearer token", http.MethodDelete, true, "", "", http.StatusOK},
+		{"matching token", http.MethodPut, false, csrfToken, csrfToken, http.StatusOK},
+		{"missing header", http.MethodPut, false, csrfToken, "", http.StatusForbidden},
+		{"missing cookie", http.MethodDelete, false, "", csrfToken, http.StatusForbidden},
+		{"mismatching token", http.MethodPost, false, csrfToken, csrfToken + "x", http.StatusForbidden},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			req := httptest.NewRequest(tt.method, "/", nil)
+			if tt.bearer {
+				req.Header.Set("Authorization", "Bearer "+token)
+			} else {
+				req.AddCookie(&http.Cookie{Name: SessionCookie, Value: token})
+			}
+			if tt.cookie != "" {
+				req.AddCookie(&http.Cookie{Name: CSRFCookie, Value: tt.cookie})
+			}
+			if tt.header != "" {
+				req.Header.Set(CSRFHeader, tt.header)
+			}
+			rr := httptest.NewRecorder()
+			handler.ServeHTTP(rr, req)
+
+			assert.Equal(t, tt.status, rr.Code)
+		})
+	}
+}
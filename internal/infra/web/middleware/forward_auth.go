@@ -0,0 +1,190 @@
+package middleware
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/url"
+	"path"
+	"strings"
+)
+
+// Access levels of forward auth rules.
+const (
+	ForwardAuthPublic        = "public"
+	ForwardAuthDeny          = "deny"
+	ForwardAuthAuthenticated = "authenticated"
+	ForwardAuthRole          = "role"
+	ForwardAuthPermission    = "permission"
+)
+
+// ForwardAuthRule sets what the requests forwarded by a reverse proxy for paths under Prefix require:
+// nothing, to be denied, a valid token, or a token with the role or permission named by Value.
+type ForwardAuthRule struct {
+	Prefix string
+	Access string
+	Value  string
+}
+
+type forwardAuthRuleKey struct{}
+
+// ParseForwardAuthRules reads rules written as "PREFIX=ACCESS" separated by commas, where ACCESS is
+// public, deny, authenticated, role:NAME or permission:NAME.
+func ParseForwardAuthRules(value string) ([]ForwardAuthRule, error) {
+	rules := make([]ForwardAuthRule, 0)
+	for _, entry := range strings.Split(value, ",") {
+		entry = strings.TrimSpace(entry)
+		if entry == "" {
+			continue
+		}
+
+		prefix, access, ok := strings.Cut(entry, "=")
+		if !ok || !strings.HasPrefix(prefix, "/") {
+			return nil, fmt.Errorf("invalid forward auth rule %q", entry)
+		}
+
+		rule := ForwardAuthRule{Prefix: prefix, Access: access}
+		if kind, name, ok := strings.Cut(access, ":"); ok {
+			rule.Access, rule.Value = kind, name
+		}
+
+		switch rule.Access {
+		case ForwardAuthPublic, ForwardAuthDeny, ForwardAuthAuthenticated:
+			if rule.Value != "" {
+				return nil, fmt.Errorf("invalid forward auth rule %q", entry)
+			}
+		case ForwardAuthRole, ForwardAuthPermission:
+			if rule.Value == "" {
+				return nil, fmt.Errorf("invalid forward auth rule %q", entry)
+			}
+		default:
+			return nil, fmt.Errorf("invalid forward auth rule %q", entry)
+		}
+
+		rules = append(rules, rule)
+	}
+	return rules, nil
+}
+
+// ForwardedRequest returns the method and cleaned path of the request a reverse proxy asks to authorize, taken
+// from the X-Forwarded-Method and X-Forwarded-Uri headers of Traefik or the X-Original-Method and
+// X-Original-URI headers of nginx.
+func ForwardedRequest(r *http.Request) (string, string) {
+	method := firstHeader(r, "X-Forwarded-Method", "X-Original-Method")
+	if method == "" {
+		method = http.MethodGet
+	}
+
+	path, _ := forwardedPath(r)
+	return strings.ToUpper(method), path
+}
+
+// forwardedPath cleans the path of the forwarded request, which proxies may pass as it was received. It is
+// not valid when it has dot segments, encoded slashes or backslashes, since the application behind the proxy
+// may resolve them to another path than the one the rules are matched against.
+func forwardedPath(r *http.Request) (string, bool) {
+	value := firstHeader(r, "X-Forwarded-Uri", "X-Original-URI")
+	if value == "" {
+		return "/", true
+	}
+
+	uri, err := url.ParseRequestURI(value)
+	if err != nil {
+		return "/", false
+	}
+
+	raw := strings.ToLower(uri.EscapedPath())
+	if strings.Contains(raw, "%2f") || strings.Contains(raw, "%5c") || strings.Contains(uri.Path, "\\") {
+		return "/", false
+	}
+	for _, segment := range strings.Split(uri.Path, "/") {
+		if segment == "." || segment == ".." {
+			return "/", false
+		}
+	}
+
+	cleaned := path.Clean("/" + uri.Path)
+	if strings.HasSuffix(uri.Path, "/") && cleaned != "/" {
+		cleaned += "/"
+	}
+	return cleaned, true
+}
+
+// MatchForwardAuthRule finds the rule with the longest prefix matching the forwarded request, which defaults
+// to requiring a valid token. Public requests are let through and denied ones rejected right away, others
+// are left to the authentication middlewares and RequireForwardAuthRule.
+func MatchForwardAuthRule(rules []ForwardAuthRule) func(http.Handler) http.Handler {
+	return func(next http.Handler) http.Handler {
+		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			path, ok := forwardedPath(r)
+			if !ok {
+				writeMessage(w, http.StatusBadRequest, "invalid forwarded uri")
+				return
+			}
+
+			match := ForwardAuthRule{Prefix: "/", Access: ForwardAuthAuthenticated}
+			found := false
+			for _, rule := range rules {
+				if hasPathPrefix(path, rule.Prefix) && (!found || len(rule.Prefix) > len(match.Prefix)) {
+					match, found = rule, true
+				}
+			}
+
+			switch match.Access {
+			case ForwardAuthPublic:
+				w.WriteHeader(http.StatusOK)
+				return
+			case ForwardAuthDeny:
+				writeMessage(w, http.StatusForbidden, "forbidden")
+				return
+			}
+
+			next.ServeHTTP(w, r.WithContext(context.WithValue(r.Context(), forwardAuthRuleKey{}, match)))
+		})
+	}
+}
+
+// RequireForwardAuthRule checks the role or permission required by the rule MatchForwardAuthRule found,
+// and the CSRF token when the forwarded request authenticated by SessionCookie may change state.
+// It must run after MatchForwardAuthRule and Authenticator.
+func RequireForwardAuthRule(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		principal, ok := PrincipalFromContext(r.Context())
+		if !ok {
+			writeMessage(w, http.StatusUnauthorized, "unauthorized")
+			return
+		}
+
+		rule, _ := r.Context().Value(forwardAuthRuleKey{}).(ForwardAuthRule)
+		switch {
+		case rule.Access == ForwardAuthRole && !principal.HasRole(rule.Value),
+			rule.Access == ForwardAuthPermission && !principal.HasPermission(rule.Value):
+			writeMessage(w, http.StatusForbidden, "forbidden")
+			return
+		}
+
+		method, _ := ForwardedRequest(r)
+		if !isSafeMethod(method) && AuthenticatedByCookie(r.Context()) && !hasValidCSRFToken(r) {
+			writeMessage(w, http.StatusForbidden, "invalid csrf token")
+			return
+		}
+
+		next.ServeHTTP(w, r)
+	})
+}
+
+func hasPathPrefix(path string, prefix string) bool {
+	if !strings.HasPrefix(path, prefix) {
+		return false
+	}
+	return len(path) == len(prefix) || strings.HasSuffix(prefix, "/") || path[len(prefix)] == '/'
+}
+
+func firstHeader(r *http.Request, names ...string) string {
+	for _, name := range names {
+		if value := r.Header.Get(name); value != "" {
+			return value
+		}
+	}
+	return ""
+}
@@ -0,0 +1,156 @@
+package middleware
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/go-chi/jwtauth"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func Test_ParseForwardAuthRules(t *testing.T) {
+	rules, err := ParseForwardAuthRules(" /public=public, /admin=role:admin,/reports=permission:reports:read,/legacy=deny,/=authenticated")
+	require.Nil(t, err)
+	assert.Equal(t, []ForwardAuthRule{
+		{Prefix: "/public", Access: ForwardAuthPublic},
+		{Prefix: "/admin", Access: ForwardAuthRole, Value: "admin"},
+		{Prefix: "/reports", Access: ForwardAuthPermission, Value: "reports:read"},
+		{Prefix: "/legacy", Access: ForwardAuthDeny},
+		{Prefix: "/", Access: ForwardAuthAuthenticated},
+	}, rules)
+
+	rules, err = ParseForwardAuthRules("")
+	require.Nil(t, err)
+	assert.Empty(t, rules)
+
+	for _, value := range []string{"/public", "public=public", "/a=open", "/a=role", "/a=role:", "/a=public:x"} {
+		_, err := ParseForwardAuthRules(value)
+		assert.NotNil(t, err, value)
+	}
+}
+
+func Test_ForwardedRequest(t *testing.T) {
+	req := httptest.NewRequest(http.MethodGet, "/auth/verify", nil)
+	method, path := ForwardedRequest(req)
+	assert.Equal(t, http.MethodGet, method)
+	assert.Equal(t, "/", path)
+
+	req.Header.Set("X-Original-Method", "post")
+	req.Header.Set("X-Original-URI", "/admin/users?page=2")
+	method, path = ForwardedRequest(req)
+	assert.Equal(t, http.MethodPost, method)
+	assert.Equal(t, "/admin/users", path)
+
+	req.Header.Set("X-Forwarded-Method", http.MethodDelete)
+	req.Header.Set("X-Forwarded-Uri", "/reports")
+	method, path = ForwardedRequest(req)
+	assert.Equal(t, http.MethodDelete, method)
+	assert.Equal(t, "/reports", path)
+
+	req.Header.Set("X-Forwarded-Uri", "//reports//2023/")
+	_, path = ForwardedRequest(req)
+	assert.Equal(t, "/reports/2023/", path)
+}
+
+func Test_ForwardAuthRules(t *testing.T) {
+	jwtAuth := jwtauth.New("HS256", []byte("secret"), nil)
+	rules := []ForwardAuthRule{
+		{Prefix: "/public", Access: ForwardAuthPublic},
+		{Prefix: "/public/private", Access: ForwardAuthAuthenticated},
+		{Prefix: "/admin", Access: ForwardAuthRole, Value: "admin"},
+		{Prefix: "/reports", Access: ForwardAuthPermission, Value: "reports:read"},
+		{Prefix: "/legacy", Access: ForwardAuthDeny},
+	}
+	next := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusOK)
+	})
+	handler := MatchForwardAuthRule(rules)(Verifier(jwtAuth)(Authenticator(RequireForwardAuthRule(next))))
+
+	_, token, err := jwtAuth.Encode(map[string]interface{}{
+		"sub":         uuid.NewString(),
+		"exp":         jwtauth.ExpireIn(time.Minute),
+		"roles":       []string{"support"},
+		"permissions": []string{"reports:read"},
+	})
+	require.Nil(t, err)
+
+	tests := []struct {
+		name   string
+		path   string
+		token  string
+		status int
+	}{
+		{"public", "/public/index.html", "", http.StatusOK},
+		{"not under public prefix", "/publication", "", http.StatusUnauthorized},
+		{"longest prefix", "/public/private", "", http.StatusUnauthorized},
+		{"denied", "/legacy", token, http.StatusForbidden},
+		{"authenticated by default", "/home", token, http.StatusOK},
+		{"without token", "/home", "", http.StatusUnauthorized},
+		{"without role", "/admin", token, http.StatusForbidden},
+		{"with permission", "/reports/2023", token, http.StatusOK},
+		{"dot segments", "/public/../admin", "", http.StatusBadRequest},
+		{"encoded dot segments", "/public/%2e%2e/admin", "", http.StatusBadRequest},
+		{"encoded slash", "/public%2F..%2Fadmin", "", http.StatusBadRequest},
+		{"encoded backslash", "/public/..%5Cadmin", "", http.StatusBadRequest},
+		{"current directory", "/public/./index.html", "", http.StatusBadRequest},
+		{"double slash", "//admin", token, http.StatusForbidden},
+		{"double slash without token", "//admin", "", http.StatusUnauthorized},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			req := httptest.NewRequest(http.MethodGet, "/auth/verify", nil)
+			req.Header.Set("X-Forwarded-Uri", tt.path)
+			if tt.token != "" {
+				req.Header.Set("Authorization", "Bearer "+tt.token)
+			}
+
+			rr := httptest.NewRecorder()
+			handler.ServeHTTP(rr, req)
+			assert.Equal(t, tt.status, rr.Code)
+		})
+	}
+}
+
+func Test_RequireForwardAuthRule_WithSessionCookie(t *testing.T) {
+	jwtAuth := jwtauth.New("HS256", []byte("secret"), nil)
+	next := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusOK)
+	})
+	handler := MatchForwardAuthRule(nil)(Verifier(jwtAuth)(Authenticator(RequireForwardAuthRule(next))))
+
+	_, token, err := jwtAuth.Encode(map[string]interface{}{"sub": uuid.NewString(), "exp": jwtauth.ExpireIn(time.Minute)})
+	require.Nil(t, err)
+
+	tests := []struct {
+		name   string
+		method string
+		header string
+		status int
+	}{
+		{"safe method", http.MethodGet, "", http.StatusOK},
+		{"without csrf token", http.MethodPost, "", http.StatusForbidden},
+		{"with wrong csrf token", http.MethodPost, "other", http.StatusForbidden},
+		{"with csrf token", http.MethodPost, "csrf", http.StatusOK},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			req := httptest.NewRequest(http.MethodGet, "/auth/verify", nil)
+			req.Header.Set("X-Forwarded-Method", tt.method)
+			req.AddCookie(&http.Cookie{Name: SessionCookie, Value: token})
+			req.AddCookie(&http.Cookie{Name: CSRFCookie, Value: "csrf"})
+			if tt.header != "" {
+				req.Header.Set(CSRFHeader, tt.header)
+			}
+
+			rr := httptest.NewRecorder()
+			handler.ServeHTTP(rr, req)
+			assert.Equal(t, tt.status, rr.Code)
+		})
+	}
+}
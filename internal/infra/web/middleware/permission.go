@@ -0,0 +1,25 @@
+package middleware
+
+import (
+	"net/http"
+)
+
+type messageDTO struct {
+	Message string `json:"message"`
+}
+
+// RequirePermission lets a request through only when its token grants permission in the "permissions" claim.
+// It must run after Authenticator.
+func RequirePermission(permission string) func(http.Handler) http.Handler {
+	return func(next http.Handler) http.Handler {
+		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			principal, ok := PrincipalFromContext(r.Context())
+			if !ok || !principal.HasPermission(permission) {
+				writeMessage(w, http.StatusForbidden, "forbidden")
+				return
+			}
+
+			next.ServeHTTP(w, r)
+		})
+	}
+}
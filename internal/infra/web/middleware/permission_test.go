@@ -0,0 +1,65 @@
+package middleware
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/go-chi/jwtauth"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func Test_RequirePermission(t *testing.T) {
+	jwtAuth := jwtauth.New("HS256", []byte("secret"), nil)
+	next := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusOK)
+	})
+	handler := jwtauth.Verifier(jwtAuth)(Authenticator(RequirePermission("users:admin")(next)))
+
+	tests := []struct {
+		name        string
+		permissions interface{}
+		status      int
+	}{
+		{"granted", []string{"users:read", "users:admin"}, http.StatusOK},
+		{"not granted", []string{"users:read"}, http.StatusForbidden},
+		{"no claim", nil, http.StatusForbidden},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			payload := map[string]interface{}{
+				"sub": uuid.NewString(),
+				"exp": jwtauth.ExpireIn(time.Minute),
+			}
+			if tt.permissions != nil {
+				payload["permissions"] = tt.permissions
+			}
+			_, token, err := jwtAuth.Encode(payload)
+			require.Nil(t, err)
+
+			req := httptest.NewRequest(http.MethodGet, "/", nil)
+			req.Header.Set("Authorization", "Bearer "+token)
+			rr := httptest.NewRecorder()
+			handler.ServeHTTP(rr, req)
+
+			assert.Equal(t, tt.status, rr.Code)
+		})
+	}
+}
+
+func Test_RequirePermission_WithoutToken(t *testing.T) {
+	next := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusOK)
+	})
+
+	req := httptest.NewRequest(http.MethodGet, "/", nil).WithContext(context.Background())
+	rr := httptest.NewRecorder()
+	RequirePermission("users:admin")(next).ServeHTTP(rr, req)
+
+	assert.Equal(t, http.StatusForbidden, rr.Code)
+}
@@ -0,0 +1,167 @@
+package middleware
+
+import (
+	"context"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/sesaquecruz/go-auth-api/internal/entity"
+
+	"github.com/go-chi/jwtauth"
+)
+
+// AuthMethod is how the user behind a request authenticated.
+type AuthMethod string
+
+const (
+	AuthMethodPassword AuthMethod = "password"
+	AuthMethodAPIKey   AuthMethod = "api_key"
+)
+
+// Principal is the user a request is made by, as told by its token.
+type Principal struct {
+	UserID   uuid.UUID
+	TenantID uuid.UUID
+	// SessionID is uuid.Nil for requests made with an API key, and APIKeyID is uuid.Nil for any other.
+	SessionID        uuid.UUID
+	APIKeyID         uuid.UUID
+	OrganizationID   string
+	OrganizationRole string
+	Roles            []string
+	Permissions      []string
+	Scopes           []string
+	AuthMethod       AuthMethod
+	// MFALevel is how many authentication methods beyond the first the user proved, by the "amr" claim.
+	MFALevel int
+	// AuthTime is when the user last proved who they are, zero for API keys.
+	AuthTime time.Time
+}
+
+type principalKey struct{}
+
+func (p *Principal) HasRole(role string) bool {
+	return contains(p.Roles, role)
+}
+
+func (p *Principal) HasPermission(permission string) bool {
+	return contains(p.Permissions, permission)
+}
+
+func (p *Principal) HasScope(scope string) bool {
+	return contains(p.Scopes, scope)
+}
+
+func WithPrincipal(ctx context.Context, principal *Principal) context.Context {
+	return context.WithValue(ctx, principalKey{}, principal)
+}
+
+// PrincipalFromContext returns the Principal Authenticator put into ctx.
+func PrincipalFromContext(ctx context.Context) (*Principal, bool) {
+	principal, ok := ctx.Value(principalKey{}).(*Principal)
+	return principal, ok && principal != nil
+}
+
+// Authenticator is jwtauth.Authenticator that also resolves the Principal of the token, rejecting tokens
+// whose claims do not make one. Requests are made with an API key only when VerifyAPIKey says so, and
+// bearer tokens claiming a key are rejected. It must run after Verifier and VerifyAPIKey.
+func Authenticator(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		token, claims, err := jwtauth.FromContext(r.Context())
+		if err != nil || token == nil {
+			writeMessage(w, http.StatusUnauthorized, "unauthorized")
+			return
+		}
+
+		if _, ok := claims["akid"]; ok {
+			writeMessage(w, http.StatusUnauthorized, "unauthorized")
+			return
+		}
+
+		principal, err := principalOf(claims)
+		if err != nil {
+			writeMessage(w, http.StatusUnauthorized, "unauthorized")
+			return
+		}
+
+		if keyID, ok := r.Context().Value(apiKeyKey{}).(uuid.UUID); ok {
+			principal.APIKeyID = keyID
+			principal.AuthMethod = AuthMethodAPIKey
+		}
+
+		next.ServeHTTP(w, r.WithContext(WithPrincipal(r.Context(), principal)))
+	})
+}
+
+func principalOf(claims map[string]interface{}) (*Principal, error) {
+	principal := &Principal{
+		TenantID:         entity.DefaultTenantID,
+		AuthMethod:       AuthMethodPassword,
+		OrganizationID:   stringClaim(claims, "oid"),
+		OrganizationRole: stringClaim(claims, "org_role"),
+		Roles:            stringsClaim(claims, "roles"),
+		Permissions:      stringsClaim(claims, "permissions"),
+		Scopes:           strings.Fields(stringClaim(claims, "scope")),
+	}
+
+	var err error
+	principal.UserID, err = uuid.Parse(stringClaim(claims, "sub"))
+	if err != nil {
+		return nil, err
+	}
+
+	// Tokens without the claims belong to the default tenant, and have no session.
+	ids := map[string]*uuid.UUID{"tid": &principal.TenantID, "sid": &principal.SessionID}
+	for name, id := range ids {
+		if _, ok := claims[name]; !ok {
+			continue
+		}
+		*id, err = uuid.Parse(stringClaim(claims, name))
+		if err != nil {
+			return nil, err
+		}
+	}
+	if amr := stringsClaim(claims, "amr"); len(amr) > 1 {
+		principal.MFALevel = len(amr) - 1
+	}
+
+	switch authTime := claims["auth_time"].(type) {
+	case float64:
+		principal.AuthTime = time.Unix(int64(authTime), 0).UTC()
+	case int64:
+		principal.AuthTime = time.Unix(authTime, 0).UTC()
+	}
+
+	return principal, nil
+}
+
+func stringClaim(claims map[string]interface{}, name string) string {
+	value, _ := claims[name].(string)
+	return value
+}
+
+// stringsClaim reads a list claim, which decoded tokens hold as []interface{}.
+func stringsClaim(claims map[string]interface{}, name string) []string {
+	values := make([]string, 0)
+	switch list := claims[name].(type) {
+	case []string:
+		values = append(values, list...)
+	case []interface{}:
+		for _, value := range list {
+			if str, ok := value.(string); ok {
+				values = append(values, str)
+			}
+		}
+	}
+	return values
+}
+
+func contains(values []string, value string) bool {
+	for _, v := range values {
+		if v == value {
+			return true
+		}
+	}
+	return false
+}
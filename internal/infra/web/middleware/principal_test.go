@@ -0,0 +1,115 @@
+package middleware
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/go-chi/jwtauth"
+	"github.com/google/uuid"
+	"github.com/sesaquecruz/go-auth-api/internal/entity"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func Test_Authenticator(t *testing.T) {
+	jwtAuth := jwtauth.New("HS256", []byte("secret"), nil)
+	userID, tenantID, sessionID := uuid.New(), uuid.New(), uuid.New()
+	authTime := time.Now().Add(-time.Minute).Truncate(time.Second).UTC()
+
+	var principal *Principal
+	handler := jwtauth.Verifier(jwtAuth)(Authenticator(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		principal, _ = PrincipalFromContext(r.Context())
+		w.WriteHeader(http.StatusOK)
+	})))
+
+	tests := []struct {
+		name      string
+		claims    map[string]interface{}
+		status    int
+		principal *Principal
+	}{
+		{
+			"password",
+			map[string]interface{}{
+				"sub":         userID.String(),
+				"tid":         tenantID.String(),
+				"sid":         sessionID.String(),
+				"oid":         "organization",
+				"org_role":    "owner",
+				"roles":       []string{"admin"},
+				"permissions": []string{"users:read"},
+				"scope":       "profile:read profile:write",
+				"amr":         []string{"pwd", "otp"},
+				"auth_time":   authTime.Unix(),
+			},
+			http.StatusOK,
+			&Principal{
+				UserID:           userID,
+				TenantID:         tenantID,
+				SessionID:        sessionID,
+				OrganizationID:   "organization",
+				OrganizationRole: "owner",
+				Roles:            []string{"admin"},
+				Permissions:      []string{"users:read"},
+				Scopes:           []string{"profile:read", "profile:write"},
+				AuthMethod:       AuthMethodPassword,
+				MFALevel:         1,
+				AuthTime:         authTime,
+			},
+		},
+		{
+			"default tenant",
+			map[string]interface{}{"sub": userID.String(), "sid": sessionID.String()},
+			http.StatusOK,
+			&Principal{
+				UserID:      userID,
+				TenantID:    entity.DefaultTenantID,
+				SessionID:   sessionID,
+				Roles:       []string{},
+				Permissions: []string{},
+				Scopes:      []string{},
+				AuthMethod:  AuthMethodPassword,
+			},
+		},
+		{"api key claim", map[string]interface{}{"sub": userID.String(), "akid": uuid.NewString()}, http.StatusUnauthorized, nil},
+		{"no subject", map[string]interface{}{}, http.StatusUnauthorized, nil},
+		{"invalid subject", map[string]interface{}{"sub": "user"}, http.StatusUnauthorized, nil},
+		{"invalid tenant", map[string]interface{}{"sub": userID.String(), "tid": "tenant"}, http.StatusUnauthorized, nil},
+		{"invalid session", map[string]interface{}{"sub": userID.String(), "sid": "session"}, http.StatusUnauthorized, nil},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			principal = nil
+			tt.claims["exp"] = jwtauth.ExpireIn(time.Minute)
+			_, token, err := jwtAuth.Encode(tt.claims)
+			require.Nil(t, err)
+
+			req := httptest.NewRequest(http.MethodGet, "/", nil)
+			req.Header.Set("Authorization", "Bearer "+token)
+			rr := httptest.NewRecorder()
+			handler.ServeHTTP(rr, req)
+
+			assert.Equal(t, tt.status, rr.Code)
+			if tt.principal != nil {
+				assert.Equal(t, tt.principal, principal)
+			} else {
+				assert.Nil(t, principal)
+			}
+		})
+	}
+}
+
+func Test_Authenticator_WithoutToken(t *testing.T) {
+	next := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusOK)
+	})
+
+	req := httptest.NewRequest(http.MethodGet, "/", nil)
+	rr := httptest.NewRecorder()
+	Authenticator(next).ServeHTTP(rr, req)
+
+	assert.Equal(t, http.StatusUnauthorized, rr.Code)
+}
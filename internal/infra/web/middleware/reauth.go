@@ -0,0 +1,51 @@
+package middleware
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"time"
+
+	"github.com/sesaquecruz/go-auth-api/internal/entity"
+)
+
+// ReauthenticationRequired is the error of RFC 9470 telling the client to authenticate the user again.
+const ReauthenticationRequired = "insufficient_user_authentication"
+
+type reauthenticationDTO struct {
+	Message string `json:"message"`
+	Error   string `json:"error"`
+	MaxAge  int64  `json:"max_age"`
+}
+
+// RequireRecentAuth lets a request through only when the user proved who they are less than maxAge ago, as
+// told by the "auth_time" claim. Other requests are rejected with ReauthenticationRequired, which clients
+// answer by calling /login/reauth. It must run after Authenticator.
+func RequireRecentAuth(maxAge time.Duration) func(http.Handler) http.Handler {
+	seconds := int64(maxAge / time.Second)
+	challenge := fmt.Sprintf(`Bearer error=%q, error_description="reauthentication required", max_age=%d`, ReauthenticationRequired, seconds)
+
+	return func(next http.Handler) http.Handler {
+		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			principal, ok := PrincipalFromContext(r.Context())
+			if !ok {
+				writeMessage(w, http.StatusUnauthorized, "unauthorized")
+				return
+			}
+
+			if principal.AuthTime.IsZero() || entity.Now().Sub(principal.AuthTime) > maxAge {
+				w.Header().Set("WWW-Authenticate", challenge)
+				w.Header().Set("Content-Type", "application/json")
+				w.WriteHeader(http.StatusUnauthorized)
+				json.NewEncoder(w).Encode(reauthenticationDTO{
+					Message: "reauthentication required",
+					Error:   ReauthenticationRequired,
+					MaxAge:  seconds,
+				})
+				return
+			}
+
+			next.ServeHTTP(w, r)
+		})
+	}
+}
@@ -0,0 +1,67 @@
+package middleware
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func Test_RequireRecentAuth(t *testing.T) {
+	next := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusOK)
+	})
+	handler := RequireRecentAuth(5 * time.Minute)(next)
+
+	tests := []struct {
+		name     string
+		authTime time.Time
+		status   int
+	}{
+		{"recent", time.Now().Add(-time.Minute), http.StatusOK},
+		{"stale", time.Now().Add(-10 * time.Minute), http.StatusUnauthorized},
+		{"never", time.Time{}, http.StatusUnauthorized},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			req := httptest.NewRequest(http.MethodDelete, "/", nil)
+			req = req.WithContext(WithPrincipal(req.Context(), &Principal{UserID: uuid.New(), AuthTime: tt.authTime}))
+			rr := httptest.NewRecorder()
+			handler.ServeHTTP(rr, req)
+
+			assert.Equal(t, tt.status, rr.Code)
+			if tt.status == http.StatusUnauthorized {
+				assert.Equal(t,
+					`Bearer error="insufficient_user_authentication", error_description="reauthentication required", max_age=300`,
+					rr.Header().Get("WWW-Authenticate"))
+
+				var body reauthenticationDTO
+				require.Nil(t, json.NewDecoder(rr.Body).Decode(&body))
+				assert.Equal(t, reauthenticationDTO{
+					Message: "reauthentication required",
+					Error:   ReauthenticationRequired,
+					MaxAge:  300,
+				}, body)
+			}
+		})
+	}
+}
+
+func Test_RequireRecentAuth_WithoutPrincipal(t *testing.T) {
+	next := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusOK)
+	})
+
+	req := httptest.NewRequest(http.MethodDelete, "/", nil)
+	rr := httptest.NewRecorder()
+	RequireRecentAuth(time.Minute)(next).ServeHTTP(rr, req)
+
+	assert.Equal(t, http.StatusUnauthorized, rr.Code)
+	assert.Empty(t, rr.Header().Get("WWW-Authenticate"))
+}
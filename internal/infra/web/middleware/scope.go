@@ -0,0 +1,33 @@
+package middleware
+
+import (
+	"fmt"
+	"net/http"
+	"strings"
+)
+
+// RequireScopes lets a request through only when its token holds every one of scopes in the "scope" claim.
+// Other requests get the insufficient_scope error of RFC 6750. It must run after Authenticator.
+func RequireScopes(scopes ...string) func(http.Handler) http.Handler {
+	challenge := fmt.Sprintf(`Bearer error="insufficient_scope", scope=%q`, strings.Join(scopes, " "))
+
+	return func(next http.Handler) http.Handler {
+		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			principal, ok := PrincipalFromContext(r.Context())
+			if !ok {
+				writeMessage(w, http.StatusUnauthorized, "unauthorized")
+				return
+			}
+
+			for _, scope := range scopes {
+				if !principal.HasScope(scope) {
+					w.Header().Set("WWW-Authenticate", challenge)
+					writeMessage(w, http.StatusForbidden, "insufficient scope")
+					return
+				}
+			}
+
+			next.ServeHTTP(w, r)
+		})
+	}
+}
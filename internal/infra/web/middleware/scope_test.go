@@ -0,0 +1,67 @@
+package middleware
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/go-chi/jwtauth"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func Test_RequireScopes(t *testing.T) {
+	jwtAuth := jwtauth.New("HS256", []byte("secret"), nil)
+	next := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusOK)
+	})
+	handler := jwtauth.Verifier(jwtAuth)(Authenticator(RequireScopes("profile:read", "profile:write")(next)))
+
+	tests := []struct {
+		name   string
+		scope  interface{}
+		status int
+	}{
+		{"granted", "profile:read profile:write", http.StatusOK},
+		{"not granted", "profile:read", http.StatusForbidden},
+		{"no claim", nil, http.StatusForbidden},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			payload := map[string]interface{}{
+				"sub": uuid.NewString(),
+				"exp": jwtauth.ExpireIn(time.Minute),
+			}
+			if tt.scope != nil {
+				payload["scope"] = tt.scope
+			}
+			_, token, err := jwtAuth.Encode(payload)
+			require.Nil(t, err)
+
+			req := httptest.NewRequest(http.MethodGet, "/", nil)
+			req.Header.Set("Authorization", "Bearer "+token)
+			rr := httptest.NewRecorder()
+			handler.ServeHTTP(rr, req)
+
+			assert.Equal(t, tt.status, rr.Code)
+			if tt.status == http.StatusForbidden {
+				assert.Equal(t, `Bearer error="insufficient_scope", scope="profile:read profile:write"`, rr.Header().Get("WWW-Authenticate"))
+			}
+		})
+	}
+}
+
+func Test_RequireScopes_WithoutPrincipal(t *testing.T) {
+	next := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusOK)
+	})
+
+	req := httptest.NewRequest(http.MethodGet, "/", nil)
+	rr := httptest.NewRecorder()
+	RequireScopes("profile:read")(next).ServeHTTP(rr, req)
+
+	assert.Equal(t, http.StatusUnauthorized, rr.Code)
+}
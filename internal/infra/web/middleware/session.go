@@ -0,0 +1,56 @@
+package middleware
+
+import (
+	"database/sql"
+	"net/http"
+
+	"github.com/google/uuid"
+	"github.com/sesaquecruz/go-auth-api/internal/entity"
+)
+
+// RequireActiveSession lets a request through only while the session in the "sid" claim belongs to the user
+// in the "sub" claim and is still active, so tokens stop working as soon as their session is revoked. Requests
+// made with an API key have no session. It also records when the session was last seen.
+// It must run after Authenticator and RequireTokenTenant.
+func RequireActiveSession(sessionRepository entity.SessionRepositoryInterface) func(http.Handler) http.Handler {
+	return func(next http.Handler) http.Handler {
+		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			principal, ok := PrincipalFromContext(r.Context())
+			if !ok || principal.AuthMethod != AuthMethodAPIKey && principal.SessionID == uuid.Nil {
+				writeMessage(w, http.StatusUnauthorized, "unauthorized")
+				return
+			}
+
+			if principal.AuthMethod == AuthMethodAPIKey {
+				next.ServeHTTP(w, r)
+				return
+			}
+
+			session, err := sessionRepository.FindById(r.Context(), principal.SessionID)
+			if err == sql.ErrNoRows {
+				writeMessage(w, http.StatusUnauthorized, "unauthorized")
+				return
+			}
+			if err != nil {
+				writeMessage(w, http.StatusInternalServerError, "internal error")
+				return
+			}
+
+			now := entity.Now()
+			if session.UserID != principal.UserID || !session.IsActive(now) {
+				writeMessage(w, http.StatusUnauthorized, "unauthorized")
+				return
+			}
+
+			if session.NeedsTouch(now) {
+				err = sessionRepository.Touch(r.Context(), session.ID, now)
+				if err != nil {
+					writeMessage(w, http.StatusInternalServerError, "internal error")
+					return
+				}
+			}
+
+			next.ServeHTTP(w, r)
+		})
+	}
+}
@@ -0,0 +1,145 @@
+package middleware
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/sesaquecruz/go-auth-api/internal/entity"
+	"github.com/sesaquecruz/go-auth-api/internal/infra/database/memory"
+
+	"github.com/go-chi/jwtauth"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func Test_RequireActiveSession(t *testing.T) {
+	jwtAuth := jwtauth.New("HS256", []byte("secret"), nil)
+	next := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusOK)
+	})
+
+	ctx := context.Background()
+	sessionRepository := memory.NewSessionRepository()
+	handler := jwtauth.Verifier(jwtAuth)(Authenticator(RequireActiveSession(sessionRepository)(next)))
+
+	newSession := func(ttl time.Duration) *entity.Session {
+		session, err := entity.NewSession(uuid.New(), "", "", ttl)
+		require.Nil(t, err)
+		require.Nil(t, sessionRepository.Save(ctx, *session))
+		return session
+	}
+
+	active := newSession(time.Hour)
+	expired := newSession(-time.Second)
+	revoked := newSession(time.Hour)
+	require.Nil(t, sessionRepository.Revoke(ctx, revoked.ID, revoked.UserID, entity.Now()))
+
+	tests := []struct {
+		name   string
+		claims map[string]interface{}
+		status int
+	}{
+		{"active", map[string]interface{}{"sub": active.UserID.String(), "sid": active.ID.String()}, http.StatusOK},
+		{"expired", map[string]interface{}{"sub": expired.UserID.String(), "sid": expired.ID.String()}, http.StatusUnauthorized},
+		{"revoked", map[string]interface{}{"sub": revoked.UserID.String(), "sid": revoked.ID.String()}, http.StatusUnauthorized},
+		{"other user", map[string]interface{}{"sub": uuid.NewString(), "sid": active.ID.String()}, http.StatusUnauthorized},
+		{"unknown", map[string]interface{}{"sub": active.UserID.String(), "sid": uuid.NewString()}, http.StatusUnauthorized},
+		{"missing", map[string]interface{}{"sub": active.UserID.String()}, http.StatusUnauthorized},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tt.claims["exp"] = jwtauth.ExpireIn(time.Minute)
+			_, token, err := jwtAuth.Encode(tt.claims)
+			require.Nil(t, err)
+
+			req := httptest.NewRequest(http.MethodGet, "/", nil)
+			req.Header.Set("Authorization", "Bearer "+token)
+			rr := httptest.NewRecorder()
+			handler.ServeHTTP(rr, req)
+
+			assert.Equal(t, tt.status, rr.Code)
+		})
+	}
+}
+
+func Test_RequireActiveSession_WithAPIKey(t *testing.T) {
+	next := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusOK)
+	})
+	principal := &Principal{UserID: uuid.New(), APIKeyID: uuid.New(), AuthMethod: AuthMethodAPIKey}
+
+	req := httptest.NewRequest(http.MethodGet, "/", nil)
+	req = req.WithContext(WithPrincipal(req.Context(), principal))
+	rr := httptest.NewRecorder()
+	RequireActiveSession(memory.NewSessionRepository())(next).ServeHTTP(rr, req)
+
+	assert.Equal(t, http.StatusOK, rr.Code)
+}
+
+func Test_RequireActiveSession_TouchesStaleSessions(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	fresh, err := entity.NewSession(uuid.New(), "", "", time.Hour)
+	require.Nil(t, err)
+	stale, err := entity.NewSession(uuid.New(), "", "", time.Hour)
+	require.Nil(t, err)
+	stale.LastSeenAt = stale.LastSeenAt.Add(-entity.SessionTouchInterval)
+
+	sessionRepository := entity.NewMockSessionRepositoryInterface(ctrl)
+	sessionRepository.EXPECT().FindById(gomock.Any(), fresh.ID).Return(fresh, nil).Times(1)
+	sessionRepository.EXPECT().FindById(gomock.Any(), stale.ID).Return(stale, nil).Times(2)
+	sessionRepository.EXPECT().Touch(gomock.Any(), fresh.ID, gomock.Any()).Times(0)
+	sessionRepository.EXPECT().Touch(gomock.Any(), stale.ID, gomock.Any()).Return(nil).Times(1)
+	sessionRepository.EXPECT().Touch(gomock.Any(), stale.ID, gomock.Any()).Return(errors.New("")).Times(1)
+
+	next := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusOK)
+	})
+
+	for _, tt := range []struct {
+		session *entity.Session
+		status  int
+	}{
+		{fresh, http.StatusOK},
+		{stale, http.StatusOK},
+		{stale, http.StatusInternalServerError},
+	} {
+		principal := &Principal{UserID: tt.session.UserID, SessionID: tt.session.ID, AuthMethod: AuthMethodPassword}
+
+		req := httptest.NewRequest(http.MethodGet, "/", nil)
+		req = req.WithContext(WithPrincipal(req.Context(), principal))
+		rr := httptest.NewRecorder()
+		RequireActiveSession(sessionRepository)(next).ServeHTTP(rr, req)
+
+		assert.Equal(t, tt.status, rr.Code)
+	}
+}
+
+func Test_RequireActiveSession_WhenRepositoryFails(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	sessionRepository := entity.NewMockSessionRepositoryInterface(ctrl)
+	sessionRepository.EXPECT().FindById(gomock.Any(), gomock.Any()).Return(nil, errors.New("")).Times(1)
+
+	principal := &Principal{UserID: uuid.New(), SessionID: uuid.New(), AuthMethod: AuthMethodPassword}
+
+	next := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusOK)
+	})
+
+	req := httptest.NewRequest(http.MethodGet, "/", nil)
+	req = req.WithContext(WithPrincipal(req.Context(), principal))
+	rr := httptest.NewRecorder()
+	RequireActiveSession(sessionRepository)(next).ServeHTTP(rr, req)
+
+	assert.Equal(t, http.StatusInternalServerError, rr.Code)
+}
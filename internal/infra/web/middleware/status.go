@@ -0,0 +1,47 @@
+package middleware
+
+import (
+	"database/sql"
+	"encoding/json"
+	"net/http"
+
+	"github.com/sesaquecruz/go-auth-api/internal/entity"
+)
+
+// RequireActiveUser lets a request through only while the user in the "sub" claim exists and is active,
+// so tokens stop working as soon as their user is suspended or deleted.
+// It must run after Authenticator.
+func RequireActiveUser(userRepository entity.UserRepositoryInterface) func(http.Handler) http.Handler {
+	return func(next http.Handler) http.Handler {
+		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			principal, ok := PrincipalFromContext(r.Context())
+			if !ok {
+				writeMessage(w, http.StatusUnauthorized, "unauthorized")
+				return
+			}
+
+			user, err := userRepository.FindById(r.Context(), principal.UserID)
+			if err == sql.ErrNoRows {
+				writeMessage(w, http.StatusUnauthorized, "unauthorized")
+				return
+			}
+			if err != nil {
+				writeMessage(w, http.StatusInternalServerError, "internal error")
+				return
+			}
+
+			if err := user.Status.Err(); err != nil {
+				writeMessage(w, http.StatusForbidden, err.Error())
+				return
+			}
+
+			next.ServeHTTP(w, r)
+		})
+	}
+}
+
+func writeMessage(w http.ResponseWriter, status int, message string) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(status)
+	json.NewEncoder(w).Encode(messageDTO{Message: message})
+}
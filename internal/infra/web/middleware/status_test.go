@@ -0,0 +1,92 @@
+package middleware
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/sesaquecruz/go-auth-api/internal/entity"
+	"github.com/sesaquecruz/go-auth-api/internal/infra/database/memory"
+
+	"github.com/go-chi/jwtauth"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func Test_RequireActiveUser(t *testing.T) {
+	jwtAuth := jwtauth.New("HS256", []byte("secret"), nil)
+	next := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusOK)
+	})
+
+	ctx := context.Background()
+	userRepository := memory.NewUserRepository()
+	handler := jwtauth.Verifier(jwtAuth)(Authenticator(RequireActiveUser(userRepository)(next)))
+
+	newUser := func(email string, status entity.UserStatus) string {
+		user, err := entity.NewUserFactory().NewUser(email, "12345")
+		require.Nil(t, err)
+		user.Status = status
+		require.Nil(t, userRepository.Save(ctx, *user))
+		return user.ID.String()
+	}
+
+	deleted := newUser("deleted@mail.com", entity.UserStatusActive)
+	require.Nil(t, userRepository.Delete(ctx, uuid.MustParse(deleted), 1, entity.Now()))
+
+	tests := []struct {
+		name   string
+		sub    string
+		status int
+	}{
+		{"active", newUser("active@mail.com", entity.UserStatusActive), http.StatusOK},
+		{"suspended", newUser("suspended@mail.com", entity.UserStatusSuspended), http.StatusForbidden},
+		{"locked", newUser("locked@mail.com", entity.UserStatusLocked), http.StatusForbidden},
+		{"deleted", deleted, http.StatusUnauthorized},
+		{"unknown", uuid.NewString(), http.StatusUnauthorized},
+		{"invalid", "user", http.StatusUnauthorized},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, token, err := jwtAuth.Encode(map[string]interface{}{
+				"sub": tt.sub,
+				"exp": jwtauth.ExpireIn(time.Minute),
+			})
+			require.Nil(t, err)
+
+			req := httptest.NewRequest(http.MethodGet, "/", nil)
+			req.Header.Set("Authorization", "Bearer "+token)
+			rr := httptest.NewRecorder()
+			handler.ServeHTTP(rr, req)
+
+			assert.Equal(t, tt.status, rr.Code)
+		})
+	}
+}
+
+func Test_RequireActiveUser_WhenRepositoryFails(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	userRepository := entity.NewMockUserRepositoryInterface(ctrl)
+	userRepository.EXPECT().FindById(gomock.Any(), gomock.Any()).Return(nil, errors.New("")).Times(1)
+
+	principal := &Principal{UserID: uuid.New()}
+
+	next := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusOK)
+	})
+
+	req := httptest.NewRequest(http.MethodGet, "/", nil)
+	req = req.WithContext(WithPrincipal(req.Context(), principal))
+	rr := httptest.NewRecorder()
+	RequireActiveUser(userRepository)(next).ServeHTTP(rr, req)
+
+	assert.Equal(t, http.StatusInternalServerError, rr.Code)
+}
@@ -0,0 +1,64 @@
+package middleware
+
+import (
+	"database/sql"
+	"net"
+	"net/http"
+
+	"github.com/sesaquecruz/go-auth-api/internal/entity"
+)
+
+// TenantHeader names the tenant of a request by its slug.
+const TenantHeader = "X-Tenant"
+
+// ResolveTenant scopes the context of each request to its tenant: the one named by the X-Tenant header,
+// else the one registered for the request host, else the default tenant.
+func ResolveTenant(tenantRepository entity.TenantRepositoryInterface) func(http.Handler) http.Handler {
+	return func(next http.Handler) http.Handler {
+		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			var tenant *entity.Tenant
+			var err error
+			if slug := r.Header.Get(TenantHeader); slug != "" {
+				tenant, err = tenantRepository.FindBySlug(r.Context(), slug)
+				if err == sql.ErrNoRows {
+					writeMessage(w, http.StatusBadRequest, "unknown tenant")
+					return
+				}
+			} else {
+				tenant, err = tenantRepository.FindByHost(r.Context(), requestHost(r))
+				if err == sql.ErrNoRows {
+					tenant, err = &entity.Tenant{ID: entity.DefaultTenantID}, nil
+				}
+			}
+			if err != nil {
+				writeMessage(w, http.StatusInternalServerError, "internal error")
+				return
+			}
+
+			next.ServeHTTP(w, r.WithContext(entity.WithTenant(r.Context(), tenant.ID)))
+		})
+	}
+}
+
+// RequireTokenTenant lets a request through only when the "tid" claim of its token names the tenant the
+// request is scoped to, so tokens cannot be used across tenants. Tokens without the claim belong to the
+// default tenant. It must run after ResolveTenant and Authenticator.
+func RequireTokenTenant(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		principal, ok := PrincipalFromContext(r.Context())
+		if !ok || principal.TenantID != entity.TenantFromContext(r.Context()) {
+			writeMessage(w, http.StatusUnauthorized, "unauthorized")
+			return
+		}
+
+		next.ServeHTTP(w, r)
+	})
+}
+
+func requestHost(r *http.Request) string {
+	host, _, err := net.SplitHostPort(r.Host)
+	if err != nil {
+		return r.Host
+	}
+	return host
+}
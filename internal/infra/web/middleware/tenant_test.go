@@ -0,0 +1,125 @@
+package middleware
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/sesaquecruz/go-auth-api/internal/entity"
+	"github.com/sesaquecruz/go-auth-api/internal/infra/database/memory"
+
+	"github.com/go-chi/jwtauth"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func Test_ResolveTenant(t *testing.T) {
+	tenantRepository := memory.NewTenantRepository()
+	tenant, err := entity.NewTenant("acme", "Acme", "auth.acme.com")
+	require.Nil(t, err)
+	require.Nil(t, tenantRepository.Save(context.Background(), *tenant))
+
+	var resolved uuid.UUID
+	handler := ResolveTenant(tenantRepository)(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		resolved = entity.TenantFromContext(r.Context())
+		w.WriteHeader(http.StatusOK)
+	}))
+
+	tests := []struct {
+		name   string
+		host   string
+		header string
+		status int
+		tenant uuid.UUID
+	}{
+		{"header", "localhost:8080", "acme", http.StatusOK, tenant.ID},
+		{"host", "auth.acme.com:8080", "", http.StatusOK, tenant.ID},
+		{"default", "localhost", "", http.StatusOK, entity.DefaultTenantID},
+		{"header wins", "auth.acme.com", "default", http.StatusOK, entity.DefaultTenantID},
+		{"unknown header", "auth.acme.com", "globex", http.StatusBadRequest, uuid.Nil},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			resolved = uuid.Nil
+
+			req := httptest.NewRequest(http.MethodGet, "/", nil)
+			req.Host = tt.host
+			if tt.header != "" {
+				req.Header.Set(TenantHeader, tt.header)
+			}
+			rr := httptest.NewRecorder()
+			handler.ServeHTTP(rr, req)
+
+			assert.Equal(t, tt.status, rr.Code)
+			assert.Equal(t, tt.tenant, resolved)
+		})
+	}
+}
+
+func Test_ResolveTenant_WhenRepositoryFails(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	tenantRepository := entity.NewMockTenantRepositoryInterface(ctrl)
+	tenantRepository.EXPECT().FindByHost(gomock.Any(), gomock.Any()).Return(nil, errors.New("")).Times(1)
+
+	next := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusOK)
+	})
+
+	rr := httptest.NewRecorder()
+	ResolveTenant(tenantRepository)(next).ServeHTTP(rr, httptest.NewRequest(http.MethodGet, "/", nil))
+
+	assert.Equal(t, http.StatusInternalServerError, rr.Code)
+}
+
+func Test_RequireTokenTenant(t *testing.T) {
+	jwtAuth := jwtauth.New("HS256", []byte("secret"), nil)
+	next := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusOK)
+	})
+	handler := jwtauth.Verifier(jwtAuth)(Authenticator(RequireTokenTenant(next)))
+
+	tenantID := uuid.New()
+
+	tests := []struct {
+		name   string
+		tid    interface{}
+		tenant uuid.UUID
+		status int
+	}{
+		{"same tenant", tenantID.String(), tenantID, http.StatusOK},
+		{"other tenant", uuid.NewString(), tenantID, http.StatusUnauthorized},
+		{"no claim", nil, entity.DefaultTenantID, http.StatusOK},
+		{"no claim on other tenant", nil, tenantID, http.StatusUnauthorized},
+		{"invalid claim", "acme", tenantID, http.StatusUnauthorized},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			payload := map[string]interface{}{
+				"sub": uuid.NewString(),
+				"exp": jwtauth.ExpireIn(time.Minute),
+			}
+			if tt.tid != nil {
+				payload["tid"] = tt.tid
+			}
+			_, token, err := jwtAuth.Encode(payload)
+			require.Nil(t, err)
+
+			req := httptest.NewRequest(http.MethodGet, "/", nil)
+			req = req.WithContext(entity.WithTenant(req.Context(), tt.tenant))
+			req.Header.Set("Authorization", "Bearer "+token)
+			rr := httptest.NewRecorder()
+			handler.ServeHTTP(rr, req)
+
+			assert.Equal(t, tt.status, rr.Code)
+		})
+	}
+}
@@ -0,0 +1,39 @@
+package usecase
+
+import (
+	"context"
+	"testing"
+
+	"github.com/sesaquecruz/go-auth-api/internal/entity"
+	"github.com/sesaquecruz/go-auth-api/internal/infra/database/memory"
+
+	"github.com/stretchr/testify/require"
+)
+
+// apiKeyFixture is a user holding the support role, with the memory repositories it is saved in.
+type apiKeyFixture struct {
+	ctx              context.Context
+	userRepository   *memory.UserRepository
+	roleRepository   *memory.RoleRepository
+	apiKeyRepository *memory.APIKeyRepository
+	user             *entity.User
+}
+
+func newAPIKeyFixture(t *testing.T) *apiKeyFixture {
+	f := &apiKeyFixture{
+		ctx:              context.Background(),
+		userRepository:   memory.NewUserRepository(),
+		roleRepository:   memory.NewRoleRepository(),
+		apiKeyRepository: memory.NewAPIKeyRepository(),
+	}
+
+	var err error
+	f.user, err = entity.NewUserFactory().NewUser("user@mail.com", "12345")
+	require.Nil(t, err)
+	require.Nil(t, f.userRepository.Save(f.ctx, *f.user))
+
+	role := entity.Role{Name: "support", Permissions: []string{"users:read", "users:write"}}
+	require.Nil(t, f.roleRepository.Save(f.ctx, role))
+	require.Nil(t, f.roleRepository.AssignToUser(f.ctx, f.user.ID, role.Name))
+	return f
+}
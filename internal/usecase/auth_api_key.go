@@ -0,0 +1,88 @@
+package usecase
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+
+	"github.com/sesaquecruz/go-auth-api/internal/entity"
+)
+
+var (
+	ErrAuthAPIKeyUseCaseInvalidKey    = errors.New("invalid api key")
+	ErrAuthAPIKeyUseCaseInternalError = errors.New("internal error")
+)
+
+type AuthAPIKeyUseCaseInputDTO struct {
+	Key string `json:"key"`
+}
+
+type AuthAPIKeyUseCaseOutputDTO struct {
+	ID          string   `json:"id"`
+	TenantID    string   `json:"tenant_id"`
+	KeyID       string   `json:"key_id"`
+	Permissions []string `json:"permissions"`
+}
+
+// AuthAPIKeyUseCase authenticates the user of an active key. The key grants the permissions the user
+// still holds within its scopes, and no roles.
+type AuthAPIKeyUseCase struct {
+	UserRepository   entity.UserRepositoryInterface
+	RoleRepository   entity.RoleRepositoryInterface
+	APIKeyRepository entity.APIKeyRepositoryInterface
+}
+
+func NewAuthAPIKeyUseCase(ur entity.UserRepositoryInterface, rr entity.RoleRepositoryInterface, ar entity.APIKeyRepositoryInterface) *AuthAPIKeyUseCase {
+	return &AuthAPIKeyUseCase{
+		UserRepository:   ur,
+		RoleRepository:   rr,
+		APIKeyRepository: ar,
+	}
+}
+
+func (uc *AuthAPIKeyUseCase) Execute(ctx context.Context, input AuthAPIKeyUseCaseInputDTO) (*AuthAPIKeyUseCaseOutputDTO, error) {
+	prefix, secret, err := entity.ParseAPIKey(input.Key)
+	if err != nil {
+		return nil, ErrAuthAPIKeyUseCaseInvalidKey
+	}
+
+	key, err := uc.APIKeyRepository.FindByPrefix(ctx, prefix)
+	if err == sql.ErrNoRows {
+		return nil, ErrAuthAPIKeyUseCaseInvalidKey
+	}
+	if err != nil {
+		return nil, ErrAuthAPIKeyUseCaseInternalError
+	}
+
+	now := entity.Now()
+	if !key.Matches(secret) || !key.IsActive(now) {
+		return nil, ErrAuthAPIKeyUseCaseInvalidKey
+	}
+
+	user, err := uc.UserRepository.FindById(ctx, key.UserID)
+	if err == sql.ErrNoRows {
+		return nil, ErrAuthAPIKeyUseCaseInvalidKey
+	}
+	if err != nil {
+		return nil, ErrAuthAPIKeyUseCaseInternalError
+	}
+
+	roles, err := uc.RoleRepository.FindByUserId(ctx, user.ID)
+	if err != nil {
+		return nil, ErrAuthAPIKeyUseCaseInternalError
+	}
+
+	err = uc.APIKeyRepository.RecordUse(ctx, key.ID, now)
+	if err != nil {
+		return nil, ErrAuthAPIKeyUseCaseInternalError
+	}
+
+	output := &AuthAPIKeyUseCaseOutputDTO{
+		ID:          user.ID.String(),
+		TenantID:    user.TenantID.String(),
+		KeyID:       key.ID.String(),
+		Permissions: key.GrantedPermissions(entity.Permissions(roles)),
+	}
+
+	return output, nil
+}
@@ -0,0 +1,97 @@
+package usecase
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/sesaquecruz/go-auth-api/internal/entity"
+
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func Test_AuthAPIKeyUseCase_NewAuthAPIKeyUseCase(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	userRepository := entity.NewMockUserRepositoryInterface(ctrl)
+	roleRepository := entity.NewMockRoleRepositoryInterface(ctrl)
+	apiKeyRepository := entity.NewMockAPIKeyRepositoryInterface(ctrl)
+
+	authAPIKeyUseCase := NewAuthAPIKeyUseCase(userRepository, roleRepository, apiKeyRepository)
+	assert.NotNil(t, authAPIKeyUseCase)
+	assert.Equal(t, userRepository, authAPIKeyUseCase.UserRepository)
+	assert.Equal(t, roleRepository, authAPIKeyUseCase.RoleRepository)
+	assert.Equal(t, apiKeyRepository, authAPIKeyUseCase.APIKeyRepository)
+}
+
+func Test_AuthAPIKeyUseCase_Execute(t *testing.T) {
+	f := newAPIKeyFixture(t)
+	authAPIKeyUseCase := NewAuthAPIKeyUseCase(f.userRepository, f.roleRepository, f.apiKeyRepository)
+
+	key, plain, err := entity.NewAPIKey(f.user.ID, "ci", []string{"users:read", entity.PermissionUsersAdmin}, time.Hour)
+	require.Nil(t, err)
+	require.Nil(t, f.apiKeyRepository.Save(f.ctx, *key))
+
+	output, err := authAPIKeyUseCase.Execute(f.ctx, AuthAPIKeyUseCaseInputDTO{Key: plain})
+	require.Nil(t, err)
+	assert.Equal(t, f.user.ID.String(), output.ID)
+	assert.Equal(t, entity.DefaultTenantID.String(), output.TenantID)
+	assert.Equal(t, key.ID.String(), output.KeyID)
+	assert.Equal(t, []string{"users:read"}, output.Permissions)
+
+	stored, err := f.apiKeyRepository.FindByPrefix(f.ctx, key.Prefix)
+	require.Nil(t, err)
+	assert.NotNil(t, stored.LastUsedAt)
+}
+
+func Test_AuthAPIKeyUseCase_Execute_WhenKeyIsInvalid(t *testing.T) {
+	f := newAPIKeyFixture(t)
+	authAPIKeyUseCase := NewAuthAPIKeyUseCase(f.userRepository, f.roleRepository, f.apiKeyRepository)
+
+	active, plain, err := entity.NewAPIKey(f.user.ID, "active", nil, time.Hour)
+	require.Nil(t, err)
+	require.Nil(t, f.apiKeyRepository.Save(f.ctx, *active))
+
+	expired, expiredPlain, err := entity.NewAPIKey(f.user.ID, "expired", nil, -time.Second)
+	require.Nil(t, err)
+	require.Nil(t, f.apiKeyRepository.Save(f.ctx, *expired))
+
+	revoked, revokedPlain, err := entity.NewAPIKey(f.user.ID, "revoked", nil, time.Hour)
+	require.Nil(t, err)
+	require.Nil(t, f.apiKeyRepository.Save(f.ctx, *revoked))
+	require.Nil(t, f.apiKeyRepository.Revoke(f.ctx, revoked.ID, f.user.ID, entity.Now()))
+
+	other, err := entity.NewUserFactory().NewUser("other@mail.com", "12345")
+	require.Nil(t, err)
+	orphan, orphanPlain, err := entity.NewAPIKey(other.ID, "orphan", nil, time.Hour)
+	require.Nil(t, err)
+	require.Nil(t, f.apiKeyRepository.Save(f.ctx, *orphan))
+
+	for _, key := range []string{"", "invalid", plain + "x", expiredPlain, revokedPlain, orphanPlain} {
+		output, err := authAPIKeyUseCase.Execute(f.ctx, AuthAPIKeyUseCaseInputDTO{Key: key})
+		assert.Nil(t, output)
+		assert.ErrorIs(t, err, ErrAuthAPIKeyUseCaseInvalidKey, key)
+	}
+}
+
+func Test_AuthAPIKeyUseCase_Execute_WhenRolesCannotBeRead(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	f := newAPIKeyFixture(t)
+	roleRepository := entity.NewMockRoleRepositoryInterface(ctrl)
+	authAPIKeyUseCase := NewAuthAPIKeyUseCase(f.userRepository, roleRepository, f.apiKeyRepository)
+
+	key, plain, err := entity.NewAPIKey(f.user.ID, "ci", nil, time.Hour)
+	require.Nil(t, err)
+	require.Nil(t, f.apiKeyRepository.Save(f.ctx, *key))
+
+	roleRepository.EXPECT().FindByUserId(gomock.Any(), f.user.ID).Return(nil, errors.New("")).Times(1)
+
+	output, err := authAPIKeyUseCase.Execute(f.ctx, AuthAPIKeyUseCaseInputDTO{Key: plain})
+	assert.Nil(t, output)
+	assert.ErrorIs(t, err, ErrAuthAPIKeyUseCaseInternalError)
+}
@@ -4,34 +4,61 @@ import (
 	"context"
 	"database/sql"
 	"errors"
+	"time"
 
 	"github.com/sesaquecruz/go-auth-api/internal/entity"
 )
 
 var (
-	ErrAuthUserUseCaseInvalidData        = errors.New("invalid data")
-	ErrAuthUserUseCaseInternalError      = errors.New("internal error")
-	ErrAuthUserUseCaseInvalidCredentials = errors.New("invalid credentials")
+	ErrAuthUserUseCaseInvalidData                = errors.New("invalid data")
+	ErrAuthUserUseCaseInvalidScope               = errors.New("invalid scope")
+	ErrAuthUserUseCaseInternalError              = errors.New("internal error")
+	ErrAuthUserUseCaseInvalidCredentials         = errors.New("invalid credentials")
+	ErrAuthUserUseCaseAccountSuspended           = errors.New("account suspended")
+	ErrAuthUserUseCaseAccountLocked              = errors.New("account locked")
+	ErrAuthUserUseCaseAccountPendingVerification = errors.New("account pending verification")
 )
 
 type AuthUserUseCaseInputDTO struct {
-	Email    string `json:"email"`
-	Password string `json:"password"`
+	Email     string        `json:"email"`
+	Password  string        `json:"password"`
+	Scope     string        `json:"scope"`
+	IP        string        `json:"-"`
+	UserAgent string        `json:"-"`
+	TTL       time.Duration `json:"-"`
 }
 
 type AuthUserUseCaseOutputDTO struct {
-	ID string `json:"id"`
+	ID          string    `json:"id"`
+	TenantID    string    `json:"tenant_id"`
+	Roles       []string  `json:"roles"`
+	Permissions []string  `json:"permissions"`
+	Scopes      []string  `json:"scopes"`
+	SessionID   string    `json:"session_id"`
+	AuthTime    time.Time `json:"auth_time"`
+	ExpiresAt   time.Time `json:"expires_at"`
 }
 
+// AuthUserUseCase starts a session lasting the TTL of the input on every successful login. The login is
+// limited to the scopes of the input, or has every scope when it names none.
 type AuthUserUseCase struct {
-	UserFactory    entity.UserFactoryInterface
-	UserRepository entity.UserRepositoryInterface
+	UserFactory       entity.UserFactoryInterface
+	UserRepository    entity.UserRepositoryInterface
+	RoleRepository    entity.RoleRepositoryInterface
+	SessionRepository entity.SessionRepositoryInterface
 }
 
-func NewAuthUserUseCase(uf entity.UserFactoryInterface, ur entity.UserRepositoryInterface) *AuthUserUseCase {
+func NewAuthUserUseCase(
+	uf entity.UserFactoryInterface,
+	ur entity.UserRepositoryInterface,
+	rr entity.RoleRepositoryInterface,
+	sr entity.SessionRepositoryInterface,
+) *AuthUserUseCase {
 	return &AuthUserUseCase{
-		UserFactory:    uf,
-		UserRepository: ur,
+		UserFactory:       uf,
+		UserRepository:    ur,
+		RoleRepository:    rr,
+		SessionRepository: sr,
 	}
 }
 
@@ -41,9 +68,15 @@ func (uc *AuthUserUseCase) Execute(ctx context.Context, input AuthUserUseCaseInp
 		return nil, ErrAuthUserUseCaseInvalidData
 	}
 
+	scopes, err := entity.ParseScope(input.Scope)
+	if err != nil {
+		return nil, ErrAuthUserUseCaseInvalidScope
+	}
+
 	user, err := uc.UserRepository.FindByEmail(ctx, input.Email)
 	if err != nil {
 		if err == sql.ErrNoRows {
+			entity.SimulatePasswordVerification(input.Password)
 			return nil, ErrAuthUserUseCaseInvalidCredentials
 		}
 		return nil, ErrAuthUserUseCaseInternalError
@@ -54,8 +87,45 @@ func (uc *AuthUserUseCase) Execute(ctx context.Context, input AuthUserUseCaseInp
 		return nil, ErrAuthUserUseCaseInvalidCredentials
 	}
 
+	switch user.Status.Err() {
+	case nil:
+	case entity.ErrUserLocked:
+		return nil, ErrAuthUserUseCaseAccountLocked
+	case entity.ErrUserPendingVerification:
+		return nil, ErrAuthUserUseCaseAccountPendingVerification
+	default:
+		return nil, ErrAuthUserUseCaseAccountSuspended
+	}
+
+	roles, err := uc.RoleRepository.FindByUserId(ctx, user.ID)
+	if err != nil {
+		return nil, ErrAuthUserUseCaseInternalError
+	}
+
+	err = uc.UserRepository.RecordLogin(ctx, user.ID, entity.Now(), input.IP)
+	if err != nil {
+		return nil, ErrAuthUserUseCaseInternalError
+	}
+
+	session, err := entity.NewSession(user.ID, input.UserAgent, input.IP, input.TTL)
+	if err != nil {
+		return nil, ErrAuthUserUseCaseInternalError
+	}
+
+	err = uc.SessionRepository.Save(ctx, *session)
+	if err != nil {
+		return nil, ErrAuthUserUseCaseInternalError
+	}
+
 	output := &AuthUserUseCaseOutputDTO{
-		ID: user.ID.String(),
+		ID:          user.ID.String(),
+		TenantID:    user.TenantID.String(),
+		Roles:       entity.RoleNames(roles),
+		Permissions: entity.Permissions(roles),
+		Scopes:      scopes,
+		SessionID:   session.ID.String(),
+		AuthTime:    session.CreatedAt,
+		ExpiresAt:   session.ExpiresAt,
 	}
 
 	return output, nil
@@ -5,7 +5,9 @@ import (
 	"database/sql"
 	"errors"
 	"testing"
+	"time"
 
+	"github.com/google/uuid"
 	"github.com/sesaquecruz/go-auth-api/internal/entity"
 
 	"github.com/golang/mock/gomock"
@@ -20,10 +22,15 @@ func Test_AuthUserUseCase_NewAuthUserUseCase(t *testing.T) {
 	userFactory := entity.NewMockUserFactoryInterface(ctrl)
 	userRepository := entity.NewMockUserRepositoryInterface(ctrl)
 
-	authUserUseCase := NewAuthUserUseCase(userFactory, userRepository)
+	roleRepository := entity.NewMockRoleRepositoryInterface(ctrl)
+	sessionRepository := entity.NewMockSessionRepositoryInterface(ctrl)
+
+	authUserUseCase := NewAuthUserUseCase(userFactory, userRepository, roleRepository, sessionRepository)
 	assert.NotNil(t, authUserUseCase)
 	assert.Equal(t, userFactory, authUserUseCase.UserFactory)
 	assert.Equal(t, userRepository, authUserUseCase.UserRepository)
+	assert.Equal(t, roleRepository, authUserUseCase.RoleRepository)
+	assert.Equal(t, sessionRepository, authUserUseCase.SessionRepository)
 }
 
 func Test_AuthUserUseCase_Execute_WhenUserIsValid(t *testing.T) {
@@ -39,16 +46,101 @@ func Test_AuthUserUseCase_Execute_WhenUserIsValid(t *testing.T) {
 	ctx := context.Background()
 	user, err := entity.NewUserFactory().NewUser(email, password)
 	require.Nil(t, err)
+	user.TenantID = uuid.New()
 
-	input := AuthUserUseCaseInputDTO{Email: email, Password: password}
-	authUserUseCase := AuthUserUseCase{UserFactory: userFactory, UserRepository: userRepository}
+	roleRepository := entity.NewMockRoleRepositoryInterface(ctrl)
+	roles := []entity.Role{
+		{Name: "admin", Permissions: []string{entity.PermissionUsersAdmin}},
+		{Name: "support", Permissions: []string{"users:read"}},
+	}
+
+	sessionRepository := entity.NewMockSessionRepositoryInterface(ctrl)
+
+	input := AuthUserUseCaseInputDTO{Email: email, Password: password, IP: "127.0.0.1", UserAgent: "curl/8.0", TTL: time.Hour}
+	authUserUseCase := AuthUserUseCase{UserFactory: userFactory, UserRepository: userRepository, RoleRepository: roleRepository, SessionRepository: sessionRepository}
 
+	var session entity.Session
 	userFactory.EXPECT().NewUser(email, password).Return(user, nil).Times(1)
 	userRepository.EXPECT().FindByEmail(ctx, email).Return(user, nil).Times(1)
+	roleRepository.EXPECT().FindByUserId(ctx, user.ID).Return(roles, nil).Times(1)
+	userRepository.EXPECT().RecordLogin(ctx, user.ID, gomock.Any(), input.IP).Return(nil).Times(1)
+	sessionRepository.EXPECT().Save(ctx, gomock.Any()).DoAndReturn(func(ctx context.Context, s entity.Session) error {
+		session = s
+		return nil
+	}).Times(1)
 
 	output, err := authUserUseCase.Execute(ctx, input)
 	assert.Nil(t, err)
 	assert.Equal(t, output.ID, user.ID.String())
+	assert.Equal(t, output.TenantID, user.TenantID.String())
+	assert.Equal(t, []string{"admin", "support"}, output.Roles)
+	assert.Equal(t, []string{entity.PermissionUsersAdmin, "users:read"}, output.Permissions)
+	assert.Equal(t, []string{entity.ScopeProfileRead, entity.ScopeProfileWrite}, output.Scopes)
+	assert.Equal(t, session.ID.String(), output.SessionID)
+	assert.Equal(t, session.CreatedAt, output.AuthTime)
+	assert.Equal(t, session.ExpiresAt, output.ExpiresAt)
+	assert.Equal(t, user.ID, session.UserID)
+	assert.Equal(t, input.UserAgent, session.UserAgent)
+	assert.Equal(t, input.IP, session.IP)
+	assert.Equal(t, session.CreatedAt.Add(input.TTL), session.ExpiresAt)
+}
+
+func Test_AuthUserUseCase_Execute_WhenSessionCannotBeSaved(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	userFactory := entity.NewMockUserFactoryInterface(ctrl)
+	userRepository := entity.NewMockUserRepositoryInterface(ctrl)
+	roleRepository := entity.NewMockRoleRepositoryInterface(ctrl)
+	sessionRepository := entity.NewMockSessionRepositoryInterface(ctrl)
+
+	email := "user@mail.com"
+	password := "12345"
+
+	ctx := context.Background()
+	user, err := entity.NewUserFactory().NewUser(email, password)
+	require.Nil(t, err)
+
+	input := AuthUserUseCaseInputDTO{Email: email, Password: password, TTL: time.Hour}
+	authUserUseCase := AuthUserUseCase{UserFactory: userFactory, UserRepository: userRepository, RoleRepository: roleRepository, SessionRepository: sessionRepository}
+
+	userFactory.EXPECT().NewUser(email, password).Return(user, nil).Times(1)
+	userRepository.EXPECT().FindByEmail(ctx, email).Return(user, nil).Times(1)
+	roleRepository.EXPECT().FindByUserId(ctx, user.ID).Return(nil, nil).Times(1)
+	userRepository.EXPECT().RecordLogin(ctx, user.ID, gomock.Any(), gomock.Any()).Return(nil).Times(1)
+	sessionRepository.EXPECT().Save(ctx, gomock.Any()).Return(errors.New("")).Times(1)
+
+	output, err := authUserUseCase.Execute(ctx, input)
+	assert.Nil(t, output)
+	assert.ErrorIs(t, err, ErrAuthUserUseCaseInternalError)
+}
+
+func Test_AuthUserUseCase_Execute_WhenRolesCannotBeRead(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	userFactory := entity.NewMockUserFactoryInterface(ctrl)
+	userRepository := entity.NewMockUserRepositoryInterface(ctrl)
+	roleRepository := entity.NewMockRoleRepositoryInterface(ctrl)
+
+	email := "user@mail.com"
+	password := "12345"
+
+	ctx := context.Background()
+	user, err := entity.NewUserFactory().NewUser(email, password)
+	require.Nil(t, err)
+
+	input := AuthUserUseCaseInputDTO{Email: email, Password: password}
+	authUserUseCase := AuthUserUseCase{UserFactory: userFactory, UserRepository: userRepository, RoleRepository: roleRepository}
+
+	userFactory.EXPECT().NewUser(email, password).Return(user, nil).Times(1)
+	userRepository.EXPECT().FindByEmail(ctx, email).Return(user, nil).Times(1)
+	roleRepository.EXPECT().FindByUserId(ctx, user.ID).Return(nil, errors.New("")).Times(1)
+	userRepository.EXPECT().RecordLogin(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).Times(0)
+
+	output, err := authUserUseCase.Execute(ctx, input)
+	assert.Nil(t, output)
+	assert.ErrorIs(t, err, ErrAuthUserUseCaseInternalError)
 }
 
 func Test_AuthUserUseCase_Execute_WhenUserIsInvalid(t *testing.T) {
@@ -73,6 +165,31 @@ func Test_AuthUserUseCase_Execute_WhenUserIsInvalid(t *testing.T) {
 	assert.ErrorIs(t, err, ErrAuthUserUseCaseInvalidData)
 }
 
+func Test_AuthUserUseCase_Execute_WhenScopeIsInvalid(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	userFactory := entity.NewMockUserFactoryInterface(ctrl)
+	userRepository := entity.NewMockUserRepositoryInterface(ctrl)
+
+	email := "user@mail.com"
+	password := "12345"
+
+	ctx := context.Background()
+	user, err := entity.NewUserFactory().NewUser(email, password)
+	require.Nil(t, err)
+
+	input := AuthUserUseCaseInputDTO{Email: email, Password: password, Scope: "profile:read users:admin"}
+	authUserUseCase := AuthUserUseCase{UserFactory: userFactory, UserRepository: userRepository}
+
+	userFactory.EXPECT().NewUser(email, password).Return(user, nil).Times(1)
+	userRepository.EXPECT().FindByEmail(gomock.Any(), gomock.Any()).Times(0)
+
+	output, err := authUserUseCase.Execute(ctx, input)
+	assert.Nil(t, output)
+	assert.ErrorIs(t, err, ErrAuthUserUseCaseInvalidScope)
+}
+
 func Test_AuthUserUseCase_Execute_WhenUserEmailIsInvalid(t *testing.T) {
 	ctrl := gomock.NewController(t)
 	defer ctrl.Finish()
@@ -123,3 +240,41 @@ func Test_AuthUserUseCase_Execute_WhenUserPasswordIsInvalid(t *testing.T) {
 	assert.Nil(t, output)
 	assert.ErrorIs(t, err, ErrAuthUserUseCaseInvalidCredentials)
 }
+
+func Test_AuthUserUseCase_Execute_WhenUserIsNotActive(t *testing.T) {
+	tests := map[entity.UserStatus]error{
+		entity.UserStatusSuspended:           ErrAuthUserUseCaseAccountSuspended,
+		entity.UserStatusLocked:              ErrAuthUserUseCaseAccountLocked,
+		entity.UserStatusPendingVerification: ErrAuthUserUseCaseAccountPendingVerification,
+	}
+
+	for status, expected := range tests {
+		ctrl := gomock.NewController(t)
+
+		userFactory := entity.NewMockUserFactoryInterface(ctrl)
+		userRepository := entity.NewMockUserRepositoryInterface(ctrl)
+		roleRepository := entity.NewMockRoleRepositoryInterface(ctrl)
+
+		email := "user@mail.com"
+		password := "12345"
+
+		ctx := context.Background()
+		user, err := entity.NewUserFactory().NewUser(email, password)
+		require.Nil(t, err)
+		user.Status = status
+
+		input := AuthUserUseCaseInputDTO{Email: email, Password: password}
+		authUserUseCase := AuthUserUseCase{UserFactory: userFactory, UserRepository: userRepository, RoleRepository: roleRepository}
+
+		userFactory.EXPECT().NewUser(email, password).Return(user, nil).Times(1)
+		userRepository.EXPECT().FindByEmail(ctx, email).Return(user, nil).Times(1)
+		roleRepository.EXPECT().FindByUserId(gomock.Any(), gomock.Any()).Times(0)
+		userRepository.EXPECT().RecordLogin(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).Times(0)
+
+		output, err := authUserUseCase.Execute(ctx, input)
+		assert.Nil(t, output)
+		assert.ErrorIs(t, err, expected, status)
+
+		ctrl.Finish()
+	}
+}
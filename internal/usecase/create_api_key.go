@@ -0,0 +1,100 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/sesaquecruz/go-auth-api/internal/entity"
+)
+
+var (
+	ErrCreateAPIKeyInvalidData   = errors.New("invalid data")
+	ErrCreateAPIKeyInvalidScope  = errors.New("invalid scope")
+	ErrCreateAPIKeyInternalError = errors.New("internal error")
+)
+
+type CreateAPIKeyUseCaseInputDTO struct {
+	UserID           string   `json:"user_id"`
+	Name             string   `json:"name"`
+	Scopes           []string `json:"scopes"`
+	ExpiresInSeconds int64    `json:"expires_in_seconds"`
+}
+
+type CreateAPIKeyUseCaseOutputDTO struct {
+	ID        string    `json:"id"`
+	Name      string    `json:"name"`
+	Key       string    `json:"key"`
+	Prefix    string    `json:"prefix"`
+	Scopes    []string  `json:"scopes"`
+	ExpiresAt time.Time `json:"expires_at"`
+	CreatedAt time.Time `json:"created_at"`
+}
+
+// CreateAPIKeyUseCase creates a key limited to scopes among the permissions of the user. The key expires
+// after MaxExpiration unless asked to expire sooner, and its plain text is only ever returned here.
+type CreateAPIKeyUseCase struct {
+	RoleRepository   entity.RoleRepositoryInterface
+	APIKeyRepository entity.APIKeyRepositoryInterface
+	MaxExpiration    time.Duration
+}
+
+func NewCreateAPIKeyUseCase(rr entity.RoleRepositoryInterface, ar entity.APIKeyRepositoryInterface, maxExpiration time.Duration) *CreateAPIKeyUseCase {
+	return &CreateAPIKeyUseCase{
+		RoleRepository:   rr,
+		APIKeyRepository: ar,
+		MaxExpiration:    maxExpiration,
+	}
+}
+
+func (uc *CreateAPIKeyUseCase) Execute(ctx context.Context, input CreateAPIKeyUseCaseInputDTO) (*CreateAPIKeyUseCaseOutputDTO, error) {
+	userID, err := uuid.Parse(input.UserID)
+	if err != nil {
+		return nil, ErrCreateAPIKeyInvalidData
+	}
+
+	expiration := time.Duration(input.ExpiresInSeconds) * time.Second
+	if expiration == 0 {
+		expiration = uc.MaxExpiration
+	}
+	if expiration < 0 || expiration > uc.MaxExpiration {
+		return nil, ErrCreateAPIKeyInvalidData
+	}
+
+	key, plain, err := entity.NewAPIKey(userID, input.Name, input.Scopes, expiration)
+	if errors.Is(err, entity.ErrAPIKeyInvalidName) {
+		return nil, ErrCreateAPIKeyInvalidData
+	}
+	if errors.Is(err, entity.ErrAPIKeyInvalidScope) {
+		return nil, ErrCreateAPIKeyInvalidScope
+	}
+	if err != nil {
+		return nil, ErrCreateAPIKeyInternalError
+	}
+
+	roles, err := uc.RoleRepository.FindByUserId(ctx, userID)
+	if err != nil {
+		return nil, ErrCreateAPIKeyInternalError
+	}
+	if len(key.GrantedPermissions(entity.Permissions(roles))) != len(key.Scopes) {
+		return nil, ErrCreateAPIKeyInvalidScope
+	}
+
+	err = uc.APIKeyRepository.Save(ctx, *key)
+	if err != nil {
+		return nil, ErrCreateAPIKeyInternalError
+	}
+
+	output := &CreateAPIKeyUseCaseOutputDTO{
+		ID:        key.ID.String(),
+		Name:      key.Name,
+		Key:       plain,
+		Prefix:    key.Prefix,
+		Scopes:    key.Scopes,
+		ExpiresAt: key.ExpiresAt,
+		CreatedAt: key.CreatedAt,
+	}
+
+	return output, nil
+}
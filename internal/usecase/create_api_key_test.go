@@ -0,0 +1,102 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/sesaquecruz/go-auth-api/internal/entity"
+
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func Test_CreateAPIKeyUseCase_NewCreateAPIKeyUseCase(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	roleRepository := entity.NewMockRoleRepositoryInterface(ctrl)
+	apiKeyRepository := entity.NewMockAPIKeyRepositoryInterface(ctrl)
+
+	createAPIKeyUseCase := NewCreateAPIKeyUseCase(roleRepository, apiKeyRepository, time.Hour)
+	assert.NotNil(t, createAPIKeyUseCase)
+	assert.Equal(t, roleRepository, createAPIKeyUseCase.RoleRepository)
+	assert.Equal(t, apiKeyRepository, createAPIKeyUseCase.APIKeyRepository)
+	assert.Equal(t, time.Hour, createAPIKeyUseCase.MaxExpiration)
+}
+
+func Test_CreateAPIKeyUseCase_Execute(t *testing.T) {
+	f := newAPIKeyFixture(t)
+	createAPIKeyUseCase := NewCreateAPIKeyUseCase(f.roleRepository, f.apiKeyRepository, time.Hour)
+
+	output, err := createAPIKeyUseCase.Execute(f.ctx, CreateAPIKeyUseCaseInputDTO{
+		UserID:           f.user.ID.String(),
+		Name:             "ci",
+		Scopes:           []string{"users:read"},
+		ExpiresInSeconds: 60,
+	})
+	require.Nil(t, err)
+	assert.Equal(t, "ci", output.Name)
+	assert.Equal(t, []string{"users:read"}, output.Scopes)
+	assert.Equal(t, output.CreatedAt.Add(time.Minute), output.ExpiresAt)
+
+	key, err := f.apiKeyRepository.FindByPrefix(f.ctx, output.Prefix)
+	require.Nil(t, err)
+	assert.Equal(t, output.ID, key.ID.String())
+
+	prefix, secret, err := entity.ParseAPIKey(output.Key)
+	require.Nil(t, err)
+	assert.Equal(t, key.Prefix, prefix)
+	assert.True(t, key.Matches(secret))
+
+	output, err = createAPIKeyUseCase.Execute(f.ctx, CreateAPIKeyUseCaseInputDTO{UserID: f.user.ID.String(), Name: "cli"})
+	require.Nil(t, err)
+	assert.Empty(t, output.Scopes)
+	assert.Equal(t, output.CreatedAt.Add(time.Hour), output.ExpiresAt)
+}
+
+func Test_CreateAPIKeyUseCase_Execute_WhenDataIsInvalid(t *testing.T) {
+	f := newAPIKeyFixture(t)
+	createAPIKeyUseCase := NewCreateAPIKeyUseCase(f.roleRepository, f.apiKeyRepository, time.Hour)
+
+	tests := []struct {
+		input    CreateAPIKeyUseCaseInputDTO
+		expected error
+	}{
+		{CreateAPIKeyUseCaseInputDTO{UserID: "invalid", Name: "ci"}, ErrCreateAPIKeyInvalidData},
+		{CreateAPIKeyUseCaseInputDTO{UserID: f.user.ID.String(), Name: " "}, ErrCreateAPIKeyInvalidData},
+		{CreateAPIKeyUseCaseInputDTO{UserID: f.user.ID.String(), Name: "ci", ExpiresInSeconds: -1}, ErrCreateAPIKeyInvalidData},
+		{CreateAPIKeyUseCaseInputDTO{UserID: f.user.ID.String(), Name: "ci", ExpiresInSeconds: 3601}, ErrCreateAPIKeyInvalidData},
+		{CreateAPIKeyUseCaseInputDTO{UserID: f.user.ID.String(), Name: "ci", Scopes: []string{""}}, ErrCreateAPIKeyInvalidScope},
+		{CreateAPIKeyUseCaseInputDTO{UserID: f.user.ID.String(), Name: "ci", Scopes: []string{entity.PermissionUsersAdmin}}, ErrCreateAPIKeyInvalidScope},
+	}
+	for _, test := range tests {
+		output, err := createAPIKeyUseCase.Execute(f.ctx, test.input)
+		assert.Nil(t, output)
+		assert.ErrorIs(t, err, test.expected)
+	}
+
+	keys, err := f.apiKeyRepository.FindByUser(f.ctx, f.user.ID)
+	assert.Nil(t, err)
+	assert.Empty(t, keys)
+}
+
+func Test_CreateAPIKeyUseCase_Execute_WhenKeyCannotBeSaved(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	roleRepository := entity.NewMockRoleRepositoryInterface(ctrl)
+	apiKeyRepository := entity.NewMockAPIKeyRepositoryInterface(ctrl)
+	createAPIKeyUseCase := NewCreateAPIKeyUseCase(roleRepository, apiKeyRepository, time.Hour)
+
+	userID := uuid.New()
+	roleRepository.EXPECT().FindByUserId(gomock.Any(), userID).Return(nil, nil).Times(1)
+	apiKeyRepository.EXPECT().Save(gomock.Any(), gomock.Any()).Return(errors.New("")).Times(1)
+
+	output, err := createAPIKeyUseCase.Execute(context.Background(), CreateAPIKeyUseCaseInputDTO{UserID: userID.String(), Name: "ci"})
+	assert.Nil(t, output)
+	assert.ErrorIs(t, err, ErrCreateAPIKeyInternalError)
+}
@@ -0,0 +1,79 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/sesaquecruz/go-auth-api/internal/entity"
+)
+
+var (
+	ErrCreateOrganizationInvalidData   = errors.New("invalid data")
+	ErrCreateOrganizationInternalError = errors.New("internal error")
+)
+
+type CreateOrganizationUseCaseInputDTO struct {
+	UserID string `json:"user_id"`
+	Name   string `json:"name"`
+}
+
+type CreateOrganizationUseCaseOutputDTO struct {
+	ID        string    `json:"id"`
+	Name      string    `json:"name"`
+	CreatedAt time.Time `json:"created_at"`
+}
+
+// CreateOrganizationUseCase creates an organization in the tenant of ctx, owned by the user who creates it.
+type CreateOrganizationUseCase struct {
+	OrganizationRepository entity.OrganizationRepositoryInterface
+	TransactionManager     entity.TransactionManagerInterface
+}
+
+func NewCreateOrganizationUseCase(or entity.OrganizationRepositoryInterface, tm entity.TransactionManagerInterface) *CreateOrganizationUseCase {
+	return &CreateOrganizationUseCase{
+		OrganizationRepository: or,
+		TransactionManager:     tm,
+	}
+}
+
+func (uc *CreateOrganizationUseCase) Execute(ctx context.Context, input CreateOrganizationUseCaseInputDTO) (*CreateOrganizationUseCaseOutputDTO, error) {
+	userID, err := uuid.Parse(input.UserID)
+	if err != nil {
+		return nil, ErrCreateOrganizationInvalidData
+	}
+
+	organization, err := entity.NewOrganization(input.Name)
+	if errors.Is(err, entity.ErrOrganizationInvalidName) {
+		return nil, ErrCreateOrganizationInvalidData
+	}
+	if err != nil {
+		return nil, ErrCreateOrganizationInternalError
+	}
+
+	err = uc.TransactionManager.Do(ctx, func(ctx context.Context) error {
+		err := uc.OrganizationRepository.Save(ctx, *organization)
+		if err != nil {
+			return err
+		}
+
+		return uc.OrganizationRepository.AddMember(ctx, entity.Membership{
+			OrganizationID: organization.ID,
+			UserID:         userID,
+			Role:           entity.OrganizationRoleOwner,
+			CreatedAt:      organization.CreatedAt,
+		})
+	})
+	if err != nil {
+		return nil, ErrCreateOrganizationInternalError
+	}
+
+	output := &CreateOrganizationUseCaseOutputDTO{
+		ID:        organization.ID.String(),
+		Name:      organization.Name,
+		CreatedAt: organization.CreatedAt,
+	}
+
+	return output, nil
+}
@@ -0,0 +1,82 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/sesaquecruz/go-auth-api/internal/entity"
+	"github.com/sesaquecruz/go-auth-api/internal/infra/database/memory"
+
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func Test_CreateOrganizationUseCase_NewCreateOrganizationUseCase(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	organizationRepository := entity.NewMockOrganizationRepositoryInterface(ctrl)
+	transactionManager := entity.NewMockTransactionManagerInterface(ctrl)
+
+	createOrganizationUseCase := NewCreateOrganizationUseCase(organizationRepository, transactionManager)
+	assert.NotNil(t, createOrganizationUseCase)
+	assert.Equal(t, organizationRepository, createOrganizationUseCase.OrganizationRepository)
+	assert.Equal(t, transactionManager, createOrganizationUseCase.TransactionManager)
+}
+
+func Test_CreateOrganizationUseCase_Execute(t *testing.T) {
+	organizationRepository := memory.NewOrganizationRepository()
+	createOrganizationUseCase := NewCreateOrganizationUseCase(organizationRepository, memory.NewTransactionManager(organizationRepository))
+
+	ctx := context.Background()
+	userID := uuid.New()
+
+	output, err := createOrganizationUseCase.Execute(ctx, CreateOrganizationUseCaseInputDTO{UserID: userID.String(), Name: " Acme "})
+	require.Nil(t, err)
+	assert.Equal(t, "Acme", output.Name)
+
+	organization, err := organizationRepository.FindById(ctx, uuid.MustParse(output.ID))
+	require.Nil(t, err)
+	assert.Equal(t, entity.DefaultTenantID, organization.TenantID)
+
+	member, err := organizationRepository.FindMember(ctx, organization.ID, userID)
+	require.Nil(t, err)
+	assert.Equal(t, entity.OrganizationRoleOwner, member.Role)
+}
+
+func Test_CreateOrganizationUseCase_Execute_WhenDataIsInvalid(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	organizationRepository := entity.NewMockOrganizationRepositoryInterface(ctrl)
+	createOrganizationUseCase := NewCreateOrganizationUseCase(organizationRepository, newTransactionManager(ctrl))
+
+	ctx := context.Background()
+	for _, input := range []CreateOrganizationUseCaseInputDTO{
+		{UserID: "invalid", Name: "Acme"},
+		{UserID: uuid.NewString(), Name: " "},
+	} {
+		output, err := createOrganizationUseCase.Execute(ctx, input)
+		assert.Nil(t, output)
+		assert.ErrorIs(t, err, ErrCreateOrganizationInvalidData)
+	}
+}
+
+func Test_CreateOrganizationUseCase_Execute_WhenOwnerCannotBeAdded(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	organizationRepository := entity.NewMockOrganizationRepositoryInterface(ctrl)
+	createOrganizationUseCase := NewCreateOrganizationUseCase(organizationRepository, newTransactionManager(ctrl))
+
+	ctx := context.Background()
+	organizationRepository.EXPECT().Save(ctx, gomock.Any()).Return(nil).Times(1)
+	organizationRepository.EXPECT().AddMember(ctx, gomock.Any()).Return(errors.New("")).Times(1)
+
+	output, err := createOrganizationUseCase.Execute(ctx, CreateOrganizationUseCaseInputDTO{UserID: uuid.NewString(), Name: "Acme"})
+	assert.Nil(t, output)
+	assert.ErrorIs(t, err, ErrCreateOrganizationInternalError)
+}
@@ -3,6 +3,7 @@ package usecase
 import (
 	"context"
 	"errors"
+	"log"
 
 	"github.com/sesaquecruz/go-auth-api/internal/entity"
 )
@@ -13,38 +14,76 @@ var (
 	ErrCreateUserInternalError    = errors.New("internal error")
 )
 
+const (
+	createUserExistingEmailSubject = "Sign up attempt with your email"
+	createUserExistingEmailBody    = "Someone tried to create a new account using this email address. " +
+		"You already have an account, so no new account was created. " +
+		"If this was you, just log in. If you forgot your password, request a reset."
+)
+
 type CreateUserUseCaseInputDTO struct {
 	Email    string `json:"email"`
 	Password string `json:"password"`
 }
 
+// ID is empty when the email was already used and ConcealExistingEmail is set.
+type CreateUserUseCaseOutputDTO struct {
+	ID string `json:"id"`
+}
+
 type CreateUserUseCase struct {
-	UserFactory    entity.UserFactoryInterface
-	UserRepository entity.UserRepositoryInterface
+	UserFactory          entity.UserFactoryInterface
+	UserRepository       entity.UserRepositoryInterface
+	Mailer               entity.MailerInterface
+	ConcealExistingEmail bool
 }
 
-func NewCreateUserUseCase(uf entity.UserFactoryInterface, ur entity.UserRepositoryInterface) *CreateUserUseCase {
+func NewCreateUserUseCase(
+	uf entity.UserFactoryInterface,
+	ur entity.UserRepositoryInterface,
+	m entity.MailerInterface,
+	concealExistingEmail bool,
+) *CreateUserUseCase {
 	return &CreateUserUseCase{
-		UserFactory:    uf,
-		UserRepository: ur,
+		UserFactory:          uf,
+		UserRepository:       ur,
+		Mailer:               m,
+		ConcealExistingEmail: concealExistingEmail,
 	}
 }
 
-func (uc *CreateUserUseCase) Execute(ctx context.Context, input CreateUserUseCaseInputDTO) error {
+func (uc *CreateUserUseCase) Execute(ctx context.Context, input CreateUserUseCaseInputDTO) (*CreateUserUseCaseOutputDTO, error) {
 	user, err := uc.UserFactory.NewUser(input.Email, input.Password)
 	if err != nil {
-		return ErrCreateUserInvalidData
-	}
-
-	_, err = uc.UserRepository.FindByEmail(ctx, input.Email)
-	if err == nil {
-		return ErrCreateUserEmailAlreadyUsed
+		return nil, ErrCreateUserInvalidData
 	}
 
 	err = uc.UserRepository.Save(ctx, *user)
+	if errors.Is(err, entity.ErrUserEmailAlreadyExists) {
+		if uc.ConcealExistingEmail {
+			uc.notifyExistingOwner(user.Email)
+			return &CreateUserUseCaseOutputDTO{}, nil
+		}
+		return nil, ErrCreateUserEmailAlreadyUsed
+	}
 	if err != nil {
-		return ErrCreateUserInternalError
+		return nil, ErrCreateUserInternalError
+	}
+
+	output := &CreateUserUseCaseOutputDTO{
+		ID: user.ID.String(),
 	}
 
-	return nil
+	return output, nil
+}
+
+// notifyExistingOwner sends the email in background, so the response time
+// does not differ from the one of a successful sign up.
+func (uc *CreateUserUseCase) notifyExistingOwner(email string) {
+	go func() {
+		err := uc.Mailer.Send(context.Background(), email, createUserExistingEmailSubject, createUserExistingEmailBody)
+		if err != nil {
+			log.Printf("fail to notify existing account owner: %v\n", err)
+		}
+	}()
 }
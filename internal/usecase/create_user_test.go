@@ -2,10 +2,12 @@ package usecase
 
 import (
 	"context"
-	"database/sql"
+	"errors"
 	"testing"
+	"time"
 
 	"github.com/sesaquecruz/go-auth-api/internal/entity"
+	"github.com/sesaquecruz/go-auth-api/internal/infra/database/memory"
 
 	"github.com/golang/mock/gomock"
 	"github.com/google/uuid"
@@ -18,11 +20,14 @@ func Test_CreateUserUseCase_NewCreateUserUseCase(t *testing.T) {
 
 	userFactory := entity.NewMockUserFactoryInterface(ctrl)
 	userRepository := entity.NewMockUserRepositoryInterface(ctrl)
+	mailer := entity.NewMockMailerInterface(ctrl)
 
-	createUserUseCase := NewCreateUserUseCase(userFactory, userRepository)
+	createUserUseCase := NewCreateUserUseCase(userFactory, userRepository, mailer, true)
 	assert.NotNil(t, createUserUseCase)
 	assert.Equal(t, userFactory, createUserUseCase.UserFactory)
 	assert.Equal(t, userRepository, createUserUseCase.UserRepository)
+	assert.Equal(t, mailer, createUserUseCase.Mailer)
+	assert.True(t, createUserUseCase.ConcealExistingEmail)
 }
 
 func Test_CreateUserUseCase_Execute_WhenUserIsValid(t *testing.T) {
@@ -36,14 +41,14 @@ func Test_CreateUserUseCase_Execute_WhenUserIsValid(t *testing.T) {
 	ctx := context.Background()
 
 	userFactory.EXPECT().NewUser(user.Email, user.Password).Return(user, nil).Times(1)
-	userRepository.EXPECT().FindByEmail(ctx, user.Email).Return(nil, sql.ErrNoRows).Times(1)
 	userRepository.EXPECT().Save(ctx, *user).Return(nil).Times(1)
 
 	createUserUseCase := CreateUserUseCase{UserFactory: userFactory, UserRepository: userRepository}
 	input := CreateUserUseCaseInputDTO{Email: user.Email, Password: user.Password}
 
-	err := createUserUseCase.Execute(ctx, input)
+	output, err := createUserUseCase.Execute(ctx, input)
 	assert.Nil(t, err)
+	assert.Equal(t, user.ID.String(), output.ID)
 }
 
 func Test_CreateUserUseCase_Execute_WhenUserAlreadyExists(t *testing.T) {
@@ -57,12 +62,93 @@ func Test_CreateUserUseCase_Execute_WhenUserAlreadyExists(t *testing.T) {
 	ctx := context.Background()
 
 	userFactory.EXPECT().NewUser(user.Email, user.Password).Return(user, nil).Times(1)
-	userRepository.EXPECT().FindByEmail(ctx, user.Email).Return(user, nil).Times(1)
-	userRepository.EXPECT().Save(ctx, *user).Return(nil).Times(0)
+	userRepository.EXPECT().Save(ctx, *user).Return(entity.ErrUserEmailAlreadyExists).Times(1)
 
 	input := CreateUserUseCaseInputDTO{Email: user.Email, Password: user.Password}
 	createUserUseCase := CreateUserUseCase{UserFactory: userFactory, UserRepository: userRepository}
 
-	err := createUserUseCase.Execute(ctx, input)
+	_, err := createUserUseCase.Execute(ctx, input)
 	assert.ErrorIs(t, err, ErrCreateUserEmailAlreadyUsed)
 }
+
+func Test_CreateUserUseCase_Execute_WhenUserAlreadyExistsAndEmailIsConcealed(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	userFactory := entity.NewMockUserFactoryInterface(ctrl)
+	userRepository := entity.NewMockUserRepositoryInterface(ctrl)
+	mailer := entity.NewMockMailerInterface(ctrl)
+
+	user := &entity.User{ID: uuid.New(), Email: "user@mail.com", Password: "12345"}
+	ctx := context.Background()
+	sent := make(chan struct{})
+
+	userFactory.EXPECT().NewUser(user.Email, user.Password).Return(user, nil).Times(1)
+	userRepository.EXPECT().Save(ctx, *user).Return(entity.ErrUserEmailAlreadyExists).Times(1)
+	mailer.EXPECT().Send(gomock.Any(), user.Email, gomock.Any(), gomock.Any()).
+		DoAndReturn(func(context.Context, string, string, string) error {
+			close(sent)
+			return nil
+		}).Times(1)
+
+	input := CreateUserUseCaseInputDTO{Email: user.Email, Password: user.Password}
+	createUserUseCase := CreateUserUseCase{
+		UserFactory:          userFactory,
+		UserRepository:       userRepository,
+		Mailer:               mailer,
+		ConcealExistingEmail: true,
+	}
+
+	output, err := createUserUseCase.Execute(ctx, input)
+	assert.Nil(t, err)
+	assert.Empty(t, output.ID)
+
+	select {
+	case <-sent:
+	case <-time.After(time.Second):
+		t.Fatal("existing owner was not notified")
+	}
+}
+
+func Test_CreateUserUseCase_Execute_WithInMemoryRepository(t *testing.T) {
+	userRepository := memory.NewUserRepository()
+	createUserUseCase := NewCreateUserUseCase(entity.NewUserFactory(), userRepository, nil, false)
+
+	ctx := context.Background()
+	input := CreateUserUseCaseInputDTO{Email: "user@mail.com", Password: "12345"}
+
+	output, err := createUserUseCase.Execute(ctx, input)
+	assert.Nil(t, err)
+
+	user, err := userRepository.FindByEmail(ctx, input.Email)
+	assert.Nil(t, err)
+	assert.Equal(t, user.ID.String(), output.ID)
+	assert.Nil(t, user.VerifyPassword(input.Password))
+
+	_, err = createUserUseCase.Execute(ctx, input)
+	assert.ErrorIs(t, err, ErrCreateUserEmailAlreadyUsed)
+
+	input.Email = "USER@mail.com"
+	_, err = createUserUseCase.Execute(ctx, input)
+	assert.ErrorIs(t, err, ErrCreateUserEmailAlreadyUsed)
+}
+
+func Test_CreateUserUseCase_Execute_WhenRepositoryFails(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	userFactory := entity.NewMockUserFactoryInterface(ctrl)
+	userRepository := entity.NewMockUserRepositoryInterface(ctrl)
+
+	user := &entity.User{ID: uuid.New(), Email: "user@mail.com", Password: "12345"}
+	ctx := context.Background()
+
+	userFactory.EXPECT().NewUser(user.Email, user.Password).Return(user, nil).Times(1)
+	userRepository.EXPECT().Save(ctx, *user).Return(errors.New("")).Times(1)
+
+	input := CreateUserUseCaseInputDTO{Email: user.Email, Password: user.Password}
+	createUserUseCase := CreateUserUseCase{UserFactory: userFactory, UserRepository: userRepository}
+
+	_, err := createUserUseCase.Execute(ctx, input)
+	assert.ErrorIs(t, err, ErrCreateUserInternalError)
+}
@@ -9,21 +9,28 @@ import (
 )
 
 var (
-	ErrDeleteUserInvalidData   = errors.New("invalid data")
-	ErrDeleteUserUserNotExists = errors.New("user not exists")
-	ErrDeleteUserInternalError = errors.New("internal error")
+	ErrDeleteUserInvalidData     = errors.New("invalid data")
+	ErrDeleteUserUserNotExists   = errors.New("user not exists")
+	ErrDeleteUserVersionConflict = errors.New("version conflict")
+	ErrDeleteUserInternalError   = errors.New("internal error")
 )
 
+// Version is the one the caller last read. Zero means any version.
 type DeleteUserUseCaseInputDTO struct {
-	ID string `json:"id"`
+	ID      string `json:"id"`
+	Version int64  `json:"-"`
 }
 
 type DeleteUserUseCase struct {
-	UserRepository entity.UserRepositoryInterface
+	UserRepository     entity.UserRepositoryInterface
+	TransactionManager entity.TransactionManagerInterface
 }
 
-func NewDeleteUserUseCase(ur entity.UserRepositoryInterface) *DeleteUserUseCase {
-	return &DeleteUserUseCase{UserRepository: ur}
+func NewDeleteUserUseCase(ur entity.UserRepositoryInterface, tm entity.TransactionManagerInterface) *DeleteUserUseCase {
+	return &DeleteUserUseCase{
+		UserRepository:     ur,
+		TransactionManager: tm,
+	}
 }
 
 func (uc *DeleteUserUseCase) Execute(ctx context.Context, input DeleteUserUseCaseInputDTO) error {
@@ -32,12 +39,31 @@ func (uc *DeleteUserUseCase) Execute(ctx context.Context, input DeleteUserUseCas
 		return ErrDeleteUserInvalidData
 	}
 
-	_, err = uc.UserRepository.FindById(ctx, id)
+	err = uc.TransactionManager.Do(ctx, func(ctx context.Context) error {
+		return uc.delete(ctx, id, input.Version)
+	})
+	switch err {
+	case nil, ErrDeleteUserUserNotExists, ErrDeleteUserVersionConflict, ErrDeleteUserInternalError:
+		return err
+	default:
+		return ErrDeleteUserInternalError
+	}
+}
+
+func (uc *DeleteUserUseCase) delete(ctx context.Context, id uuid.UUID, version int64) error {
+	user, err := uc.UserRepository.FindById(ctx, id)
 	if err != nil {
 		return ErrDeleteUserUserNotExists
 	}
 
-	err = uc.UserRepository.Delete(ctx, id)
+	if version != 0 && version != user.Version {
+		return ErrDeleteUserVersionConflict
+	}
+
+	err = uc.UserRepository.Delete(ctx, id, user.Version, entity.Now())
+	if errors.Is(err, entity.ErrUserVersionConflict) {
+		return ErrDeleteUserVersionConflict
+	}
 	if err != nil {
 		return ErrDeleteUserInternalError
 	}
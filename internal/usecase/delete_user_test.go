@@ -17,9 +17,11 @@ func Test_DeleteUserUseCase_NewDeleteUserUseCase(t *testing.T) {
 	defer ctrl.Finish()
 
 	userRepository := entity.NewMockUserRepositoryInterface(ctrl)
-	deleteUserUseCase := NewDeleteUserUseCase(userRepository)
+	transactionManager := entity.NewMockTransactionManagerInterface(ctrl)
+	deleteUserUseCase := NewDeleteUserUseCase(userRepository, transactionManager)
 	assert.NotNil(t, deleteUserUseCase)
 	assert.Equal(t, userRepository, deleteUserUseCase.UserRepository)
+	assert.Equal(t, transactionManager, deleteUserUseCase.TransactionManager)
 }
 
 func Test_DeleteUserUseCase_Execute_WhenUserExists(t *testing.T) {
@@ -27,33 +29,54 @@ func Test_DeleteUserUseCase_Execute_WhenUserExists(t *testing.T) {
 	defer ctrl.Finish()
 
 	userRepository := entity.NewMockUserRepositoryInterface(ctrl)
-	deleteUserUseCase := NewDeleteUserUseCase(userRepository)
+	deleteUserUseCase := NewDeleteUserUseCase(userRepository, newTransactionManager(ctrl))
 
 	ctx := context.Background()
 	userId := uuid.New()
-	user := &entity.User{ID: userId}
+	user := &entity.User{ID: userId, Version: 2}
 
 	userRepository.EXPECT().FindById(ctx, userId).Return(user, nil).Times(1)
-	userRepository.EXPECT().Delete(ctx, userId).Return(nil).Times(1)
+	userRepository.EXPECT().Delete(ctx, userId, user.Version, gomock.Any()).Return(nil).Times(1)
 
-	input := DeleteUserUseCaseInputDTO{ID: userId.String()}
+	input := DeleteUserUseCaseInputDTO{ID: userId.String(), Version: user.Version}
 
 	err := deleteUserUseCase.Execute(ctx, input)
 	assert.Nil(t, err)
 }
 
+func Test_DeleteUserUseCase_Execute_WhenVersionIsStale(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	userRepository := entity.NewMockUserRepositoryInterface(ctrl)
+	deleteUserUseCase := NewDeleteUserUseCase(userRepository, newTransactionManager(ctrl))
+
+	ctx := context.Background()
+	userId := uuid.New()
+	user := &entity.User{ID: userId, Version: 2}
+
+	userRepository.EXPECT().FindById(ctx, userId).Return(user, nil).Times(2)
+	userRepository.EXPECT().Delete(ctx, userId, user.Version, gomock.Any()).Return(entity.ErrUserVersionConflict).Times(1)
+
+	err := deleteUserUseCase.Execute(ctx, DeleteUserUseCaseInputDTO{ID: userId.String(), Version: 1})
+	assert.ErrorIs(t, err, ErrDeleteUserVersionConflict)
+
+	err = deleteUserUseCase.Execute(ctx, DeleteUserUseCaseInputDTO{ID: userId.String(), Version: 2})
+	assert.ErrorIs(t, err, ErrDeleteUserVersionConflict)
+}
+
 func Test_DeleteUserUseCase_Execute_WhenUserNotExists(t *testing.T) {
 	ctrl := gomock.NewController(t)
 	defer ctrl.Finish()
 
 	userRepository := entity.NewMockUserRepositoryInterface(ctrl)
-	deleteUserUseCase := NewDeleteUserUseCase(userRepository)
+	deleteUserUseCase := NewDeleteUserUseCase(userRepository, newTransactionManager(ctrl))
 
 	ctx := context.Background()
 	userId := uuid.New()
 
 	userRepository.EXPECT().FindById(ctx, userId).Return(nil, sql.ErrNoRows).Times(1)
-	userRepository.EXPECT().Delete(ctx, userId).Return(nil).Times(0)
+	userRepository.EXPECT().Delete(ctx, userId, gomock.Any(), gomock.Any()).Return(nil).Times(0)
 
 	input := DeleteUserUseCaseInputDTO{ID: userId.String()}
 
@@ -66,13 +89,13 @@ func Test_DeleteUserUseCase_Execute_WhenUserIsInvalid(t *testing.T) {
 	defer ctrl.Finish()
 
 	userRepository := entity.NewMockUserRepositoryInterface(ctrl)
-	deleteUserUseCase := NewDeleteUserUseCase(userRepository)
+	deleteUserUseCase := NewDeleteUserUseCase(userRepository, newTransactionManager(ctrl))
 
 	ctx := context.Background()
 	userId := uuid.New()
 
 	userRepository.EXPECT().FindById(ctx, userId).Return(nil, sql.ErrNoRows).Times(0)
-	userRepository.EXPECT().Delete(ctx, userId).Return(nil).Times(0)
+	userRepository.EXPECT().Delete(ctx, userId, gomock.Any(), gomock.Any()).Return(nil).Times(0)
 
 	input := DeleteUserUseCaseInputDTO{ID: "fiifsiuofef"}
 
@@ -4,6 +4,7 @@ import (
 	"context"
 	"database/sql"
 	"errors"
+	"time"
 
 	"github.com/google/uuid"
 	"github.com/sesaquecruz/go-auth-api/internal/entity"
@@ -20,7 +21,15 @@ type FindUserUseCaseInputDTO struct {
 }
 
 type FindUserUseCaseOutputDTO struct {
-	Email string `json:"email"`
+	ID                string     `json:"id"`
+	Email             string     `json:"email"`
+	Status            string     `json:"status"`
+	CreatedAt         time.Time  `json:"created_at"`
+	UpdatedAt         time.Time  `json:"updated_at"`
+	PasswordChangedAt time.Time  `json:"password_changed_at"`
+	LastLoginAt       *time.Time `json:"last_login_at"`
+	LastLoginIP       string     `json:"last_login_ip"`
+	Version           int64      `json:"-"`
 }
 
 type FindUserUseCase struct {
@@ -46,9 +55,19 @@ func (uc *FindUserUseCase) Execute(ctx context.Context, input FindUserUseCaseInp
 		return nil, ErrFindUserInternalError
 	}
 
-	output := &FindUserUseCaseOutputDTO{
-		Email: user.Email,
-	}
+	return newFindUserUseCaseOutputDTO(user), nil
+}
 
-	return output, nil
+func newFindUserUseCaseOutputDTO(user *entity.User) *FindUserUseCaseOutputDTO {
+	return &FindUserUseCaseOutputDTO{
+		ID:                user.ID.String(),
+		Email:             user.Email,
+		Status:            string(user.Status),
+		CreatedAt:         user.CreatedAt,
+		UpdatedAt:         user.UpdatedAt,
+		PasswordChangedAt: user.PasswordChangedAt,
+		LastLoginAt:       user.LastLoginAt,
+		LastLoginIP:       user.LastLoginIP,
+		Version:           user.Version,
+	}
 }
@@ -30,7 +30,17 @@ func Test_FindUserUseCase_Execute_WhenUserExist(t *testing.T) {
 
 	ctx := context.Background()
 	userId := uuid.New()
-	user := &entity.User{ID: userId, Email: "user@mail.com"}
+	now := entity.Now()
+	user := &entity.User{
+		ID:                userId,
+		Email:             "user@mail.com",
+		CreatedAt:         now,
+		UpdatedAt:         now,
+		PasswordChangedAt: now,
+		LastLoginAt:       &now,
+		LastLoginIP:       "127.0.0.1",
+		Status:            entity.UserStatusActive,
+	}
 
 	userRepository.EXPECT().FindById(ctx, userId).Return(user, nil).Times(1)
 
@@ -38,7 +48,14 @@ func Test_FindUserUseCase_Execute_WhenUserExist(t *testing.T) {
 
 	output, err := findUserUseCase.Execute(ctx, input)
 	assert.Nil(t, err)
+	assert.Equal(t, userId.String(), output.ID)
 	assert.Equal(t, user.Email, output.Email)
+	assert.Equal(t, "active", output.Status)
+	assert.Equal(t, user.CreatedAt, output.CreatedAt)
+	assert.Equal(t, user.UpdatedAt, output.UpdatedAt)
+	assert.Equal(t, user.PasswordChangedAt, output.PasswordChangedAt)
+	assert.Equal(t, user.LastLoginAt, output.LastLoginAt)
+	assert.Equal(t, user.LastLoginIP, output.LastLoginIP)
 }
 
 func Test_FindUserUseCase_Execute_WhenUserNotExists(t *testing.T) {
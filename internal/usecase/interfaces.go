@@ -3,13 +3,17 @@ package usecase
 import "context"
 
 type CreateUserUseCaseInterface interface {
-	Execute(ctx context.Context, input CreateUserUseCaseInputDTO) error
+	Execute(ctx context.Context, input CreateUserUseCaseInputDTO) (*CreateUserUseCaseOutputDTO, error)
 }
 
 type AuthUserUseCaseInterface interface {
 	Execute(ctx context.Context, input AuthUserUseCaseInputDTO) (*AuthUserUseCaseOutputDTO, error)
 }
 
+type ReauthUserUseCaseInterface interface {
+	Execute(ctx context.Context, input ReauthUserUseCaseInputDTO) (*ReauthUserUseCaseOutputDTO, error)
+}
+
 type UpdateUserUseCaseInterface interface {
 	Execute(ctx context.Context, input UpdateUserUseCaseInputDTO) (*UpdateUserUseCaseOutputDTO, error)
 }
@@ -21,3 +25,75 @@ type DeleteUserUseCaseInterface interface {
 type FindUserUseCaseInterface interface {
 	Execute(ctx context.Context, input FindUserUseCaseInputDTO) (*FindUserUseCaseOutputDTO, error)
 }
+
+type RestoreUserUseCaseInterface interface {
+	Execute(ctx context.Context, input RestoreUserUseCaseInputDTO) (*RestoreUserUseCaseOutputDTO, error)
+}
+
+type PurgeUsersUseCaseInterface interface {
+	Execute(ctx context.Context) (*PurgeUsersUseCaseOutputDTO, error)
+}
+
+type ListUsersUseCaseInterface interface {
+	Execute(ctx context.Context, input ListUsersUseCaseInputDTO) (*ListUsersUseCaseOutputDTO, error)
+}
+
+type SetUserStatusUseCaseInterface interface {
+	Execute(ctx context.Context, input SetUserStatusUseCaseInputDTO) error
+}
+
+type ResetUserPasswordUseCaseInterface interface {
+	Execute(ctx context.Context, input ResetUserPasswordUseCaseInputDTO) error
+}
+
+type CreateOrganizationUseCaseInterface interface {
+	Execute(ctx context.Context, input CreateOrganizationUseCaseInputDTO) (*CreateOrganizationUseCaseOutputDTO, error)
+}
+
+type InviteMemberUseCaseInterface interface {
+	Execute(ctx context.Context, input InviteMemberUseCaseInputDTO) (*InviteMemberUseCaseOutputDTO, error)
+}
+
+type RespondInvitationUseCaseInterface interface {
+	Execute(ctx context.Context, input RespondInvitationUseCaseInputDTO) (*RespondInvitationUseCaseOutputDTO, error)
+}
+
+type ListMembersUseCaseInterface interface {
+	Execute(ctx context.Context, input ListMembersUseCaseInputDTO) (*ListMembersUseCaseOutputDTO, error)
+}
+
+type SetMemberRoleUseCaseInterface interface {
+	Execute(ctx context.Context, input SetMemberRoleUseCaseInputDTO) error
+}
+
+type RemoveMemberUseCaseInterface interface {
+	Execute(ctx context.Context, input RemoveMemberUseCaseInputDTO) error
+}
+
+type SwitchOrganizationUseCaseInterface interface {
+	Execute(ctx context.Context, input SwitchOrganizationUseCaseInputDTO) (*SwitchOrganizationUseCaseOutputDTO, error)
+}
+
+type CreateAPIKeyUseCaseInterface interface {
+	Execute(ctx context.Context, input CreateAPIKeyUseCaseInputDTO) (*CreateAPIKeyUseCaseOutputDTO, error)
+}
+
+type ListAPIKeysUseCaseInterface interface {
+	Execute(ctx context.Context, input ListAPIKeysUseCaseInputDTO) (*ListAPIKeysUseCaseOutputDTO, error)
+}
+
+type RevokeAPIKeyUseCaseInterface interface {
+	Execute(ctx context.Context, input RevokeAPIKeyUseCaseInputDTO) error
+}
+
+type AuthAPIKeyUseCaseInterface interface {
+	Execute(ctx context.Context, input AuthAPIKeyUseCaseInputDTO) (*AuthAPIKeyUseCaseOutputDTO, error)
+}
+
+type ListSessionsUseCaseInterface interface {
+	Execute(ctx context.Context, input ListSessionsUseCaseInputDTO) (*ListSessionsUseCaseOutputDTO, error)
+}
+
+type RevokeSessionUseCaseInterface interface {
+	Execute(ctx context.Context, input RevokeSessionUseCaseInputDTO) error
+}
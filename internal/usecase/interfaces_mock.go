@@ -35,11 +35,12 @@ func (m *MockCreateUserUseCaseInterface) EXPECT() *MockCreateUserUseCaseInterfac
 }
 
 // Execute mocks base method.
-func (m *MockCreateUserUseCaseInterface) Execute(ctx context.Context, input CreateUserUseCaseInputDTO) error {
+func (m *MockCreateUserUseCaseInterface) Execute(ctx context.Context, input CreateUserUseCaseInputDTO) (*CreateUserUseCaseOutputDTO, error) {
 	m.ctrl.T.Helper()
 	ret := m.ctrl.Call(m, "Execute", ctx, input)
-	ret0, _ := ret[0].(error)
-	return ret0
+	ret0, _ := ret[0].(*CreateUserUseCaseOutputDTO)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
 }
 
 // Execute indicates an expected call of Execute.
@@ -86,6 +87,44 @@ func (mr *MockAuthUserUseCaseInterfaceMockRecorder) Execute(ctx, input interface
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Execute", reflect.TypeOf((*MockAuthUserUseCaseInterface)(nil).Execute), ctx, input)
 }
 
+// MockReauthUserUseCaseInterface is a mock of ReauthUserUseCaseInterface interface.
+type MockReauthUserUseCaseInterface struct {
+	ctrl     *gomock.Controller
+	recorder *MockReauthUserUseCaseInterfaceMockRecorder
+}
+
+// MockReauthUserUseCaseInterfaceMockRecorder is the mock recorder for MockReauthUserUseCaseInterface.
+type MockReauthUserUseCaseInterfaceMockRecorder struct {
+	mock *MockReauthUserUseCaseInterface
+}
+
+// NewMockReauthUserUseCaseInterface creates a new mock instance.
+func NewMockReauthUserUseCaseInterface(ctrl *gomock.Controller) *MockReauthUserUseCaseInterface {
+	mock := &MockReauthUserUseCaseInterface{ctrl: ctrl}
+	mock.recorder = &MockReauthUserUseCaseInterfaceMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockReauthUserUseCaseInterface) EXPECT() *MockReauthUserUseCaseInterfaceMockRecorder {
+	return m.recorder
+}
+
+// Execute mocks base method.
+func (m *MockReauthUserUseCaseInterface) Execute(ctx context.Context, input ReauthUserUseCaseInputDTO) (*ReauthUserUseCaseOutputDTO, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Execute", ctx, input)
+	ret0, _ := ret[0].(*ReauthUserUseCaseOutputDTO)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Execute indicates an expected call of Execute.
+func (mr *MockReauthUserUseCaseInterfaceMockRecorder) Execute(ctx, input interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Execute", reflect.TypeOf((*MockReauthUserUseCaseInterface)(nil).Execute), ctx, input)
+}
+
 // MockUpdateUserUseCaseInterface is a mock of UpdateUserUseCaseInterface interface.
 type MockUpdateUserUseCaseInterface struct {
 	ctrl     *gomock.Controller
@@ -198,3 +237,681 @@ func (mr *MockFindUserUseCaseInterfaceMockRecorder) Execute(ctx, input interface
 	mr.mock.ctrl.T.Helper()
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Execute", reflect.TypeOf((*MockFindUserUseCaseInterface)(nil).Execute), ctx, input)
 }
+
+// MockRestoreUserUseCaseInterface is a mock of RestoreUserUseCaseInterface interface.
+type MockRestoreUserUseCaseInterface struct {
+	ctrl     *gomock.Controller
+	recorder *MockRestoreUserUseCaseInterfaceMockRecorder
+}
+
+// MockRestoreUserUseCaseInterfaceMockRecorder is the mock recorder for MockRestoreUserUseCaseInterface.
+type MockRestoreUserUseCaseInterfaceMockRecorder struct {
+	mock *MockRestoreUserUseCaseInterface
+}
+
+// NewMockRestoreUserUseCaseInterface creates a new mock instance.
+func NewMockRestoreUserUseCaseInterface(ctrl *gomock.Controller) *MockRestoreUserUseCaseInterface {
+	mock := &MockRestoreUserUseCaseInterface{ctrl: ctrl}
+	mock.recorder = &MockRestoreUserUseCaseInterfaceMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockRestoreUserUseCaseInterface) EXPECT() *MockRestoreUserUseCaseInterfaceMockRecorder {
+	return m.recorder
+}
+
+// Execute mocks base method.
+func (m *MockRestoreUserUseCaseInterface) Execute(ctx context.Context, input RestoreUserUseCaseInputDTO) (*RestoreUserUseCaseOutputDTO, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Execute", ctx, input)
+	ret0, _ := ret[0].(*RestoreUserUseCaseOutputDTO)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Execute indicates an expected call of Execute.
+func (mr *MockRestoreUserUseCaseInterfaceMockRecorder) Execute(ctx, input interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Execute", reflect.TypeOf((*MockRestoreUserUseCaseInterface)(nil).Execute), ctx, input)
+}
+
+// MockPurgeUsersUseCaseInterface is a mock of PurgeUsersUseCaseInterface interface.
+type MockPurgeUsersUseCaseInterface struct {
+	ctrl     *gomock.Controller
+	recorder *MockPurgeUsersUseCaseInterfaceMockRecorder
+}
+
+// MockPurgeUsersUseCaseInterfaceMockRecorder is the mock recorder for MockPurgeUsersUseCaseInterface.
+type MockPurgeUsersUseCaseInterfaceMockRecorder struct {
+	mock *MockPurgeUsersUseCaseInterface
+}
+
+// NewMockPurgeUsersUseCaseInterface creates a new mock instance.
+func NewMockPurgeUsersUseCaseInterface(ctrl *gomock.Controller) *MockPurgeUsersUseCaseInterface {
+	mock := &MockPurgeUsersUseCaseInterface{ctrl: ctrl}
+	mock.recorder = &MockPurgeUsersUseCaseInterfaceMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockPurgeUsersUseCaseInterface) EXPECT() *MockPurgeUsersUseCaseInterfaceMockRecorder {
+	return m.recorder
+}
+
+// Execute mocks base method.
+func (m *MockPurgeUsersUseCaseInterface) Execute(ctx context.Context) (*PurgeUsersUseCaseOutputDTO, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Execute", ctx)
+	ret0, _ := ret[0].(*PurgeUsersUseCaseOutputDTO)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Execute indicates an expected call of Execute.
+func (mr *MockPurgeUsersUseCaseInterfaceMockRecorder) Execute(ctx interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Execute", reflect.TypeOf((*MockPurgeUsersUseCaseInterface)(nil).Execute), ctx)
+}
+
+// MockListUsersUseCaseInterface is a mock of ListUsersUseCaseInterface interface.
+type MockListUsersUseCaseInterface struct {
+	ctrl     *gomock.Controller
+	recorder *MockListUsersUseCaseInterfaceMockRecorder
+}
+
+// MockListUsersUseCaseInterfaceMockRecorder is the mock recorder for MockListUsersUseCaseInterface.
+type MockListUsersUseCaseInterfaceMockRecorder struct {
+	mock *MockListUsersUseCaseInterface
+}
+
+// NewMockListUsersUseCaseInterface creates a new mock instance.
+func NewMockListUsersUseCaseInterface(ctrl *gomock.Controller) *MockListUsersUseCaseInterface {
+	mock := &MockListUsersUseCaseInterface{ctrl: ctrl}
+	mock.recorder = &MockListUsersUseCaseInterfaceMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockListUsersUseCaseInterface) EXPECT() *MockListUsersUseCaseInterfaceMockRecorder {
+	return m.recorder
+}
+
+// Execute mocks base method.
+func (m *MockListUsersUseCaseInterface) Execute(ctx context.Context, input ListUsersUseCaseInputDTO) (*ListUsersUseCaseOutputDTO, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Execute", ctx, input)
+	ret0, _ := ret[0].(*ListUsersUseCaseOutputDTO)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Execute indicates an expected call of Execute.
+func (mr *MockListUsersUseCaseInterfaceMockRecorder) Execute(ctx, input interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Execute", reflect.TypeOf((*MockListUsersUseCaseInterface)(nil).Execute), ctx, input)
+}
+
+// MockSetUserStatusUseCaseInterface is a mock of SetUserStatusUseCaseInterface interface.
+type MockSetUserStatusUseCaseInterface struct {
+	ctrl     *gomock.Controller
+	recorder *MockSetUserStatusUseCaseInterfaceMockRecorder
+}
+
+// MockSetUserStatusUseCaseInterfaceMockRecorder is the mock recorder for MockSetUserStatusUseCaseInterface.
+type MockSetUserStatusUseCaseInterfaceMockRecorder struct {
+	mock *MockSetUserStatusUseCaseInterface
+}
+
+// NewMockSetUserStatusUseCaseInterface creates a new mock instance.
+func NewMockSetUserStatusUseCaseInterface(ctrl *gomock.Controller) *MockSetUserStatusUseCaseInterface {
+	mock := &MockSetUserStatusUseCaseInterface{ctrl: ctrl}
+	mock.recorder = &MockSetUserStatusUseCaseInterfaceMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockSetUserStatusUseCaseInterface) EXPECT() *MockSetUserStatusUseCaseInterfaceMockRecorder {
+	return m.recorder
+}
+
+// Execute mocks base method.
+func (m *MockSetUserStatusUseCaseInterface) Execute(ctx context.Context, input SetUserStatusUseCaseInputDTO) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Execute", ctx, input)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Execute indicates an expected call of Execute.
+func (mr *MockSetUserStatusUseCaseInterfaceMockRecorder) Execute(ctx, input interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Execute", reflect.TypeOf((*MockSetUserStatusUseCaseInterface)(nil).Execute), ctx, input)
+}
+
+// MockResetUserPasswordUseCaseInterface is a mock of ResetUserPasswordUseCaseInterface interface.
+type MockResetUserPasswordUseCaseInterface struct {
+	ctrl     *gomock.Controller
+	recorder *MockResetUserPasswordUseCaseInterfaceMockRecorder
+}
+
+// MockResetUserPasswordUseCaseInterfaceMockRecorder is the mock recorder for MockResetUserPasswordUseCaseInterface.
+type MockResetUserPasswordUseCaseInterfaceMockRecorder struct {
+	mock *MockResetUserPasswordUseCaseInterface
+}
+
+// NewMockResetUserPasswordUseCaseInterface creates a new mock instance.
+func NewMockResetUserPasswordUseCaseInterface(ctrl *gomock.Controller) *MockResetUserPasswordUseCaseInterface {
+	mock := &MockResetUserPasswordUseCaseInterface{ctrl: ctrl}
+	mock.recorder = &MockResetUserPasswordUseCaseInterfaceMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockResetUserPasswordUseCaseInterface) EXPECT() *MockResetUserPasswordUseCaseInterfaceMockRecorder {
+	return m.recorder
+}
+
+// Execute mocks base method.
+func (m *MockResetUserPasswordUseCaseInterface) Execute(ctx context.Context, input ResetUserPasswordUseCaseInputDTO) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Execute", ctx, input)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Execute indicates an expected call of Execute.
+func (mr *MockResetUserPasswordUseCaseInterfaceMockRecorder) Execute(ctx, input interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Execute", reflect.TypeOf((*MockResetUserPasswordUseCaseInterface)(nil).Execute), ctx, input)
+}
+
+// MockCreateOrganizationUseCaseInterface is a mock of CreateOrganizationUseCaseInterface interface.
+type MockCreateOrganizationUseCaseInterface struct {
+	ctrl     *gomock.Controller
+	recorder *MockCreateOrganizationUseCaseInterfaceMockRecorder
+}
+
+// MockCreateOrganizationUseCaseInterfaceMockRecorder is the mock recorder for MockCreateOrganizationUseCaseInterface.
+type MockCreateOrganizationUseCaseInterfaceMockRecorder struct {
+	mock *MockCreateOrganizationUseCaseInterface
+}
+
+// NewMockCreateOrganizationUseCaseInterface creates a new mock instance.
+func NewMockCreateOrganizationUseCaseInterface(ctrl *gomock.Controller) *MockCreateOrganizationUseCaseInterface {
+	mock := &MockCreateOrganizationUseCaseInterface{ctrl: ctrl}
+	mock.recorder = &MockCreateOrganizationUseCaseInterfaceMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockCreateOrganizationUseCaseInterface) EXPECT() *MockCreateOrganizationUseCaseInterfaceMockRecorder {
+	return m.recorder
+}
+
+// Execute mocks base method.
+func (m *MockCreateOrganizationUseCaseInterface) Execute(ctx context.Context, input CreateOrganizationUseCaseInputDTO) (*CreateOrganizationUseCaseOutputDTO, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Execute", ctx, input)
+	ret0, _ := ret[0].(*CreateOrganizationUseCaseOutputDTO)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Execute indicates an expected call of Execute.
+func (mr *MockCreateOrganizationUseCaseInterfaceMockRecorder) Execute(ctx, input interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Execute", reflect.TypeOf((*MockCreateOrganizationUseCaseInterface)(nil).Execute), ctx, input)
+}
+
+// MockInviteMemberUseCaseInterface is a mock of InviteMemberUseCaseInterface interface.
+type MockInviteMemberUseCaseInterface struct {
+	ctrl     *gomock.Controller
+	recorder *MockInviteMemberUseCaseInterfaceMockRecorder
+}
+
+// MockInviteMemberUseCaseInterfaceMockRecorder is the mock recorder for MockInviteMemberUseCaseInterface.
+type MockInviteMemberUseCaseInterfaceMockRecorder struct {
+	mock *MockInviteMemberUseCaseInterface
+}
+
+// NewMockInviteMemberUseCaseInterface creates a new mock instance.
+func NewMockInviteMemberUseCaseInterface(ctrl *gomock.Controller) *MockInviteMemberUseCaseInterface {
+	mock := &MockInviteMemberUseCaseInterface{ctrl: ctrl}
+	mock.recorder = &MockInviteMemberUseCaseInterfaceMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockInviteMemberUseCaseInterface) EXPECT() *MockInviteMemberUseCaseInterfaceMockRecorder {
+	return m.recorder
+}
+
+// Execute mocks base method.
+func (m *MockInviteMemberUseCaseInterface) Execute(ctx context.Context, input InviteMemberUseCaseInputDTO) (*InviteMemberUseCaseOutputDTO, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Execute", ctx, input)
+	ret0, _ := ret[0].(*InviteMemberUseCaseOutputDTO)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Execute indicates an expected call of Execute.
+func (mr *MockInviteMemberUseCaseInterfaceMockRecorder) Execute(ctx, input interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Execute", reflect.TypeOf((*MockInviteMemberUseCaseInterface)(nil).Execute), ctx, input)
+}
+
+// MockRespondInvitationUseCaseInterface is a mock of RespondInvitationUseCaseInterface interface.
+type MockRespondInvitationUseCaseInterface struct {
+	ctrl     *gomock.Controller
+	recorder *MockRespondInvitationUseCaseInterfaceMockRecorder
+}
+
+// MockRespondInvitationUseCaseInterfaceMockRecorder is the mock recorder for MockRespondInvitationUseCaseInterface.
+type MockRespondInvitationUseCaseInterfaceMockRecorder struct {
+	mock *MockRespondInvitationUseCaseInterface
+}
+
+// NewMockRespondInvitationUseCaseInterface creates a new mock instance.
+func NewMockRespondInvitationUseCaseInterface(ctrl *gomock.Controller) *MockRespondInvitationUseCaseInterface {
+	mock := &MockRespondInvitationUseCaseInterface{ctrl: ctrl}
+	mock.recorder = &MockRespondInvitationUseCaseInterfaceMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockRespondInvitationUseCaseInterface) EXPECT() *MockRespondInvitationUseCaseInterfaceMockRecorder {
+	return m.recorder
+}
+
+// Execute mocks base method.
+func (m *MockRespondInvitationUseCaseInterface) Execute(ctx context.Context, input RespondInvitationUseCaseInputDTO) (*RespondInvitationUseCaseOutputDTO, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Execute", ctx, input)
+	ret0, _ := ret[0].(*RespondInvitationUseCaseOutputDTO)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Execute indicates an expected call of Execute.
+func (mr *MockRespondInvitationUseCaseInterfaceMockRecorder) Execute(ctx, input interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Execute", reflect.TypeOf((*MockRespondInvitationUseCaseInterface)(nil).Execute), ctx, input)
+}
+
+// MockListMembersUseCaseInterface is a mock of ListMembersUseCaseInterface interface.
+type MockListMembersUseCaseInterface struct {
+	ctrl     *gomock.Controller
+	recorder *MockListMembersUseCaseInterfaceMockRecorder
+}
+
+// MockListMembersUseCaseInterfaceMockRecorder is the mock recorder for MockListMembersUseCaseInterface.
+type MockListMembersUseCaseInterfaceMockRecorder struct {
+	mock *MockListMembersUseCaseInterface
+}
+
+// NewMockListMembersUseCaseInterface creates a new mock instance.
+func NewMockListMembersUseCaseInterface(ctrl *gomock.Controller) *MockListMembersUseCaseInterface {
+	mock := &MockListMembersUseCaseInterface{ctrl: ctrl}
+	mock.recorder = &MockListMembersUseCaseInterfaceMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockListMembersUseCaseInterface) EXPECT() *MockListMembersUseCaseInterfaceMockRecorder {
+	return m.recorder
+}
+
+// Execute mocks base method.
+func (m *MockListMembersUseCaseInterface) Execute(ctx context.Context, input ListMembersUseCaseInputDTO) (*ListMembersUseCaseOutputDTO, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Execute", ctx, input)
+	ret0, _ := ret[0].(*ListMembersUseCaseOutputDTO)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Execute indicates an expected call of Execute.
+func (mr *MockListMembersUseCaseInterfaceMockRecorder) Execute(ctx, input interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Execute", reflect.TypeOf((*MockListMembersUseCaseInterface)(nil).Execute), ctx, input)
+}
+
+// MockSetMemberRoleUseCaseInterface is a mock of SetMemberRoleUseCaseInterface interface.
+type MockSetMemberRoleUseCaseInterface struct {
+	ctrl     *gomock.Controller
+	recorder *MockSetMemberRoleUseCaseInterfaceMockRecorder
+}
+
+// MockSetMemberRoleUseCaseInterfaceMockRecorder is the mock recorder for MockSetMemberRoleUseCaseInterface.
+type MockSetMemberRoleUseCaseInterfaceMockRecorder struct {
+	mock *MockSetMemberRoleUseCaseInterface
+}
+
+// NewMockSetMemberRoleUseCaseInterface creates a new mock instance.
+func NewMockSetMemberRoleUseCaseInterface(ctrl *gomock.Controller) *MockSetMemberRoleUseCaseInterface {
+	mock := &MockSetMemberRoleUseCaseInterface{ctrl: ctrl}
+	mock.recorder = &MockSetMemberRoleUseCaseInterfaceMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockSetMemberRoleUseCaseInterface) EXPECT() *MockSetMemberRoleUseCaseInterfaceMockRecorder {
+	return m.recorder
+}
+
+// Execute mocks base method.
+func (m *MockSetMemberRoleUseCaseInterface) Execute(ctx context.Context, input SetMemberRoleUseCaseInputDTO) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Execute", ctx, input)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Execute indicates an expected call of Execute.
+func (mr *MockSetMemberRoleUseCaseInterfaceMockRecorder) Execute(ctx, input interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Execute", reflect.TypeOf((*MockSetMemberRoleUseCaseInterface)(nil).Execute), ctx, input)
+}
+
+// MockRemoveMemberUseCaseInterface is a mock of RemoveMemberUseCaseInterface interface.
+type MockRemoveMemberUseCaseInterface struct {
+	ctrl     *gomock.Controller
+	recorder *MockRemoveMemberUseCaseInterfaceMockRecorder
+}
+
+// MockRemoveMemberUseCaseInterfaceMockRecorder is the mock recorder for MockRemoveMemberUseCaseInterface.
+type MockRemoveMemberUseCaseInterfaceMockRecorder struct {
+	mock *MockRemoveMemberUseCaseInterface
+}
+
+// NewMockRemoveMemberUseCaseInterface creates a new mock instance.
+func NewMockRemoveMemberUseCaseInterface(ctrl *gomock.Controller) *MockRemoveMemberUseCaseInterface {
+	mock := &MockRemoveMemberUseCaseInterface{ctrl: ctrl}
+	mock.recorder = &MockRemoveMemberUseCaseInterfaceMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockRemoveMemberUseCaseInterface) EXPECT() *MockRemoveMemberUseCaseInterfaceMockRecorder {
+	return m.recorder
+}
+
+// Execute mocks base method.
+func (m *MockRemoveMemberUseCaseInterface) Execute(ctx context.Context, input RemoveMemberUseCaseInputDTO) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Execute", ctx, input)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Execute indicates an expected call of Execute.
+func (mr *MockRemoveMemberUseCaseInterfaceMockRecorder) Execute(ctx, input interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Execute", reflect.TypeOf((*MockRemoveMemberUseCaseInterface)(nil).Execute), ctx, input)
+}
+
+// MockSwitchOrganizationUseCaseInterface is a mock of SwitchOrganizationUseCaseInterface interface.
+type MockSwitchOrganizationUseCaseInterface struct {
+	ctrl     *gomock.Controller
+	recorder *MockSwitchOrganizationUseCaseInterfaceMockRecorder
+}
+
+// MockSwitchOrganizationUseCaseInterfaceMockRecorder is the mock recorder for MockSwitchOrganizationUseCaseInterface.
+type MockSwitchOrganizationUseCaseInterfaceMockRecorder struct {
+	mock *MockSwitchOrganizationUseCaseInterface
+}
+
+// NewMockSwitchOrganizationUseCaseInterface creates a new mock instance.
+func NewMockSwitchOrganizationUseCaseInterface(ctrl *gomock.Controller) *MockSwitchOrganizationUseCaseInterface {
+	mock := &MockSwitchOrganizationUseCaseInterface{ctrl: ctrl}
+	mock.recorder = &MockSwitchOrganizationUseCaseInterfaceMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockSwitchOrganizationUseCaseInterface) EXPECT() *MockSwitchOrganizationUseCaseInterfaceMockRecorder {
+	return m.recorder
+}
+
+// Execute mocks base method.
+func (m *MockSwitchOrganizationUseCaseInterface) Execute(ctx context.Context, input SwitchOrganizationUseCaseInputDTO) (*SwitchOrganizationUseCaseOutputDTO, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Execute", ctx, input)
+	ret0, _ := ret[0].(*SwitchOrganizationUseCaseOutputDTO)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Execute indicates an expected call of Execute.
+func (mr *MockSwitchOrganizationUseCaseInterfaceMockRecorder) Execute(ctx, input interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Execute", reflect.TypeOf((*MockSwitchOrganizationUseCaseInterface)(nil).Execute), ctx, input)
+}
+
+// MockCreateAPIKeyUseCaseInterface is a mock of CreateAPIKeyUseCaseInterface interface.
+type MockCreateAPIKeyUseCaseInterface struct {
+	ctrl     *gomock.Controller
+	recorder *MockCreateAPIKeyUseCaseInterfaceMockRecorder
+}
+
+// MockCreateAPIKeyUseCaseInterfaceMockRecorder is the mock recorder for MockCreateAPIKeyUseCaseInterface.
+type MockCreateAPIKeyUseCaseInterfaceMockRecorder struct {
+	mock *MockCreateAPIKeyUseCaseInterface
+}
+
+// NewMockCreateAPIKeyUseCaseInterface creates a new mock instance.
+func NewMockCreateAPIKeyUseCaseInterface(ctrl *gomock.Controller) *MockCreateAPIKeyUseCaseInterface {
+	mock := &MockCreateAPIKeyUseCaseInterface{ctrl: ctrl}
+	mock.recorder = &MockCreateAPIKeyUseCaseInterfaceMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockCreateAPIKeyUseCaseInterface) EXPECT() *MockCreateAPIKeyUseCaseInterfaceMockRecorder {
+	return m.recorder
+}
+
+// Execute mocks base method.
+func (m *MockCreateAPIKeyUseCaseInterface) Execute(ctx context.Context, input CreateAPIKeyUseCaseInputDTO) (*CreateAPIKeyUseCaseOutputDTO, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Execute", ctx, input)
+	ret0, _ := ret[0].(*CreateAPIKeyUseCaseOutputDTO)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Execute indicates an expected call of Execute.
+func (mr *MockCreateAPIKeyUseCaseInterfaceMockRecorder) Execute(ctx, input interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Execute", reflect.TypeOf((*MockCreateAPIKeyUseCaseInterface)(nil).Execute), ctx, input)
+}
+
+// MockListAPIKeysUseCaseInterface is a mock of ListAPIKeysUseCaseInterface interface.
+type MockListAPIKeysUseCaseInterface struct {
+	ctrl     *gomock.Controller
+	recorder *MockListAPIKeysUseCaseInterfaceMockRecorder
+}
+
+// MockListAPIKeysUseCaseInterfaceMockRecorder is the mock recorder for MockListAPIKeysUseCaseInterface.
+type MockListAPIKeysUseCaseInterfaceMockRecorder struct {
+	mock *MockListAPIKeysUseCaseInterface
+}
+
+// NewMockListAPIKeysUseCaseInterface creates a new mock instance.
+func NewMockListAPIKeysUseCaseInterface(ctrl *gomock.Controller) *MockListAPIKeysUseCaseInterface {
+	mock := &MockListAPIKeysUseCaseInterface{ctrl: ctrl}
+	mock.recorder = &MockListAPIKeysUseCaseInterfaceMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockListAPIKeysUseCaseInterface) EXPECT() *MockListAPIKeysUseCaseInterfaceMockRecorder {
+	return m.recorder
+}
+
+// Execute mocks base method.
+func (m *MockListAPIKeysUseCaseInterface) Execute(ctx context.Context, input ListAPIKeysUseCaseInputDTO) (*ListAPIKeysUseCaseOutputDTO, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Execute", ctx, input)
+	ret0, _ := ret[0].(*ListAPIKeysUseCaseOutputDTO)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Execute indicates an expected call of Execute.
+func (mr *MockListAPIKeysUseCaseInterfaceMockRecorder) Execute(ctx, input interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Execute", reflect.TypeOf((*MockListAPIKeysUseCaseInterface)(nil).Execute), ctx, input)
+}
+
+// MockRevokeAPIKeyUseCaseInterface is a mock of RevokeAPIKeyUseCaseInterface interface.
+type MockRevokeAPIKeyUseCaseInterface struct {
+	ctrl     *gomock.Controller
+	recorder *MockRevokeAPIKeyUseCaseInterfaceMockRecorder
+}
+
+// MockRevokeAPIKeyUseCaseInterfaceMockRecorder is the mock recorder for MockRevokeAPIKeyUseCaseInterface.
+type MockRevokeAPIKeyUseCaseInterfaceMockRecorder struct {
+	mock *MockRevokeAPIKeyUseCaseInterface
+}
+
+// NewMockRevokeAPIKeyUseCaseInterface creates a new mock instance.
+func NewMockRevokeAPIKeyUseCaseInterface(ctrl *gomock.Controller) *MockRevokeAPIKeyUseCaseInterface {
+	mock := &MockRevokeAPIKeyUseCaseInterface{ctrl: ctrl}
+	mock.recorder = &MockRevokeAPIKeyUseCaseInterfaceMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockRevokeAPIKeyUseCaseInterface) EXPECT() *MockRevokeAPIKeyUseCaseInterfaceMockRecorder {
+	return m.recorder
+}
+
+// Execute mocks base method.
+func (m *MockRevokeAPIKeyUseCaseInterface) Execute(ctx context.Context, input RevokeAPIKeyUseCaseInputDTO) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Execute", ctx, input)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Execute indicates an expected call of Execute.
+func (mr *MockRevokeAPIKeyUseCaseInterfaceMockRecorder) Execute(ctx, input interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Execute", reflect.TypeOf((*MockRevokeAPIKeyUseCaseInterface)(nil).Execute), ctx, input)
+}
+
+// MockAuthAPIKeyUseCaseInterface is a mock of AuthAPIKeyUseCaseInterface interface.
+type MockAuthAPIKeyUseCaseInterface struct {
+	ctrl     *gomock.Controller
+	recorder *MockAuthAPIKeyUseCaseInterfaceMockRecorder
+}
+
+// MockAuthAPIKeyUseCaseInterfaceMockRecorder is the mock recorder for MockAuthAPIKeyUseCaseInterface.
+type MockAuthAPIKeyUseCaseInterfaceMockRecorder struct {
+	mock *MockAuthAPIKeyUseCaseInterface
+}
+
+// NewMockAuthAPIKeyUseCaseInterface creates a new mock instance.
+func NewMockAuthAPIKeyUseCaseInterface(ctrl *gomock.Controller) *MockAuthAPIKeyUseCaseInterface {
+	mock := &MockAuthAPIKeyUseCaseInterface{ctrl: ctrl}
+	mock.recorder = &MockAuthAPIKeyUseCaseInterfaceMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockAuthAPIKeyUseCaseInterface) EXPECT() *MockAuthAPIKeyUseCaseInterfaceMockRecorder {
+	return m.recorder
+}
+
+// Execute mocks base method.
+func (m *MockAuthAPIKeyUseCaseInterface) Execute(ctx context.Context, input AuthAPIKeyUseCaseInputDTO) (*AuthAPIKeyUseCaseOutputDTO, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Execute", ctx, input)
+	ret0, _ := ret[0].(*AuthAPIKeyUseCaseOutputDTO)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Execute indicates an expected call of Execute.
+func (mr *MockAuthAPIKeyUseCaseInterfaceMockRecorder) Execute(ctx, input interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Execute", reflect.TypeOf((*MockAuthAPIKeyUseCaseInterface)(nil).Execute), ctx, input)
+}
+
+// MockListSessionsUseCaseInterface is a mock of ListSessionsUseCaseInterface interface.
+type MockListSessionsUseCaseInterface struct {
+	ctrl     *gomock.Controller
+	recorder *MockListSessionsUseCaseInterfaceMockRecorder
+}
+
+// MockListSessionsUseCaseInterfaceMockRecorder is the mock recorder for MockListSessionsUseCaseInterface.
+type MockListSessionsUseCaseInterfaceMockRecorder struct {
+	mock *MockListSessionsUseCaseInterface
+}
+
+// NewMockListSessionsUseCaseInterface creates a new mock instance.
+func NewMockListSessionsUseCaseInterface(ctrl *gomock.Controller) *MockListSessionsUseCaseInterface {
+	mock := &MockListSessionsUseCaseInterface{ctrl: ctrl}
+	mock.recorder = &MockListSessionsUseCaseInterfaceMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockListSessionsUseCaseInterface) EXPECT() *MockListSessionsUseCaseInterfaceMockRecorder {
+	return m.recorder
+}
+
+// Execute mocks base method.
+func (m *MockListSessionsUseCaseInterface) Execute(ctx context.Context, input ListSessionsUseCaseInputDTO) (*ListSessionsUseCaseOutputDTO, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Execute", ctx, input)
+	ret0, _ := ret[0].(*ListSessionsUseCaseOutputDTO)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Execute indicates an expected call of Execute.
+func (mr *MockListSessionsUseCaseInterfaceMockRecorder) Execute(ctx, input interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Execute", reflect.TypeOf((*MockListSessionsUseCaseInterface)(nil).Execute), ctx, input)
+}
+
+// MockRevokeSessionUseCaseInterface is a mock of RevokeSessionUseCaseInterface interface.
+type MockRevokeSessionUseCaseInterface struct {
+	ctrl     *gomock.Controller
+	recorder *MockRevokeSessionUseCaseInterfaceMockRecorder
+}
+
+// MockRevokeSessionUseCaseInterfaceMockRecorder is the mock recorder for MockRevokeSessionUseCaseInterface.
+type MockRevokeSessionUseCaseInterfaceMockRecorder struct {
+	mock *MockRevokeSessionUseCaseInterface
+}
+
+// NewMockRevokeSessionUseCaseInterface creates a new mock instance.
+func NewMockRevokeSessionUseCaseInterface(ctrl *gomock.Controller) *MockRevokeSessionUseCaseInterface {
+	mock := &MockRevokeSessionUseCaseInterface{ctrl: ctrl}
+	mock.recorder = &MockRevokeSessionUseCaseInterfaceMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockRevokeSessionUseCaseInterface) EXPECT() *MockRevokeSessionUseCaseInterfaceMockRecorder {
+	return m.recorder
+}
+
+// Execute mocks base method.
+func (m *MockRevokeSessionUseCaseInterface) Execute(ctx context.Context, input RevokeSessionUseCaseInputDTO) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Execute", ctx, input)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Execute indicates an expected call of Execute.
+func (mr *MockRevokeSessionUseCaseInterfaceMockRecorder) Execute(ctx, input interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Execute", reflect.TypeOf((*MockRevokeSessionUseCaseInterface)(nil).Execute), ctx, input)
+}
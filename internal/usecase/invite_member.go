@@ -0,0 +1,173 @@
+package usecase
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"net/url"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/sesaquecruz/go-auth-api/internal/entity"
+)
+
+var (
+	ErrInviteMemberInvalidData           = errors.New("invalid data")
+	ErrInviteMemberOrganizationNotExists = errors.New("organization not exists")
+	ErrInviteMemberForbidden             = errors.New("forbidden")
+	ErrInviteMemberAlreadyMember         = errors.New("already a member")
+	ErrInviteMemberInternalError         = errors.New("internal error")
+)
+
+const (
+	inviteMemberSubject = "You were invited to %s"
+	inviteMemberBody    = "You were invited to join %s as %s. " +
+		"Open the link below to accept or decline the invitation before %s.\n\n%s\n"
+)
+
+type InviteMemberUseCaseInputDTO struct {
+	OrganizationID string `json:"organization_id"`
+	UserID         string `json:"user_id"`
+	Email          string `json:"email"`
+	Role           string `json:"role"`
+}
+
+type InviteMemberUseCaseOutputDTO struct {
+	ID        string    `json:"id"`
+	ExpiresAt time.Time `json:"expires_at"`
+}
+
+// InviteMemberUseCase emails a signed link that lets the invitee join the organization. Members invite
+// with the roles they can manage. The email is sent once the invitation is stored, out of the transaction,
+// and the invitation is deleted if it could not be sent.
+type InviteMemberUseCase struct {
+	UserRepository         entity.UserRepositoryInterface
+	OrganizationRepository entity.OrganizationRepositoryInterface
+	InvitationRepository   entity.InvitationRepositoryInterface
+	InvitationSigner       entity.InvitationSignerInterface
+	Mailer                 entity.MailerInterface
+	TransactionManager     entity.TransactionManagerInterface
+	Expiration             time.Duration
+	URL                    string
+}
+
+func NewInviteMemberUseCase(
+	ur entity.UserRepositoryInterface,
+	or entity.OrganizationRepositoryInterface,
+	ir entity.InvitationRepositoryInterface,
+	is entity.InvitationSignerInterface,
+	m entity.MailerInterface,
+	tm entity.TransactionManagerInterface,
+	expiration time.Duration,
+	url string,
+) *InviteMemberUseCase {
+	return &InviteMemberUseCase{
+		UserRepository:         ur,
+		OrganizationRepository: or,
+		InvitationRepository:   ir,
+		InvitationSigner:       is,
+		Mailer:                 m,
+		TransactionManager:     tm,
+		Expiration:             expiration,
+		URL:                    url,
+	}
+}
+
+func (uc *InviteMemberUseCase) Execute(ctx context.Context, input InviteMemberUseCaseInputDTO) (*InviteMemberUseCaseOutputDTO, error) {
+	organizationID, err := uuid.Parse(input.OrganizationID)
+	if err != nil {
+		return nil, ErrInviteMemberInvalidData
+	}
+
+	userID, err := uuid.Parse(input.UserID)
+	if err != nil {
+		return nil, ErrInviteMemberInvalidData
+	}
+
+	invitation, err := entity.NewInvitation(organizationID, input.Email, entity.OrganizationRole(input.Role), userID, uc.Expiration)
+	if errors.Is(err, entity.ErrUserInvalidEmail) || errors.Is(err, entity.ErrOrganizationInvalidRole) {
+		return nil, ErrInviteMemberInvalidData
+	}
+	if err != nil {
+		return nil, ErrInviteMemberInternalError
+	}
+
+	var organization *entity.Organization
+	err = uc.TransactionManager.Do(ctx, func(ctx context.Context) error {
+		organization, err = uc.invite(ctx, *invitation)
+		return err
+	})
+	switch err {
+	case nil:
+	case ErrInviteMemberOrganizationNotExists, ErrInviteMemberForbidden, ErrInviteMemberAlreadyMember:
+		return nil, err
+	default:
+		return nil, ErrInviteMemberInternalError
+	}
+
+	err = uc.send(ctx, *organization, *invitation)
+	if err != nil {
+		// The invitation is of no use without its link.
+		uc.InvitationRepository.Delete(ctx, invitation.ID)
+		return nil, ErrInviteMemberInternalError
+	}
+
+	output := &InviteMemberUseCaseOutputDTO{
+		ID:        invitation.ID.String(),
+		ExpiresAt: invitation.ExpiresAt,
+	}
+
+	return output, nil
+}
+
+func (uc *InviteMemberUseCase) invite(ctx context.Context, invitation entity.Invitation) (*entity.Organization, error) {
+	organization, err := uc.OrganizationRepository.FindById(ctx, invitation.OrganizationID)
+	if err == sql.ErrNoRows {
+		return nil, ErrInviteMemberOrganizationNotExists
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	inviter, err := uc.OrganizationRepository.FindMember(ctx, organization.ID, invitation.InvitedBy)
+	if err == sql.ErrNoRows {
+		return nil, ErrInviteMemberOrganizationNotExists
+	}
+	if err != nil {
+		return nil, err
+	}
+	if !inviter.Role.CanManage(invitation.Role) {
+		return nil, ErrInviteMemberForbidden
+	}
+
+	invitee, err := uc.UserRepository.FindByEmail(ctx, invitation.Email)
+	if err == nil {
+		_, err = uc.OrganizationRepository.FindMember(ctx, organization.ID, invitee.ID)
+		if err == nil {
+			return nil, ErrInviteMemberAlreadyMember
+		}
+	}
+	if err != nil && err != sql.ErrNoRows {
+		return nil, err
+	}
+
+	err = uc.InvitationRepository.Save(ctx, invitation)
+	if err != nil {
+		return nil, err
+	}
+
+	return organization, nil
+}
+
+func (uc *InviteMemberUseCase) send(ctx context.Context, organization entity.Organization, invitation entity.Invitation) error {
+	token, err := uc.InvitationSigner.Sign(invitation)
+	if err != nil {
+		return err
+	}
+
+	link := uc.URL + "?token=" + url.QueryEscape(token)
+	subject := fmt.Sprintf(inviteMemberSubject, organization.Name)
+	body := fmt.Sprintf(inviteMemberBody, organization.Name, invitation.Role, invitation.ExpiresAt.Format(time.RFC1123), link)
+	return uc.Mailer.Send(ctx, invitation.Email, subject, body)
+}
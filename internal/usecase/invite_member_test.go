@@ -0,0 +1,229 @@
+package usecase
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/sesaquecruz/go-auth-api/internal/entity"
+	"github.com/sesaquecruz/go-auth-api/internal/infra/signing"
+
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func newInviteMemberUseCase(f *organizationFixture, mailer entity.MailerInterface) *InviteMemberUseCase {
+	return NewInviteMemberUseCase(
+		f.userRepository,
+		f.organizationRepository,
+		f.invitationRepository,
+		signing.NewInvitationSigner([]byte("secret")),
+		mailer,
+		f.transactionManager,
+		time.Hour,
+		"https://app.mail.com/invitations",
+	)
+}
+
+func Test_InviteMemberUseCase_NewInviteMemberUseCase(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	userRepository := entity.NewMockUserRepositoryInterface(ctrl)
+	organizationRepository := entity.NewMockOrganizationRepositoryInterface(ctrl)
+	invitationRepository := entity.NewMockInvitationRepositoryInterface(ctrl)
+	invitationSigner := entity.NewMockInvitationSignerInterface(ctrl)
+	mailer := entity.NewMockMailerInterface(ctrl)
+	transactionManager := entity.NewMockTransactionManagerInterface(ctrl)
+
+	inviteMemberUseCase := NewInviteMemberUseCase(userRepository, organizationRepository, invitationRepository, invitationSigner, mailer, transactionManager, time.Hour, "url")
+	assert.NotNil(t, inviteMemberUseCase)
+	assert.Equal(t, userRepository, inviteMemberUseCase.UserRepository)
+	assert.Equal(t, organizationRepository, inviteMemberUseCase.OrganizationRepository)
+	assert.Equal(t, invitationRepository, inviteMemberUseCase.InvitationRepository)
+	assert.Equal(t, invitationSigner, inviteMemberUseCase.InvitationSigner)
+	assert.Equal(t, mailer, inviteMemberUseCase.Mailer)
+	assert.Equal(t, transactionManager, inviteMemberUseCase.TransactionManager)
+	assert.Equal(t, time.Hour, inviteMemberUseCase.Expiration)
+	assert.Equal(t, "url", inviteMemberUseCase.URL)
+}
+
+func Test_InviteMemberUseCase_Execute(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	f := newOrganizationFixture(t)
+	mailer := entity.NewMockMailerInterface(ctrl)
+	inviteMemberUseCase := newInviteMemberUseCase(f, mailer)
+
+	var body string
+	mailer.EXPECT().
+		Send(gomock.Any(), "guest@mail.com", "You were invited to Acme", gomock.Any()).
+		DoAndReturn(func(_ context.Context, _ string, _ string, b string) error {
+			body = b
+			return nil
+		}).
+		Times(1)
+
+	output, err := inviteMemberUseCase.Execute(f.ctx, InviteMemberUseCaseInputDTO{
+		OrganizationID: f.organization.ID.String(),
+		UserID:         f.owner.ID.String(),
+		Email:          "guest@Mail.com",
+		Role:           "admin",
+	})
+	require.Nil(t, err)
+	assert.WithinDuration(t, time.Now().Add(time.Hour), output.ExpiresAt, time.Minute)
+
+	invitation, err := f.invitationRepository.FindById(f.ctx, uuid.MustParse(output.ID))
+	require.Nil(t, err)
+	assert.Equal(t, "guest@mail.com", invitation.Email)
+	assert.Equal(t, entity.OrganizationRoleAdmin, invitation.Role)
+	assert.Equal(t, f.owner.ID, invitation.InvitedBy)
+
+	token, err := signing.NewInvitationSigner([]byte("secret")).Sign(*invitation)
+	require.Nil(t, err)
+	assert.True(t, strings.Contains(body, "https://app.mail.com/invitations?token="+token))
+}
+
+func Test_InviteMemberUseCase_Execute_WhenDataIsInvalid(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	f := newOrganizationFixture(t)
+	inviteMemberUseCase := newInviteMemberUseCase(f, entity.NewMockMailerInterface(ctrl))
+
+	for _, input := range []InviteMemberUseCaseInputDTO{
+		{OrganizationID: "invalid", UserID: f.owner.ID.String(), Email: "guest@mail.com", Role: "member"},
+		{OrganizationID: f.organization.ID.String(), UserID: "invalid", Email: "guest@mail.com", Role: "member"},
+		{OrganizationID: f.organization.ID.String(), UserID: f.owner.ID.String(), Email: "guest", Role: "member"},
+		{OrganizationID: f.organization.ID.String(), UserID: f.owner.ID.String(), Email: "guest@mail.com", Role: "guest"},
+	} {
+		output, err := inviteMemberUseCase.Execute(f.ctx, input)
+		assert.Nil(t, output)
+		assert.ErrorIs(t, err, ErrInviteMemberInvalidData)
+	}
+}
+
+func Test_InviteMemberUseCase_Execute_WhenInviterCannotManageRole(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	f := newOrganizationFixture(t)
+	admin := f.addMember(t, "admin@mail.com", entity.OrganizationRoleAdmin)
+	member := f.addMember(t, "member@mail.com", entity.OrganizationRoleMember)
+	outsider := f.addUser(t, "outsider@mail.com")
+	inviteMemberUseCase := newInviteMemberUseCase(f, entity.NewMockMailerInterface(ctrl))
+
+	tests := []struct {
+		inviter  *entity.User
+		role     string
+		expected error
+	}{
+		{admin, "owner", ErrInviteMemberForbidden},
+		{member, "member", ErrInviteMemberForbidden},
+		{outsider, "member", ErrInviteMemberOrganizationNotExists},
+	}
+	for _, test := range tests {
+		output, err := inviteMemberUseCase.Execute(f.ctx, InviteMemberUseCaseInputDTO{
+			OrganizationID: f.organization.ID.String(),
+			UserID:         test.inviter.ID.String(),
+			Email:          "guest@mail.com",
+			Role:           test.role,
+		})
+		assert.Nil(t, output)
+		assert.ErrorIs(t, err, test.expected, test.inviter.Email)
+	}
+}
+
+func Test_InviteMemberUseCase_Execute_WhenUserIsAlreadyMember(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	f := newOrganizationFixture(t)
+	f.addMember(t, "member@mail.com", entity.OrganizationRoleMember)
+	inviteMemberUseCase := newInviteMemberUseCase(f, entity.NewMockMailerInterface(ctrl))
+
+	output, err := inviteMemberUseCase.Execute(f.ctx, InviteMemberUseCaseInputDTO{
+		OrganizationID: f.organization.ID.String(),
+		UserID:         f.owner.ID.String(),
+		Email:          "member@mail.com",
+		Role:           "member",
+	})
+	assert.Nil(t, output)
+	assert.ErrorIs(t, err, ErrInviteMemberAlreadyMember)
+}
+
+func Test_InviteMemberUseCase_Execute_WhenEmailCannotBeSent(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	f := newOrganizationFixture(t)
+	mailer := entity.NewMockMailerInterface(ctrl)
+	inviteMemberUseCase := newInviteMemberUseCase(f, mailer)
+
+	var body string
+	mailer.EXPECT().
+		Send(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).
+		DoAndReturn(func(_ context.Context, _ string, _ string, b string) error {
+			body = b
+			return errors.New("")
+		}).
+		Times(1)
+
+	output, err := inviteMemberUseCase.Execute(f.ctx, InviteMemberUseCaseInputDTO{
+		OrganizationID: f.organization.ID.String(),
+		UserID:         f.owner.ID.String(),
+		Email:          "guest@mail.com",
+		Role:           "member",
+	})
+	assert.Nil(t, output)
+	assert.ErrorIs(t, err, ErrInviteMemberInternalError)
+
+	token := strings.TrimSpace(body[strings.Index(body, "?token=")+len("?token="):])
+	id, err := signing.NewInvitationSigner([]byte("secret")).Verify(token)
+	require.Nil(t, err)
+	_, err = f.invitationRepository.FindById(f.ctx, id)
+	assert.ErrorIs(t, err, sql.ErrNoRows)
+}
+
+func Test_InviteMemberUseCase_Execute_SendsEmailOutOfTransaction(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	f := newOrganizationFixture(t)
+	mailer := entity.NewMockMailerInterface(ctrl)
+	inviteMemberUseCase := newInviteMemberUseCase(f, mailer)
+
+	inTransaction := false
+	transactionManager := entity.NewMockTransactionManagerInterface(ctrl)
+	transactionManager.EXPECT().
+		Do(gomock.Any(), gomock.Any()).
+		DoAndReturn(func(ctx context.Context, fn func(ctx context.Context) error) error {
+			inTransaction = true
+			defer func() { inTransaction = false }()
+			return f.transactionManager.Do(ctx, fn)
+		}).
+		Times(1)
+	inviteMemberUseCase.TransactionManager = transactionManager
+
+	mailer.EXPECT().
+		Send(gomock.Any(), "guest@mail.com", gomock.Any(), gomock.Any()).
+		DoAndReturn(func(_ context.Context, _ string, _ string, _ string) error {
+			assert.False(t, inTransaction)
+			return nil
+		}).
+		Times(1)
+
+	_, err := inviteMemberUseCase.Execute(f.ctx, InviteMemberUseCaseInputDTO{
+		OrganizationID: f.organization.ID.String(),
+		UserID:         f.owner.ID.String(),
+		Email:          "guest@mail.com",
+		Role:           "member",
+	})
+	assert.Nil(t, err)
+}
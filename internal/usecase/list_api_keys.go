@@ -0,0 +1,68 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/sesaquecruz/go-auth-api/internal/entity"
+)
+
+var (
+	ErrListAPIKeysInvalidData   = errors.New("invalid data")
+	ErrListAPIKeysInternalError = errors.New("internal error")
+)
+
+type ListAPIKeysUseCaseInputDTO struct {
+	UserID string `json:"user_id"`
+}
+
+type APIKeyOutputDTO struct {
+	ID         string     `json:"id"`
+	Name       string     `json:"name"`
+	Prefix     string     `json:"prefix"`
+	Scopes     []string   `json:"scopes"`
+	ExpiresAt  time.Time  `json:"expires_at"`
+	LastUsedAt *time.Time `json:"last_used_at"`
+	CreatedAt  time.Time  `json:"created_at"`
+}
+
+type ListAPIKeysUseCaseOutputDTO struct {
+	Keys []APIKeyOutputDTO `json:"keys"`
+}
+
+type ListAPIKeysUseCase struct {
+	APIKeyRepository entity.APIKeyRepositoryInterface
+}
+
+func NewListAPIKeysUseCase(ar entity.APIKeyRepositoryInterface) *ListAPIKeysUseCase {
+	return &ListAPIKeysUseCase{APIKeyRepository: ar}
+}
+
+func (uc *ListAPIKeysUseCase) Execute(ctx context.Context, input ListAPIKeysUseCaseInputDTO) (*ListAPIKeysUseCaseOutputDTO, error) {
+	userID, err := uuid.Parse(input.UserID)
+	if err != nil {
+		return nil, ErrListAPIKeysInvalidData
+	}
+
+	keys, err := uc.APIKeyRepository.FindByUser(ctx, userID)
+	if err != nil {
+		return nil, ErrListAPIKeysInternalError
+	}
+
+	output := &ListAPIKeysUseCaseOutputDTO{Keys: make([]APIKeyOutputDTO, 0, len(keys))}
+	for _, key := range keys {
+		output.Keys = append(output.Keys, APIKeyOutputDTO{
+			ID:         key.ID.String(),
+			Name:       key.Name,
+			Prefix:     key.Prefix,
+			Scopes:     key.Scopes,
+			ExpiresAt:  key.ExpiresAt,
+			LastUsedAt: key.LastUsedAt,
+			CreatedAt:  key.CreatedAt,
+		})
+	}
+
+	return output, nil
+}
@@ -0,0 +1,64 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/sesaquecruz/go-auth-api/internal/entity"
+
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func Test_ListAPIKeysUseCase_NewListAPIKeysUseCase(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	apiKeyRepository := entity.NewMockAPIKeyRepositoryInterface(ctrl)
+	listAPIKeysUseCase := NewListAPIKeysUseCase(apiKeyRepository)
+	assert.NotNil(t, listAPIKeysUseCase)
+	assert.Equal(t, apiKeyRepository, listAPIKeysUseCase.APIKeyRepository)
+}
+
+func Test_ListAPIKeysUseCase_Execute(t *testing.T) {
+	f := newAPIKeyFixture(t)
+	listAPIKeysUseCase := NewListAPIKeysUseCase(f.apiKeyRepository)
+
+	key, _, err := entity.NewAPIKey(f.user.ID, "ci", []string{"users:read"}, time.Hour)
+	require.Nil(t, err)
+	require.Nil(t, f.apiKeyRepository.Save(f.ctx, *key))
+
+	output, err := listAPIKeysUseCase.Execute(f.ctx, ListAPIKeysUseCaseInputDTO{UserID: f.user.ID.String()})
+	require.Nil(t, err)
+	require.Len(t, output.Keys, 1)
+	assert.Equal(t, APIKeyOutputDTO{
+		ID:        key.ID.String(),
+		Name:      key.Name,
+		Prefix:    key.Prefix,
+		Scopes:    key.Scopes,
+		ExpiresAt: key.ExpiresAt,
+		CreatedAt: key.CreatedAt,
+	}, output.Keys[0])
+}
+
+func Test_ListAPIKeysUseCase_Execute_WhenKeysCannotBeRead(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	apiKeyRepository := entity.NewMockAPIKeyRepositoryInterface(ctrl)
+	listAPIKeysUseCase := NewListAPIKeysUseCase(apiKeyRepository)
+
+	apiKeyRepository.EXPECT().FindByUser(gomock.Any(), gomock.Any()).Return(nil, errors.New("")).Times(1)
+
+	output, err := listAPIKeysUseCase.Execute(context.Background(), ListAPIKeysUseCaseInputDTO{UserID: uuid.NewString()})
+	assert.Nil(t, output)
+	assert.ErrorIs(t, err, ErrListAPIKeysInternalError)
+
+	output, err = listAPIKeysUseCase.Execute(context.Background(), ListAPIKeysUseCaseInputDTO{UserID: "invalid"})
+	assert.Nil(t, output)
+	assert.ErrorIs(t, err, ErrListAPIKeysInvalidData)
+}
@@ -0,0 +1,78 @@
+package usecase
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/sesaquecruz/go-auth-api/internal/entity"
+)
+
+var (
+	ErrListMembersInvalidData           = errors.New("invalid data")
+	ErrListMembersOrganizationNotExists = errors.New("organization not exists")
+	ErrListMembersInternalError         = errors.New("internal error")
+)
+
+type ListMembersUseCaseInputDTO struct {
+	OrganizationID string `json:"organization_id"`
+	UserID         string `json:"user_id"`
+}
+
+type MemberOutputDTO struct {
+	UserID    string    `json:"user_id"`
+	Role      string    `json:"role"`
+	CreatedAt time.Time `json:"created_at"`
+}
+
+type ListMembersUseCaseOutputDTO struct {
+	Members []MemberOutputDTO `json:"members"`
+}
+
+// ListMembersUseCase lists the members of an organization to one of them. Organizations the user
+// is not a member of are reported as not existing.
+type ListMembersUseCase struct {
+	OrganizationRepository entity.OrganizationRepositoryInterface
+}
+
+func NewListMembersUseCase(or entity.OrganizationRepositoryInterface) *ListMembersUseCase {
+	return &ListMembersUseCase{OrganizationRepository: or}
+}
+
+func (uc *ListMembersUseCase) Execute(ctx context.Context, input ListMembersUseCaseInputDTO) (*ListMembersUseCaseOutputDTO, error) {
+	organizationID, err := uuid.Parse(input.OrganizationID)
+	if err != nil {
+		return nil, ErrListMembersInvalidData
+	}
+
+	userID, err := uuid.Parse(input.UserID)
+	if err != nil {
+		return nil, ErrListMembersInvalidData
+	}
+
+	_, err = uc.OrganizationRepository.FindMember(ctx, organizationID, userID)
+	if err == sql.ErrNoRows {
+		return nil, ErrListMembersOrganizationNotExists
+	}
+	if err != nil {
+		return nil, ErrListMembersInternalError
+	}
+
+	members, err := uc.OrganizationRepository.ListMembers(ctx, organizationID)
+	if err != nil {
+		return nil, ErrListMembersInternalError
+	}
+
+	output := &ListMembersUseCaseOutputDTO{Members: make([]MemberOutputDTO, 0, len(members))}
+	for _, member := range members {
+		output.Members = append(output.Members, MemberOutputDTO{
+			UserID:    member.UserID.String(),
+			Role:      string(member.Role),
+			CreatedAt: member.CreatedAt,
+		})
+	}
+
+	return output, nil
+}
@@ -0,0 +1,72 @@
+package usecase
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/sesaquecruz/go-auth-api/internal/entity"
+
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func Test_ListMembersUseCase_NewListMembersUseCase(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	organizationRepository := entity.NewMockOrganizationRepositoryInterface(ctrl)
+	listMembersUseCase := NewListMembersUseCase(organizationRepository)
+	assert.NotNil(t, listMembersUseCase)
+	assert.Equal(t, organizationRepository, listMembersUseCase.OrganizationRepository)
+}
+
+func Test_ListMembersUseCase_Execute(t *testing.T) {
+	f := newOrganizationFixture(t)
+	member := f.addMember(t, "member@mail.com", entity.OrganizationRoleMember)
+	listMembersUseCase := NewListMembersUseCase(f.organizationRepository)
+
+	output, err := listMembersUseCase.Execute(f.ctx, ListMembersUseCaseInputDTO{OrganizationID: f.organization.ID.String(), UserID: member.ID.String()})
+	require.Nil(t, err)
+	require.Len(t, output.Members, 2)
+	assert.Equal(t, f.owner.ID.String(), output.Members[0].UserID)
+	assert.Equal(t, "owner", output.Members[0].Role)
+	assert.Equal(t, member.ID.String(), output.Members[1].UserID)
+	assert.Equal(t, "member", output.Members[1].Role)
+}
+
+func Test_ListMembersUseCase_Execute_WhenUserIsNotMember(t *testing.T) {
+	f := newOrganizationFixture(t)
+	outsider := f.addUser(t, "outsider@mail.com")
+	listMembersUseCase := NewListMembersUseCase(f.organizationRepository)
+
+	for _, input := range []ListMembersUseCaseInputDTO{
+		{OrganizationID: f.organization.ID.String(), UserID: outsider.ID.String()},
+		{OrganizationID: uuid.NewString(), UserID: f.owner.ID.String()},
+	} {
+		output, err := listMembersUseCase.Execute(f.ctx, input)
+		assert.Nil(t, output)
+		assert.ErrorIs(t, err, ErrListMembersOrganizationNotExists)
+	}
+
+	output, err := listMembersUseCase.Execute(f.ctx, ListMembersUseCaseInputDTO{OrganizationID: "invalid", UserID: f.owner.ID.String()})
+	assert.Nil(t, output)
+	assert.ErrorIs(t, err, ErrListMembersInvalidData)
+}
+
+func Test_ListMembersUseCase_Execute_WhenMembersCannotBeRead(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	organizationRepository := entity.NewMockOrganizationRepositoryInterface(ctrl)
+	listMembersUseCase := NewListMembersUseCase(organizationRepository)
+
+	f := newOrganizationFixture(t)
+	organizationRepository.EXPECT().FindMember(f.ctx, f.organization.ID, f.owner.ID).Return(&entity.Membership{}, nil).Times(1)
+	organizationRepository.EXPECT().ListMembers(f.ctx, f.organization.ID).Return(nil, errors.New("")).Times(1)
+
+	output, err := listMembersUseCase.Execute(f.ctx, ListMembersUseCaseInputDTO{OrganizationID: f.organization.ID.String(), UserID: f.owner.ID.String()})
+	assert.Nil(t, output)
+	assert.ErrorIs(t, err, ErrListMembersInternalError)
+}
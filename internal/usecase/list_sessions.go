@@ -0,0 +1,70 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/sesaquecruz/go-auth-api/internal/entity"
+)
+
+var (
+	ErrListSessionsInvalidData   = errors.New("invalid data")
+	ErrListSessionsInternalError = errors.New("internal error")
+)
+
+// SessionID is the session of the request, if any, which the output marks as current.
+type ListSessionsUseCaseInputDTO struct {
+	UserID    string `json:"user_id"`
+	SessionID string `json:"session_id"`
+}
+
+type SessionOutputDTO struct {
+	ID         string    `json:"id"`
+	UserAgent  string    `json:"user_agent"`
+	IP         string    `json:"ip"`
+	Current    bool      `json:"current"`
+	ExpiresAt  time.Time `json:"expires_at"`
+	LastSeenAt time.Time `json:"last_seen_at"`
+	CreatedAt  time.Time `json:"created_at"`
+}
+
+type ListSessionsUseCaseOutputDTO struct {
+	Sessions []SessionOutputDTO `json:"sessions"`
+}
+
+type ListSessionsUseCase struct {
+	SessionRepository entity.SessionRepositoryInterface
+}
+
+func NewListSessionsUseCase(sr entity.SessionRepositoryInterface) *ListSessionsUseCase {
+	return &ListSessionsUseCase{SessionRepository: sr}
+}
+
+func (uc *ListSessionsUseCase) Execute(ctx context.Context, input ListSessionsUseCaseInputDTO) (*ListSessionsUseCaseOutputDTO, error) {
+	userID, err := uuid.Parse(input.UserID)
+	if err != nil {
+		return nil, ErrListSessionsInvalidData
+	}
+
+	sessions, err := uc.SessionRepository.FindActiveByUser(ctx, userID, entity.Now())
+	if err != nil {
+		return nil, ErrListSessionsInternalError
+	}
+
+	output := &ListSessionsUseCaseOutputDTO{Sessions: make([]SessionOutputDTO, 0, len(sessions))}
+	for _, session := range sessions {
+		output.Sessions = append(output.Sessions, SessionOutputDTO{
+			ID:         session.ID.String(),
+			UserAgent:  session.UserAgent,
+			IP:         session.IP,
+			Current:    session.ID.String() == input.SessionID,
+			ExpiresAt:  session.ExpiresAt,
+			LastSeenAt: session.LastSeenAt,
+			CreatedAt:  session.CreatedAt,
+		})
+	}
+
+	return output, nil
+}
@@ -0,0 +1,76 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/sesaquecruz/go-auth-api/internal/entity"
+	"github.com/sesaquecruz/go-auth-api/internal/infra/database/memory"
+
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func Test_ListSessionsUseCase_NewListSessionsUseCase(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	sessionRepository := entity.NewMockSessionRepositoryInterface(ctrl)
+	listSessionsUseCase := NewListSessionsUseCase(sessionRepository)
+	assert.NotNil(t, listSessionsUseCase)
+	assert.Equal(t, sessionRepository, listSessionsUseCase.SessionRepository)
+}
+
+func Test_ListSessionsUseCase_Execute(t *testing.T) {
+	ctx := context.Background()
+	sessionRepository := memory.NewSessionRepository()
+	listSessionsUseCase := NewListSessionsUseCase(sessionRepository)
+
+	userID := uuid.New()
+	current, err := entity.NewSession(userID, "curl/8.0", "127.0.0.1", time.Hour)
+	require.Nil(t, err)
+	other, err := entity.NewSession(userID, "Firefox", "10.0.0.1", time.Hour)
+	require.Nil(t, err)
+	other.CreatedAt = current.CreatedAt.Add(time.Second)
+	expired, err := entity.NewSession(userID, "", "", -time.Second)
+	require.Nil(t, err)
+	for _, session := range []*entity.Session{current, other, expired} {
+		require.Nil(t, sessionRepository.Save(ctx, *session))
+	}
+
+	output, err := listSessionsUseCase.Execute(ctx, ListSessionsUseCaseInputDTO{UserID: userID.String(), SessionID: current.ID.String()})
+	require.Nil(t, err)
+	require.Len(t, output.Sessions, 2)
+	assert.Equal(t, SessionOutputDTO{
+		ID:         current.ID.String(),
+		UserAgent:  current.UserAgent,
+		IP:         current.IP,
+		Current:    true,
+		ExpiresAt:  current.ExpiresAt,
+		LastSeenAt: current.LastSeenAt,
+		CreatedAt:  current.CreatedAt,
+	}, output.Sessions[0])
+	assert.Equal(t, other.ID.String(), output.Sessions[1].ID)
+	assert.False(t, output.Sessions[1].Current)
+
+	_, err = listSessionsUseCase.Execute(ctx, ListSessionsUseCaseInputDTO{UserID: "invalid"})
+	assert.ErrorIs(t, err, ErrListSessionsInvalidData)
+}
+
+func Test_ListSessionsUseCase_Execute_WhenSessionsCannotBeRead(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	sessionRepository := entity.NewMockSessionRepositoryInterface(ctrl)
+	listSessionsUseCase := NewListSessionsUseCase(sessionRepository)
+
+	sessionRepository.EXPECT().FindActiveByUser(gomock.Any(), gomock.Any(), gomock.Any()).Return(nil, errors.New("")).Times(1)
+
+	output, err := listSessionsUseCase.Execute(context.Background(), ListSessionsUseCaseInputDTO{UserID: uuid.NewString()})
+	assert.Nil(t, output)
+	assert.ErrorIs(t, err, ErrListSessionsInternalError)
+}
@@ -0,0 +1,101 @@
+package usecase
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+
+	"github.com/sesaquecruz/go-auth-api/internal/entity"
+)
+
+var (
+	ErrListUsersInvalidData   = errors.New("invalid data")
+	ErrListUsersInternalError = errors.New("internal error")
+)
+
+const (
+	listUsersDefaultLimit = 20
+	listUsersMaxLimit     = 100
+)
+
+// Email, when set, looks up the only user with that email instead of listing. Otherwise users are
+// listed in creation order, filtered by EmailPrefix and Status, resuming after Cursor, the NextCursor
+// of the previous page. Limit defaults to 20 and is at most 100.
+type ListUsersUseCaseInputDTO struct {
+	Email       string   `json:"email"`
+	EmailPrefix string   `json:"email_prefix"`
+	Status      []string `json:"status"`
+	Cursor      string   `json:"cursor"`
+	Limit       int      `json:"limit"`
+}
+
+// NextCursor is empty on the last page. Total counts every user matching the filters.
+type ListUsersUseCaseOutputDTO struct {
+	Users      []FindUserUseCaseOutputDTO `json:"users"`
+	NextCursor string                     `json:"next_cursor,omitempty"`
+	Total      int64                      `json:"total"`
+}
+
+type ListUsersUseCase struct {
+	UserRepository entity.UserRepositoryInterface
+}
+
+func NewListUsersUseCase(ur entity.UserRepositoryInterface) *ListUsersUseCase {
+	return &ListUsersUseCase{UserRepository: ur}
+}
+
+func (uc *ListUsersUseCase) Execute(ctx context.Context, input ListUsersUseCaseInputDTO) (*ListUsersUseCaseOutputDTO, error) {
+	if input.Limit == 0 {
+		input.Limit = listUsersDefaultLimit
+	}
+	if input.Limit < 0 || input.Limit > listUsersMaxLimit {
+		return nil, ErrListUsersInvalidData
+	}
+
+	page := &entity.UserPage{}
+	if input.Email != "" {
+		user, err := uc.UserRepository.FindByEmail(ctx, input.Email)
+		if err != nil && err != sql.ErrNoRows {
+			return nil, ErrListUsersInternalError
+		}
+		if err == nil {
+			page.Users = append(page.Users, *user)
+			page.Total = 1
+		}
+	} else {
+		filter := entity.UserListFilter{EmailPrefix: input.EmailPrefix, Limit: input.Limit}
+		for _, value := range input.Status {
+			status := entity.UserStatus(value)
+			if !status.IsValid() {
+				return nil, ErrListUsersInvalidData
+			}
+			filter.Statuses = append(filter.Statuses, status)
+		}
+		if input.Cursor != "" {
+			cursor, err := entity.ParseUserCursor(input.Cursor)
+			if err != nil {
+				return nil, ErrListUsersInvalidData
+			}
+			filter.After = cursor
+		}
+
+		var err error
+		page, err = uc.UserRepository.List(ctx, filter)
+		if err != nil {
+			return nil, ErrListUsersInternalError
+		}
+	}
+
+	output := &ListUsersUseCaseOutputDTO{
+		Users: make([]FindUserUseCaseOutputDTO, 0, len(page.Users)),
+		Total: page.Total,
+	}
+	for i := range page.Users {
+		output.Users = append(output.Users, *newFindUserUseCaseOutputDTO(&page.Users[i]))
+	}
+	if page.Next != nil {
+		output.NextCursor = page.Next.String()
+	}
+
+	return output, nil
+}
@@ -0,0 +1,142 @@
+package usecase
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/sesaquecruz/go-auth-api/internal/entity"
+
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+)
+
+func Test_ListUsersUseCase_NewListUsersUseCase(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	userRepository := entity.NewMockUserRepositoryInterface(ctrl)
+	listUsersUseCase := NewListUsersUseCase(userRepository)
+	assert.NotNil(t, listUsersUseCase)
+	assert.Equal(t, userRepository, listUsersUseCase.UserRepository)
+}
+
+func Test_ListUsersUseCase_Execute(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	userRepository := entity.NewMockUserRepositoryInterface(ctrl)
+	listUsersUseCase := ListUsersUseCase{UserRepository: userRepository}
+
+	ctx := context.Background()
+	users := []entity.User{
+		{ID: uuid.New(), Email: "user1@mail.com", Status: entity.UserStatusActive},
+		{ID: uuid.New(), Email: "user2@mail.com", Status: entity.UserStatusSuspended},
+	}
+
+	next := entity.NewUserCursor(users[1])
+
+	userRepository.EXPECT().
+		List(ctx, entity.UserListFilter{Limit: 20}).
+		Return(&entity.UserPage{Users: users, Next: &next, Total: 5}, nil).
+		Times(1)
+
+	output, err := listUsersUseCase.Execute(ctx, ListUsersUseCaseInputDTO{})
+	assert.Nil(t, err)
+	assert.Len(t, output.Users, 2)
+	assert.Equal(t, users[0].ID.String(), output.Users[0].ID)
+	assert.Equal(t, users[1].Email, output.Users[1].Email)
+	assert.Equal(t, "suspended", output.Users[1].Status)
+	assert.Equal(t, next.String(), output.NextCursor)
+	assert.Equal(t, int64(5), output.Total)
+}
+
+func Test_ListUsersUseCase_Execute_WithFilters(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	userRepository := entity.NewMockUserRepositoryInterface(ctrl)
+	listUsersUseCase := ListUsersUseCase{UserRepository: userRepository}
+
+	ctx := context.Background()
+	cursor := entity.UserCursor{CreatedAt: entity.Now(), ID: uuid.New()}
+
+	userRepository.EXPECT().
+		List(ctx, gomock.Any()).
+		DoAndReturn(func(_ context.Context, filter entity.UserListFilter) (*entity.UserPage, error) {
+			assert.Equal(t, "user", filter.EmailPrefix)
+			assert.Equal(t, []entity.UserStatus{entity.UserStatusSuspended}, filter.Statuses)
+			assert.Equal(t, cursor.ID, filter.After.ID)
+			assert.True(t, cursor.CreatedAt.Equal(filter.After.CreatedAt))
+			assert.Equal(t, 5, filter.Limit)
+			return &entity.UserPage{}, nil
+		}).
+		Times(1)
+
+	output, err := listUsersUseCase.Execute(ctx, ListUsersUseCaseInputDTO{
+		EmailPrefix: "user",
+		Status:      []string{"suspended"},
+		Cursor:      cursor.String(),
+		Limit:       5,
+	})
+	assert.Nil(t, err)
+	assert.Empty(t, output.Users)
+	assert.Empty(t, output.NextCursor)
+}
+
+func Test_ListUsersUseCase_Execute_WhenEmailIsGiven(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	userRepository := entity.NewMockUserRepositoryInterface(ctrl)
+	listUsersUseCase := ListUsersUseCase{UserRepository: userRepository}
+
+	ctx := context.Background()
+	user := &entity.User{ID: uuid.New(), Email: "user@mail.com"}
+
+	userRepository.EXPECT().FindByEmail(ctx, user.Email).Return(user, nil).Times(1)
+	userRepository.EXPECT().FindByEmail(ctx, "other@mail.com").Return(nil, sql.ErrNoRows).Times(1)
+	userRepository.EXPECT().List(gomock.Any(), gomock.Any()).Times(0)
+
+	output, err := listUsersUseCase.Execute(ctx, ListUsersUseCaseInputDTO{Email: user.Email})
+	assert.Nil(t, err)
+	assert.Len(t, output.Users, 1)
+	assert.Equal(t, user.ID.String(), output.Users[0].ID)
+	assert.Equal(t, int64(1), output.Total)
+
+	output, err = listUsersUseCase.Execute(ctx, ListUsersUseCaseInputDTO{Email: "other@mail.com"})
+	assert.Nil(t, err)
+	assert.Empty(t, output.Users)
+}
+
+func Test_ListUsersUseCase_Execute_WhenPageIsInvalid(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	userRepository := entity.NewMockUserRepositoryInterface(ctrl)
+	listUsersUseCase := ListUsersUseCase{UserRepository: userRepository}
+
+	ctx := context.Background()
+	for _, input := range []ListUsersUseCaseInputDTO{{Limit: -1}, {Limit: 101}, {Status: []string{"gone"}}, {Cursor: "invalid"}} {
+		output, err := listUsersUseCase.Execute(ctx, input)
+		assert.Nil(t, output)
+		assert.ErrorIs(t, err, ErrListUsersInvalidData)
+	}
+}
+
+func Test_ListUsersUseCase_Execute_WhenRepositoryFails(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	userRepository := entity.NewMockUserRepositoryInterface(ctrl)
+	listUsersUseCase := ListUsersUseCase{UserRepository: userRepository}
+
+	ctx := context.Background()
+	userRepository.EXPECT().List(ctx, entity.UserListFilter{Limit: 10}).Return(nil, errors.New("")).Times(1)
+
+	output, err := listUsersUseCase.Execute(ctx, ListUsersUseCaseInputDTO{Limit: 10})
+	assert.Nil(t, output)
+	assert.ErrorIs(t, err, ErrListUsersInternalError)
+}
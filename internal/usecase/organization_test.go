@@ -0,0 +1,66 @@
+package usecase
+
+import (
+	"context"
+	"testing"
+
+	"github.com/sesaquecruz/go-auth-api/internal/entity"
+	"github.com/sesaquecruz/go-auth-api/internal/infra/database/memory"
+
+	"github.com/stretchr/testify/require"
+)
+
+// organizationFixture is an organization owned by owner, with the users of the memory repositories.
+type organizationFixture struct {
+	ctx                    context.Context
+	userRepository         *memory.UserRepository
+	organizationRepository *memory.OrganizationRepository
+	invitationRepository   *memory.InvitationRepository
+	transactionManager     *memory.TransactionManager
+	organization           *entity.Organization
+	owner                  *entity.User
+}
+
+func newOrganizationFixture(t *testing.T) *organizationFixture {
+	f := &organizationFixture{
+		ctx:                    context.Background(),
+		userRepository:         memory.NewUserRepository(),
+		organizationRepository: memory.NewOrganizationRepository(),
+		invitationRepository:   memory.NewInvitationRepository(),
+	}
+	f.transactionManager = memory.NewTransactionManager(f.userRepository, f.organizationRepository, f.invitationRepository)
+
+	var err error
+	f.organization, err = entity.NewOrganization("Acme")
+	require.Nil(t, err)
+	require.Nil(t, f.organizationRepository.Save(f.ctx, *f.organization))
+
+	f.owner = f.addMember(t, "owner@mail.com", entity.OrganizationRoleOwner)
+	return f
+}
+
+// addUser saves a user who is not a member of the organization.
+func (f *organizationFixture) addUser(t *testing.T, email string) *entity.User {
+	user, err := entity.NewUserFactory().NewUser(email, "12345")
+	require.Nil(t, err)
+	require.Nil(t, f.userRepository.Save(f.ctx, *user))
+	return user
+}
+
+func (f *organizationFixture) addMember(t *testing.T, email string, role entity.OrganizationRole) *entity.User {
+	user := f.addUser(t, email)
+	err := f.organizationRepository.AddMember(f.ctx, entity.Membership{
+		OrganizationID: f.organization.ID,
+		UserID:         user.ID,
+		Role:           role,
+		CreatedAt:      entity.Now(),
+	})
+	require.Nil(t, err)
+	return user
+}
+
+func (f *organizationFixture) role(t *testing.T, user *entity.User) entity.OrganizationRole {
+	member, err := f.organizationRepository.FindMember(f.ctx, f.organization.ID, user.ID)
+	require.Nil(t, err)
+	return member.Role
+}
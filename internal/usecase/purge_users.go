@@ -0,0 +1,41 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/sesaquecruz/go-auth-api/internal/entity"
+)
+
+var ErrPurgeUsersInternalError = errors.New("internal error")
+
+type PurgeUsersUseCaseOutputDTO struct {
+	Purged int64 `json:"purged"`
+}
+
+// PurgeUsersUseCase permanently removes the users deleted longer than GracePeriod ago.
+type PurgeUsersUseCase struct {
+	UserRepository entity.UserRepositoryInterface
+	GracePeriod    time.Duration
+}
+
+func NewPurgeUsersUseCase(ur entity.UserRepositoryInterface, gracePeriod time.Duration) *PurgeUsersUseCase {
+	return &PurgeUsersUseCase{
+		UserRepository: ur,
+		GracePeriod:    gracePeriod,
+	}
+}
+
+func (uc *PurgeUsersUseCase) Execute(ctx context.Context) (*PurgeUsersUseCaseOutputDTO, error) {
+	purged, err := uc.UserRepository.Purge(ctx, entity.Now().Add(-uc.GracePeriod))
+	if err != nil {
+		return nil, ErrPurgeUsersInternalError
+	}
+
+	output := &PurgeUsersUseCaseOutputDTO{
+		Purged: purged,
+	}
+
+	return output, nil
+}
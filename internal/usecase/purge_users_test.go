@@ -0,0 +1,64 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/sesaquecruz/go-auth-api/internal/entity"
+
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+)
+
+func Test_PurgeUsersUseCase_NewPurgeUsersUseCase(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	userRepository := entity.NewMockUserRepositoryInterface(ctrl)
+
+	purgeUsersUseCase := NewPurgeUsersUseCase(userRepository, time.Hour)
+	assert.NotNil(t, purgeUsersUseCase)
+	assert.Equal(t, userRepository, purgeUsersUseCase.UserRepository)
+	assert.Equal(t, time.Hour, purgeUsersUseCase.GracePeriod)
+}
+
+func Test_PurgeUsersUseCase_Execute(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	userRepository := entity.NewMockUserRepositoryInterface(ctrl)
+	purgeUsersUseCase := PurgeUsersUseCase{UserRepository: userRepository, GracePeriod: time.Hour}
+
+	ctx := context.Background()
+	before := entity.Now().Add(-time.Hour)
+
+	userRepository.EXPECT().
+		Purge(ctx, gomock.Any()).
+		DoAndReturn(func(_ context.Context, deletedBefore time.Time) (int64, error) {
+			assert.False(t, deletedBefore.Before(before))
+			assert.True(t, deletedBefore.Before(entity.Now().Add(-time.Hour+time.Second)))
+			return 2, nil
+		}).
+		Times(1)
+
+	output, err := purgeUsersUseCase.Execute(ctx)
+	assert.Nil(t, err)
+	assert.Equal(t, int64(2), output.Purged)
+}
+
+func Test_PurgeUsersUseCase_Execute_WhenRepositoryFails(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	userRepository := entity.NewMockUserRepositoryInterface(ctrl)
+	purgeUsersUseCase := PurgeUsersUseCase{UserRepository: userRepository, GracePeriod: time.Hour}
+
+	ctx := context.Background()
+	userRepository.EXPECT().Purge(ctx, gomock.Any()).Return(int64(0), errors.New("")).Times(1)
+
+	output, err := purgeUsersUseCase.Execute(ctx)
+	assert.Nil(t, output)
+	assert.ErrorIs(t, err, ErrPurgeUsersInternalError)
+}
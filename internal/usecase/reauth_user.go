@@ -0,0 +1,58 @@
+package usecase
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/sesaquecruz/go-auth-api/internal/entity"
+)
+
+var (
+	ErrReauthUserInvalidData        = errors.New("invalid data")
+	ErrReauthUserInvalidCredentials = errors.New("invalid credentials")
+	ErrReauthUserInternalError      = errors.New("internal error")
+)
+
+type ReauthUserUseCaseInputDTO struct {
+	ID       string `json:"id"`
+	Password string `json:"password"`
+}
+
+type ReauthUserUseCaseOutputDTO struct {
+	AuthTime time.Time `json:"auth_time"`
+}
+
+// ReauthUserUseCase checks the password of an already authenticated user again, telling when it did.
+type ReauthUserUseCase struct {
+	UserRepository entity.UserRepositoryInterface
+}
+
+func NewReauthUserUseCase(ur entity.UserRepositoryInterface) *ReauthUserUseCase {
+	return &ReauthUserUseCase{UserRepository: ur}
+}
+
+func (uc *ReauthUserUseCase) Execute(ctx context.Context, input ReauthUserUseCaseInputDTO) (*ReauthUserUseCaseOutputDTO, error) {
+	id, err := uuid.Parse(input.ID)
+	if err != nil || input.Password == "" {
+		return nil, ErrReauthUserInvalidData
+	}
+
+	user, err := uc.UserRepository.FindById(ctx, id)
+	if err != nil {
+		if err == sql.ErrNoRows {
+			entity.SimulatePasswordVerification(input.Password)
+			return nil, ErrReauthUserInvalidCredentials
+		}
+		return nil, ErrReauthUserInternalError
+	}
+
+	err = user.VerifyPassword(input.Password)
+	if err != nil {
+		return nil, ErrReauthUserInvalidCredentials
+	}
+
+	return &ReauthUserUseCaseOutputDTO{AuthTime: entity.Now()}, nil
+}
@@ -0,0 +1,84 @@
+package usecase
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/golang/mock/gomock"
+	"github.com/google/uuid"
+	"github.com/sesaquecruz/go-auth-api/internal/entity"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func Test_ReauthUserUseCase_NewReauthUserUseCase(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	userRepository := entity.NewMockUserRepositoryInterface(ctrl)
+	reauthUserUseCase := NewReauthUserUseCase(userRepository)
+	assert.NotNil(t, reauthUserUseCase)
+	assert.Equal(t, userRepository, reauthUserUseCase.UserRepository)
+}
+
+func Test_ReauthUserUseCase_Execute(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	user, err := entity.NewUserFactory().NewUser("user@mail.com", "12345")
+	require.Nil(t, err)
+
+	userRepository := entity.NewMockUserRepositoryInterface(ctrl)
+	userRepository.EXPECT().FindById(gomock.Any(), user.ID).Return(user, nil).Times(2)
+	reauthUserUseCase := NewReauthUserUseCase(userRepository)
+
+	ctx := context.Background()
+	output, err := reauthUserUseCase.Execute(ctx, ReauthUserUseCaseInputDTO{ID: user.ID.String(), Password: "12345"})
+	assert.Nil(t, err)
+	assert.WithinDuration(t, time.Now(), output.AuthTime, time.Second)
+
+	output, err = reauthUserUseCase.Execute(ctx, ReauthUserUseCaseInputDTO{ID: user.ID.String(), Password: "54321"})
+	assert.Nil(t, output)
+	assert.ErrorIs(t, err, ErrReauthUserInvalidCredentials)
+}
+
+func Test_ReauthUserUseCase_Execute_WhenInputIsInvalid(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	userRepository := entity.NewMockUserRepositoryInterface(ctrl)
+	userRepository.EXPECT().FindById(gomock.Any(), gomock.Any()).Times(0)
+	reauthUserUseCase := NewReauthUserUseCase(userRepository)
+
+	for _, input := range []ReauthUserUseCaseInputDTO{
+		{ID: "user", Password: "12345"},
+		{ID: uuid.NewString(), Password: ""},
+	} {
+		output, err := reauthUserUseCase.Execute(context.Background(), input)
+		assert.Nil(t, output)
+		assert.ErrorIs(t, err, ErrReauthUserInvalidData)
+	}
+}
+
+func Test_ReauthUserUseCase_Execute_WhenRepositoryFails(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	userRepository := entity.NewMockUserRepositoryInterface(ctrl)
+	userRepository.EXPECT().FindById(gomock.Any(), gomock.Any()).Return(nil, sql.ErrNoRows).Times(1)
+	userRepository.EXPECT().FindById(gomock.Any(), gomock.Any()).Return(nil, errors.New("")).Times(1)
+	reauthUserUseCase := NewReauthUserUseCase(userRepository)
+
+	input := ReauthUserUseCaseInputDTO{ID: uuid.NewString(), Password: "12345"}
+
+	output, err := reauthUserUseCase.Execute(context.Background(), input)
+	assert.Nil(t, output)
+	assert.ErrorIs(t, err, ErrReauthUserInvalidCredentials)
+
+	output, err = reauthUserUseCase.Execute(context.Background(), input)
+	assert.Nil(t, output)
+	assert.ErrorIs(t, err, ErrReauthUserInternalError)
+}
@@ -0,0 +1,99 @@
+package usecase
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+
+	"github.com/google/uuid"
+	"github.com/sesaquecruz/go-auth-api/internal/entity"
+)
+
+var (
+	ErrRemoveMemberInvalidData           = errors.New("invalid data")
+	ErrRemoveMemberOrganizationNotExists = errors.New("organization not exists")
+	ErrRemoveMemberMemberNotExists       = errors.New("member not exists")
+	ErrRemoveMemberForbidden             = errors.New("forbidden")
+	ErrRemoveMemberLastOwner             = errors.New("last owner")
+	ErrRemoveMemberInternalError         = errors.New("internal error")
+)
+
+type RemoveMemberUseCaseInputDTO struct {
+	OrganizationID string `json:"organization_id"`
+	UserID         string `json:"user_id"`
+	MemberID       string `json:"member_id"`
+}
+
+// RemoveMemberUseCase removes a member from an organization. Members can always leave, others must be able
+// to manage the member's role, and the last owner of an organization can not be removed.
+type RemoveMemberUseCase struct {
+	OrganizationRepository entity.OrganizationRepositoryInterface
+	TransactionManager     entity.TransactionManagerInterface
+}
+
+func NewRemoveMemberUseCase(or entity.OrganizationRepositoryInterface, tm entity.TransactionManagerInterface) *RemoveMemberUseCase {
+	return &RemoveMemberUseCase{
+		OrganizationRepository: or,
+		TransactionManager:     tm,
+	}
+}
+
+func (uc *RemoveMemberUseCase) Execute(ctx context.Context, input RemoveMemberUseCaseInputDTO) error {
+	organizationID, err := uuid.Parse(input.OrganizationID)
+	if err != nil {
+		return ErrRemoveMemberInvalidData
+	}
+
+	userID, err := uuid.Parse(input.UserID)
+	if err != nil {
+		return ErrRemoveMemberInvalidData
+	}
+
+	memberID, err := uuid.Parse(input.MemberID)
+	if err != nil {
+		return ErrRemoveMemberInvalidData
+	}
+
+	err = uc.TransactionManager.Do(ctx, func(ctx context.Context) error {
+		return uc.remove(ctx, organizationID, userID, memberID)
+	})
+	switch err {
+	case nil, ErrRemoveMemberOrganizationNotExists, ErrRemoveMemberMemberNotExists, ErrRemoveMemberForbidden, ErrRemoveMemberLastOwner:
+		return err
+	default:
+		return ErrRemoveMemberInternalError
+	}
+}
+
+func (uc *RemoveMemberUseCase) remove(ctx context.Context, organizationID uuid.UUID, userID uuid.UUID, memberID uuid.UUID) error {
+	actor, err := uc.OrganizationRepository.FindMember(ctx, organizationID, userID)
+	if err == sql.ErrNoRows {
+		return ErrRemoveMemberOrganizationNotExists
+	}
+	if err != nil {
+		return err
+	}
+
+	member, err := uc.OrganizationRepository.FindMember(ctx, organizationID, memberID)
+	if err == sql.ErrNoRows {
+		return ErrRemoveMemberMemberNotExists
+	}
+	if err != nil {
+		return err
+	}
+	if actor.UserID != member.UserID && !actor.Role.CanManage(member.Role) {
+		return ErrRemoveMemberForbidden
+	}
+
+	if member.Role == entity.OrganizationRoleOwner {
+		last, err := isLastOwner(ctx, uc.OrganizationRepository, organizationID)
+		if err != nil {
+			return err
+		}
+		if last {
+			return ErrRemoveMemberLastOwner
+		}
+	}
+
+	return uc.OrganizationRepository.RemoveMember(ctx, organizationID, memberID)
+}
@@ -0,0 +1,78 @@
+package usecase
+
+import (
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/sesaquecruz/go-auth-api/internal/entity"
+
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+)
+
+func Test_RemoveMemberUseCase_NewRemoveMemberUseCase(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	organizationRepository := entity.NewMockOrganizationRepositoryInterface(ctrl)
+	transactionManager := entity.NewMockTransactionManagerInterface(ctrl)
+
+	removeMemberUseCase := NewRemoveMemberUseCase(organizationRepository, transactionManager)
+	assert.NotNil(t, removeMemberUseCase)
+	assert.Equal(t, organizationRepository, removeMemberUseCase.OrganizationRepository)
+	assert.Equal(t, transactionManager, removeMemberUseCase.TransactionManager)
+}
+
+func Test_RemoveMemberUseCase_Execute(t *testing.T) {
+	f := newOrganizationFixture(t)
+	admin := f.addMember(t, "admin@mail.com", entity.OrganizationRoleAdmin)
+	member := f.addMember(t, "member@mail.com", entity.OrganizationRoleMember)
+	removeMemberUseCase := NewRemoveMemberUseCase(f.organizationRepository, f.transactionManager)
+
+	for _, input := range []RemoveMemberUseCaseInputDTO{
+		{OrganizationID: f.organization.ID.String(), UserID: admin.ID.String(), MemberID: member.ID.String()},
+		{OrganizationID: f.organization.ID.String(), UserID: admin.ID.String(), MemberID: admin.ID.String()},
+	} {
+		err := removeMemberUseCase.Execute(f.ctx, input)
+		assert.Nil(t, err)
+	}
+
+	members, err := f.organizationRepository.ListMembers(f.ctx, f.organization.ID)
+	assert.Nil(t, err)
+	assert.Len(t, members, 1)
+}
+
+func Test_RemoveMemberUseCase_Execute_WhenNotAllowed(t *testing.T) {
+	f := newOrganizationFixture(t)
+	admin := f.addMember(t, "admin@mail.com", entity.OrganizationRoleAdmin)
+	member := f.addMember(t, "member@mail.com", entity.OrganizationRoleMember)
+	outsider := f.addUser(t, "outsider@mail.com")
+	removeMemberUseCase := NewRemoveMemberUseCase(f.organizationRepository, f.transactionManager)
+
+	tests := []struct {
+		actor    *entity.User
+		memberID uuid.UUID
+		expected error
+	}{
+		{admin, f.owner.ID, ErrRemoveMemberForbidden},
+		{member, admin.ID, ErrRemoveMemberForbidden},
+		{f.owner, f.owner.ID, ErrRemoveMemberLastOwner},
+		{f.owner, outsider.ID, ErrRemoveMemberMemberNotExists},
+		{outsider, member.ID, ErrRemoveMemberOrganizationNotExists},
+	}
+	for _, test := range tests {
+		err := removeMemberUseCase.Execute(f.ctx, RemoveMemberUseCaseInputDTO{
+			OrganizationID: f.organization.ID.String(),
+			UserID:         test.actor.ID.String(),
+			MemberID:       test.memberID.String(),
+		})
+		assert.ErrorIs(t, err, test.expected, test.actor.Email)
+	}
+
+	err := removeMemberUseCase.Execute(f.ctx, RemoveMemberUseCaseInputDTO{OrganizationID: "invalid", UserID: f.owner.ID.String(), MemberID: member.ID.String()})
+	assert.ErrorIs(t, err, ErrRemoveMemberInvalidData)
+
+	members, err := f.organizationRepository.ListMembers(f.ctx, f.organization.ID)
+	assert.Nil(t, err)
+	assert.Len(t, members, 3)
+}
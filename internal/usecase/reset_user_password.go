@@ -0,0 +1,125 @@
+package usecase
+
+import (
+	"context"
+	"crypto/rand"
+	"database/sql"
+	"encoding/base64"
+	"errors"
+	"fmt"
+
+	"github.com/google/uuid"
+	"github.com/sesaquecruz/go-auth-api/internal/entity"
+)
+
+var (
+	ErrResetUserPasswordInvalidData     = errors.New("invalid data")
+	ErrResetUserPasswordUserNotExists   = errors.New("user not exists")
+	ErrResetUserPasswordVersionConflict = errors.New("version conflict")
+	ErrResetUserPasswordInternalError   = errors.New("internal error")
+)
+
+const (
+	resetUserPasswordTemporaryPasswordLen = 12
+	resetUserPasswordSubject              = "Your password was reset"
+	resetUserPasswordBody                 = "An administrator reset the password of your account. " +
+		"Log in with the temporary password below and change it right away.\n\n%s\n"
+)
+
+type ResetUserPasswordUseCaseInputDTO struct {
+	ID string `json:"id"`
+}
+
+// ResetUserPasswordUseCase replaces the password of a user with a random one and emails it to them, which
+// revokes every session of the user. The email is sent first, out of the transaction, and the password is
+// only stored once it was sent. A user changed meanwhile keeps their password, as a version conflict.
+type ResetUserPasswordUseCase struct {
+	UserFactory        entity.UserFactoryInterface
+	UserRepository     entity.UserRepositoryInterface
+	SessionRepository  entity.SessionRepositoryInterface
+	Mailer             entity.MailerInterface
+	TransactionManager entity.TransactionManagerInterface
+}
+
+func NewResetUserPasswordUseCase(
+	uf entity.UserFactoryInterface,
+	ur entity.UserRepositoryInterface,
+	sr entity.SessionRepositoryInterface,
+	m entity.MailerInterface,
+	tm entity.TransactionManagerInterface,
+) *ResetUserPasswordUseCase {
+	return &ResetUserPasswordUseCase{
+		UserFactory:        uf,
+		UserRepository:     ur,
+		SessionRepository:  sr,
+		Mailer:             m,
+		TransactionManager: tm,
+	}
+}
+
+func (uc *ResetUserPasswordUseCase) Execute(ctx context.Context, input ResetUserPasswordUseCaseInputDTO) error {
+	id, err := uuid.Parse(input.ID)
+	if err != nil {
+		return ErrResetUserPasswordInvalidData
+	}
+
+	password, err := temporaryPassword()
+	if err != nil {
+		return ErrResetUserPasswordInternalError
+	}
+
+	stored, err := uc.UserRepository.FindById(ctx, id)
+	if err == sql.ErrNoRows {
+		return ErrResetUserPasswordUserNotExists
+	}
+	if err != nil {
+		return ErrResetUserPasswordInternalError
+	}
+
+	err = uc.Mailer.Send(ctx, stored.Email, resetUserPasswordSubject, fmt.Sprintf(resetUserPasswordBody, password))
+	if err != nil {
+		return ErrResetUserPasswordInternalError
+	}
+
+	err = uc.TransactionManager.Do(ctx, func(ctx context.Context) error {
+		return uc.reset(ctx, stored, password)
+	})
+	switch err {
+	case nil, ErrResetUserPasswordVersionConflict:
+		return err
+	default:
+		return ErrResetUserPasswordInternalError
+	}
+}
+
+// reset stores password unless the user changed since stored was read.
+func (uc *ResetUserPasswordUseCase) reset(ctx context.Context, stored *entity.User, password string) error {
+	user, err := uc.UserFactory.GetUser(stored.ID.String(), stored.Email, password)
+	if err != nil {
+		return err
+	}
+
+	user.Version = stored.Version
+	user.CreatedAt = stored.CreatedAt
+	user.UpdatedAt = entity.Now()
+	user.PasswordChangedAt = user.UpdatedAt
+
+	err = uc.UserRepository.Update(ctx, *user)
+	if errors.Is(err, entity.ErrUserVersionConflict) {
+		return ErrResetUserPasswordVersionConflict
+	}
+	if err != nil {
+		return err
+	}
+
+	return uc.SessionRepository.RevokeByUser(ctx, user.ID, user.UpdatedAt)
+}
+
+func temporaryPassword() (string, error) {
+	b := make([]byte, resetUserPasswordTemporaryPasswordLen)
+	_, err := rand.Read(b)
+	if err != nil {
+		return "", err
+	}
+	return base64.RawURLEncoding.EncodeToString(b), nil
+}
@@ -0,0 +1,224 @@
+package usecase
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/sesaquecruz/go-auth-api/internal/entity"
+	"github.com/sesaquecruz/go-auth-api/internal/infra/database/memory"
+
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func Test_ResetUserPasswordUseCase_NewResetUserPasswordUseCase(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	userFactory := entity.NewMockUserFactoryInterface(ctrl)
+	userRepository := entity.NewMockUserRepositoryInterface(ctrl)
+	sessionRepository := entity.NewMockSessionRepositoryInterface(ctrl)
+	mailer := entity.NewMockMailerInterface(ctrl)
+	transactionManager := entity.NewMockTransactionManagerInterface(ctrl)
+
+	resetUserPasswordUseCase := NewResetUserPasswordUseCase(userFactory, userRepository, sessionRepository, mailer, transactionManager)
+	assert.NotNil(t, resetUserPasswordUseCase)
+	assert.Equal(t, userFactory, resetUserPasswordUseCase.UserFactory)
+	assert.Equal(t, userRepository, resetUserPasswordUseCase.UserRepository)
+	assert.Equal(t, sessionRepository, resetUserPasswordUseCase.SessionRepository)
+	assert.Equal(t, mailer, resetUserPasswordUseCase.Mailer)
+	assert.Equal(t, transactionManager, resetUserPasswordUseCase.TransactionManager)
+}
+
+func Test_ResetUserPasswordUseCase_Execute(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	userRepository := memory.NewUserRepository()
+	sessionRepository := memory.NewSessionRepository()
+	mailer := entity.NewMockMailerInterface(ctrl)
+	resetUserPasswordUseCase := NewResetUserPasswordUseCase(
+		entity.NewUserFactory(),
+		userRepository,
+		sessionRepository,
+		mailer,
+		memory.NewTransactionManager(userRepository, sessionRepository),
+	)
+
+	ctx := context.Background()
+	user, err := entity.NewUserFactory().NewUser("user@mail.com", "12345")
+	require.Nil(t, err)
+	require.Nil(t, userRepository.Save(ctx, *user))
+	session, err := entity.NewSession(user.ID, "", "", time.Hour)
+	require.Nil(t, err)
+	require.Nil(t, sessionRepository.Save(ctx, *session))
+
+	var body string
+	mailer.EXPECT().
+		Send(gomock.Any(), user.Email, gomock.Any(), gomock.Any()).
+		DoAndReturn(func(_ context.Context, _ string, _ string, b string) error {
+			body = b
+			return nil
+		}).
+		Times(1)
+
+	err = resetUserPasswordUseCase.Execute(ctx, ResetUserPasswordUseCaseInputDTO{ID: user.ID.String()})
+	assert.Nil(t, err)
+
+	lines := strings.Split(strings.TrimSpace(body), "\n")
+	password := lines[len(lines)-1]
+
+	stored, err := userRepository.FindById(ctx, user.ID)
+	require.Nil(t, err)
+	assert.Nil(t, stored.VerifyPassword(password))
+	assert.NotNil(t, stored.VerifyPassword("12345"))
+	assert.Equal(t, stored.UpdatedAt, stored.PasswordChangedAt)
+	assert.Equal(t, user.Version+1, stored.Version)
+
+	sessions, err := sessionRepository.FindActiveByUser(ctx, user.ID, entity.Now())
+	require.Nil(t, err)
+	assert.Empty(t, sessions)
+}
+
+func Test_ResetUserPasswordUseCase_Execute_WhenEmailFails(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	userRepository := memory.NewUserRepository()
+	sessionRepository := memory.NewSessionRepository()
+	mailer := entity.NewMockMailerInterface(ctrl)
+	resetUserPasswordUseCase := NewResetUserPasswordUseCase(
+		entity.NewUserFactory(),
+		userRepository,
+		sessionRepository,
+		mailer,
+		memory.NewTransactionManager(userRepository, sessionRepository),
+	)
+
+	ctx := context.Background()
+	user, err := entity.NewUserFactory().NewUser("user@mail.com", "12345")
+	require.Nil(t, err)
+	require.Nil(t, userRepository.Save(ctx, *user))
+	session, err := entity.NewSession(user.ID, "", "", time.Hour)
+	require.Nil(t, err)
+	require.Nil(t, sessionRepository.Save(ctx, *session))
+
+	mailer.EXPECT().Send(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).Return(errors.New("")).Times(1)
+
+	err = resetUserPasswordUseCase.Execute(ctx, ResetUserPasswordUseCaseInputDTO{ID: user.ID.String()})
+	assert.ErrorIs(t, err, ErrResetUserPasswordInternalError)
+
+	stored, err := userRepository.FindById(ctx, user.ID)
+	require.Nil(t, err)
+	assert.Nil(t, stored.VerifyPassword("12345"))
+	assert.Equal(t, user.Version, stored.Version)
+
+	sessions, err := sessionRepository.FindActiveByUser(ctx, user.ID, entity.Now())
+	require.Nil(t, err)
+	assert.Len(t, sessions, 1)
+}
+
+func Test_ResetUserPasswordUseCase_Execute_WhenUserChangesMeanwhile(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	userRepository := memory.NewUserRepository()
+	sessionRepository := memory.NewSessionRepository()
+	mailer := entity.NewMockMailerInterface(ctrl)
+	resetUserPasswordUseCase := NewResetUserPasswordUseCase(
+		entity.NewUserFactory(),
+		userRepository,
+		sessionRepository,
+		mailer,
+		memory.NewTransactionManager(userRepository, sessionRepository),
+	)
+
+	ctx := context.Background()
+	user, err := entity.NewUserFactory().NewUser("user@mail.com", "12345")
+	require.Nil(t, err)
+	require.Nil(t, userRepository.Save(ctx, *user))
+
+	mailer.EXPECT().
+		Send(gomock.Any(), user.Email, gomock.Any(), gomock.Any()).
+		DoAndReturn(func(_ context.Context, _ string, _ string, _ string) error {
+			changed := *user
+			changed.UpdatedAt = entity.Now()
+			return userRepository.Update(ctx, changed)
+		}).
+		Times(1)
+
+	err = resetUserPasswordUseCase.Execute(ctx, ResetUserPasswordUseCaseInputDTO{ID: user.ID.String()})
+	assert.ErrorIs(t, err, ErrResetUserPasswordVersionConflict)
+
+	stored, err := userRepository.FindById(ctx, user.ID)
+	require.Nil(t, err)
+	assert.Nil(t, stored.VerifyPassword("12345"))
+}
+
+func Test_ResetUserPasswordUseCase_Execute_SendsEmailOutOfTransaction(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	userRepository := memory.NewUserRepository()
+	sessionRepository := memory.NewSessionRepository()
+	transactionManager := entity.NewMockTransactionManagerInterface(ctrl)
+	mailer := entity.NewMockMailerInterface(ctrl)
+	resetUserPasswordUseCase := NewResetUserPasswordUseCase(entity.NewUserFactory(), userRepository, sessionRepository, mailer, transactionManager)
+
+	ctx := context.Background()
+	user, err := entity.NewUserFactory().NewUser("user@mail.com", "12345")
+	require.Nil(t, err)
+	require.Nil(t, userRepository.Save(ctx, *user))
+
+	inTransaction := false
+	transactionManager.EXPECT().
+		Do(gomock.Any(), gomock.Any()).
+		DoAndReturn(func(ctx context.Context, fn func(ctx context.Context) error) error {
+			inTransaction = true
+			defer func() { inTransaction = false }()
+			return fn(ctx)
+		}).
+		Times(1)
+	mailer.EXPECT().
+		Send(gomock.Any(), user.Email, gomock.Any(), gomock.Any()).
+		DoAndReturn(func(_ context.Context, _ string, _ string, _ string) error {
+			assert.False(t, inTransaction)
+			return nil
+		}).
+		Times(1)
+
+	err = resetUserPasswordUseCase.Execute(ctx, ResetUserPasswordUseCaseInputDTO{ID: user.ID.String()})
+	assert.Nil(t, err)
+}
+
+func Test_ResetUserPasswordUseCase_Execute_WhenUserNotExists(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	userRepository := entity.NewMockUserRepositoryInterface(ctrl)
+	resetUserPasswordUseCase := ResetUserPasswordUseCase{
+		UserRepository:     userRepository,
+		TransactionManager: newTransactionManager(ctrl),
+	}
+
+	ctx := context.Background()
+	id := uuid.New()
+
+	userRepository.EXPECT().FindById(ctx, id).Return(nil, sql.ErrNoRows).Times(1)
+
+	err := resetUserPasswordUseCase.Execute(ctx, ResetUserPasswordUseCaseInputDTO{ID: id.String()})
+	assert.ErrorIs(t, err, ErrResetUserPasswordUserNotExists)
+}
+
+func Test_ResetUserPasswordUseCase_Execute_WhenIdIsInvalid(t *testing.T) {
+	resetUserPasswordUseCase := ResetUserPasswordUseCase{}
+
+	err := resetUserPasswordUseCase.Execute(context.Background(), ResetUserPasswordUseCaseInputDTO{ID: "invalid"})
+	assert.ErrorIs(t, err, ErrResetUserPasswordInvalidData)
+}
@@ -0,0 +1,139 @@
+package usecase
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+
+	"github.com/google/uuid"
+	"github.com/sesaquecruz/go-auth-api/internal/entity"
+)
+
+var (
+	ErrRespondInvitationInvalidData   = errors.New("invalid data")
+	ErrRespondInvitationInvalidToken  = errors.New("invalid invitation")
+	ErrRespondInvitationExpired       = errors.New("invitation expired")
+	ErrRespondInvitationNotExists     = errors.New("invitation not exists")
+	ErrRespondInvitationForbidden     = errors.New("invitation for another email")
+	ErrRespondInvitationAlreadyMember = errors.New("already a member")
+	ErrRespondInvitationInternalError = errors.New("internal error")
+)
+
+type RespondInvitationUseCaseInputDTO struct {
+	Token  string `json:"token"`
+	UserID string `json:"user_id"`
+	Accept bool   `json:"accept"`
+}
+
+type RespondInvitationUseCaseOutputDTO struct {
+	OrganizationID string `json:"organization_id"`
+	Role           string `json:"role"`
+}
+
+// RespondInvitationUseCase accepts or declines an invitation for the user it was sent to. Either way
+// the invitation can not be used again.
+type RespondInvitationUseCase struct {
+	UserRepository         entity.UserRepositoryInterface
+	OrganizationRepository entity.OrganizationRepositoryInterface
+	InvitationRepository   entity.InvitationRepositoryInterface
+	InvitationSigner       entity.InvitationSignerInterface
+	TransactionManager     entity.TransactionManagerInterface
+}
+
+func NewRespondInvitationUseCase(
+	ur entity.UserRepositoryInterface,
+	or entity.OrganizationRepositoryInterface,
+	ir entity.InvitationRepositoryInterface,
+	is entity.InvitationSignerInterface,
+	tm entity.TransactionManagerInterface,
+) *RespondInvitationUseCase {
+	return &RespondInvitationUseCase{
+		UserRepository:         ur,
+		OrganizationRepository: or,
+		InvitationRepository:   ir,
+		InvitationSigner:       is,
+		TransactionManager:     tm,
+	}
+}
+
+func (uc *RespondInvitationUseCase) Execute(ctx context.Context, input RespondInvitationUseCaseInputDTO) (*RespondInvitationUseCaseOutputDTO, error) {
+	userID, err := uuid.Parse(input.UserID)
+	if err != nil {
+		return nil, ErrRespondInvitationInvalidData
+	}
+
+	id, err := uc.InvitationSigner.Verify(input.Token)
+	if errors.Is(err, entity.ErrInvitationExpired) {
+		return nil, ErrRespondInvitationExpired
+	}
+	if err != nil {
+		return nil, ErrRespondInvitationInvalidToken
+	}
+
+	var invitation *entity.Invitation
+	err = uc.TransactionManager.Do(ctx, func(ctx context.Context) error {
+		var err error
+		invitation, err = uc.respond(ctx, id, userID, input.Accept)
+		return err
+	})
+	switch err {
+	case nil:
+	case ErrRespondInvitationExpired, ErrRespondInvitationNotExists, ErrRespondInvitationForbidden, ErrRespondInvitationAlreadyMember:
+		return nil, err
+	default:
+		return nil, ErrRespondInvitationInternalError
+	}
+
+	output := &RespondInvitationUseCaseOutputDTO{
+		OrganizationID: invitation.OrganizationID.String(),
+		Role:           string(invitation.Role),
+	}
+
+	return output, nil
+}
+
+func (uc *RespondInvitationUseCase) respond(ctx context.Context, id uuid.UUID, userID uuid.UUID, accept bool) (*entity.Invitation, error) {
+	invitation, err := uc.InvitationRepository.FindById(ctx, id)
+	if err == sql.ErrNoRows {
+		return nil, ErrRespondInvitationNotExists
+	}
+	if err != nil {
+		return nil, err
+	}
+	if invitation.IsExpired(entity.Now()) {
+		return nil, ErrRespondInvitationExpired
+	}
+
+	user, err := uc.UserRepository.FindById(ctx, userID)
+	if err == sql.ErrNoRows {
+		return nil, ErrRespondInvitationForbidden
+	}
+	if err != nil {
+		return nil, err
+	}
+	if !invitation.IsFor(user.Email) {
+		return nil, ErrRespondInvitationForbidden
+	}
+
+	if accept {
+		err = uc.OrganizationRepository.AddMember(ctx, entity.Membership{
+			OrganizationID: invitation.OrganizationID,
+			UserID:         user.ID,
+			Role:           invitation.Role,
+			CreatedAt:      entity.Now(),
+		})
+		if errors.Is(err, entity.ErrMembershipAlreadyExists) {
+			return nil, ErrRespondInvitationAlreadyMember
+		}
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	err = uc.InvitationRepository.Delete(ctx, invitation.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	return invitation, nil
+}
@@ -0,0 +1,139 @@
+package usecase
+
+import (
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/sesaquecruz/go-auth-api/internal/entity"
+	"github.com/sesaquecruz/go-auth-api/internal/infra/signing"
+
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func newRespondInvitationUseCase(f *organizationFixture) *RespondInvitationUseCase {
+	return NewRespondInvitationUseCase(
+		f.userRepository,
+		f.organizationRepository,
+		f.invitationRepository,
+		signing.NewInvitationSigner([]byte("secret")),
+		f.transactionManager,
+	)
+}
+
+// invite stores an invitation to the organization of f and returns its token.
+func invite(t *testing.T, f *organizationFixture, email string, role entity.OrganizationRole, ttl time.Duration) (*entity.Invitation, string) {
+	invitation, err := entity.NewInvitation(f.organization.ID, email, role, f.owner.ID, ttl)
+	require.Nil(t, err)
+	require.Nil(t, f.invitationRepository.Save(f.ctx, *invitation))
+
+	token, err := signing.NewInvitationSigner([]byte("secret")).Sign(*invitation)
+	require.Nil(t, err)
+	return invitation, token
+}
+
+func Test_RespondInvitationUseCase_NewRespondInvitationUseCase(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	userRepository := entity.NewMockUserRepositoryInterface(ctrl)
+	organizationRepository := entity.NewMockOrganizationRepositoryInterface(ctrl)
+	invitationRepository := entity.NewMockInvitationRepositoryInterface(ctrl)
+	invitationSigner := entity.NewMockInvitationSignerInterface(ctrl)
+	transactionManager := entity.NewMockTransactionManagerInterface(ctrl)
+
+	respondInvitationUseCase := NewRespondInvitationUseCase(userRepository, organizationRepository, invitationRepository, invitationSigner, transactionManager)
+	assert.NotNil(t, respondInvitationUseCase)
+	assert.Equal(t, userRepository, respondInvitationUseCase.UserRepository)
+	assert.Equal(t, organizationRepository, respondInvitationUseCase.OrganizationRepository)
+	assert.Equal(t, invitationRepository, respondInvitationUseCase.InvitationRepository)
+	assert.Equal(t, invitationSigner, respondInvitationUseCase.InvitationSigner)
+	assert.Equal(t, transactionManager, respondInvitationUseCase.TransactionManager)
+}
+
+func Test_RespondInvitationUseCase_Execute_WhenAccepted(t *testing.T) {
+	f := newOrganizationFixture(t)
+	guest := f.addUser(t, "guest@mail.com")
+	invitation, token := invite(t, f, "guest@mail.com", entity.OrganizationRoleAdmin, time.Hour)
+	respondInvitationUseCase := newRespondInvitationUseCase(f)
+
+	output, err := respondInvitationUseCase.Execute(f.ctx, RespondInvitationUseCaseInputDTO{Token: token, UserID: guest.ID.String(), Accept: true})
+	require.Nil(t, err)
+	assert.Equal(t, f.organization.ID.String(), output.OrganizationID)
+	assert.Equal(t, "admin", output.Role)
+	assert.Equal(t, entity.OrganizationRoleAdmin, f.role(t, guest))
+
+	_, err = f.invitationRepository.FindById(f.ctx, invitation.ID)
+	assert.NotNil(t, err)
+
+	output, err = respondInvitationUseCase.Execute(f.ctx, RespondInvitationUseCaseInputDTO{Token: token, UserID: guest.ID.String(), Accept: true})
+	assert.Nil(t, output)
+	assert.ErrorIs(t, err, ErrRespondInvitationNotExists)
+}
+
+func Test_RespondInvitationUseCase_Execute_WhenDeclined(t *testing.T) {
+	f := newOrganizationFixture(t)
+	guest := f.addUser(t, "guest@mail.com")
+	invitation, token := invite(t, f, "guest@mail.com", entity.OrganizationRoleMember, time.Hour)
+	respondInvitationUseCase := newRespondInvitationUseCase(f)
+
+	output, err := respondInvitationUseCase.Execute(f.ctx, RespondInvitationUseCaseInputDTO{Token: token, UserID: guest.ID.String()})
+	require.Nil(t, err)
+	assert.Equal(t, f.organization.ID.String(), output.OrganizationID)
+
+	_, err = f.organizationRepository.FindMember(f.ctx, f.organization.ID, guest.ID)
+	assert.NotNil(t, err)
+	_, err = f.invitationRepository.FindById(f.ctx, invitation.ID)
+	assert.NotNil(t, err)
+}
+
+func Test_RespondInvitationUseCase_Execute_WhenInvitationIsForAnotherEmail(t *testing.T) {
+	f := newOrganizationFixture(t)
+	other := f.addUser(t, "other@mail.com")
+	invitation, token := invite(t, f, "guest@mail.com", entity.OrganizationRoleMember, time.Hour)
+	respondInvitationUseCase := newRespondInvitationUseCase(f)
+
+	for _, userID := range []uuid.UUID{other.ID, uuid.New()} {
+		output, err := respondInvitationUseCase.Execute(f.ctx, RespondInvitationUseCaseInputDTO{Token: token, UserID: userID.String(), Accept: true})
+		assert.Nil(t, output)
+		assert.ErrorIs(t, err, ErrRespondInvitationForbidden)
+	}
+
+	_, err := f.invitationRepository.FindById(f.ctx, invitation.ID)
+	assert.Nil(t, err)
+}
+
+func Test_RespondInvitationUseCase_Execute_WhenTokenIsInvalid(t *testing.T) {
+	f := newOrganizationFixture(t)
+	guest := f.addUser(t, "guest@mail.com")
+	_, expired := invite(t, f, "guest@mail.com", entity.OrganizationRoleMember, -time.Second)
+	respondInvitationUseCase := newRespondInvitationUseCase(f)
+
+	tests := []struct {
+		input    RespondInvitationUseCaseInputDTO
+		expected error
+	}{
+		{RespondInvitationUseCaseInputDTO{Token: "invalid", UserID: guest.ID.String()}, ErrRespondInvitationInvalidToken},
+		{RespondInvitationUseCaseInputDTO{Token: expired, UserID: guest.ID.String()}, ErrRespondInvitationExpired},
+		{RespondInvitationUseCaseInputDTO{Token: expired, UserID: "invalid"}, ErrRespondInvitationInvalidData},
+	}
+	for _, test := range tests {
+		output, err := respondInvitationUseCase.Execute(f.ctx, test.input)
+		assert.Nil(t, output)
+		assert.ErrorIs(t, err, test.expected)
+	}
+}
+
+func Test_RespondInvitationUseCase_Execute_WhenUserIsAlreadyMember(t *testing.T) {
+	f := newOrganizationFixture(t)
+	member := f.addMember(t, "member@mail.com", entity.OrganizationRoleMember)
+	_, token := invite(t, f, "member@mail.com", entity.OrganizationRoleAdmin, time.Hour)
+	respondInvitationUseCase := newRespondInvitationUseCase(f)
+
+	output, err := respondInvitationUseCase.Execute(f.ctx, RespondInvitationUseCaseInputDTO{Token: token, UserID: member.ID.String(), Accept: true})
+	assert.Nil(t, output)
+	assert.ErrorIs(t, err, ErrRespondInvitationAlreadyMember)
+	assert.Equal(t, entity.OrganizationRoleMember, f.role(t, member))
+}
@@ -0,0 +1,81 @@
+package usecase
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"time"
+
+	"github.com/sesaquecruz/go-auth-api/internal/entity"
+)
+
+var (
+	ErrRestoreUserInvalidData        = errors.New("invalid data")
+	ErrRestoreUserInvalidCredentials = errors.New("invalid credentials")
+	ErrRestoreUserGracePeriodExpired = errors.New("grace period expired")
+	ErrRestoreUserInternalError      = errors.New("internal error")
+)
+
+type RestoreUserUseCaseInputDTO struct {
+	Email    string `json:"email"`
+	Password string `json:"password"`
+}
+
+type RestoreUserUseCaseOutputDTO struct {
+	ID string `json:"id"`
+}
+
+type RestoreUserUseCase struct {
+	UserFactory    entity.UserFactoryInterface
+	UserRepository entity.UserRepositoryInterface
+	GracePeriod    time.Duration
+}
+
+func NewRestoreUserUseCase(uf entity.UserFactoryInterface, ur entity.UserRepositoryInterface, gracePeriod time.Duration) *RestoreUserUseCase {
+	return &RestoreUserUseCase{
+		UserFactory:    uf,
+		UserRepository: ur,
+		GracePeriod:    gracePeriod,
+	}
+}
+
+func (uc *RestoreUserUseCase) Execute(ctx context.Context, input RestoreUserUseCaseInputDTO) (*RestoreUserUseCaseOutputDTO, error) {
+	_, err := uc.UserFactory.NewUser(input.Email, input.Password)
+	if err != nil {
+		return nil, ErrRestoreUserInvalidData
+	}
+
+	user, err := uc.UserRepository.FindDeletedByEmail(ctx, input.Email)
+	if err != nil {
+		if err == sql.ErrNoRows {
+			entity.SimulatePasswordVerification(input.Password)
+			return nil, ErrRestoreUserInvalidCredentials
+		}
+		return nil, ErrRestoreUserInternalError
+	}
+
+	err = user.VerifyPassword(input.Password)
+	if err != nil {
+		return nil, ErrRestoreUserInvalidCredentials
+	}
+
+	now := entity.Now()
+	if now.Sub(*user.DeletedAt) > uc.GracePeriod {
+		return nil, ErrRestoreUserGracePeriodExpired
+	}
+
+	// A version conflict means the account was restored since it was read.
+	err = uc.UserRepository.Restore(ctx, user.ID, user.Version, now)
+	if errors.Is(err, entity.ErrUserVersionConflict) {
+		return nil, ErrRestoreUserInvalidCredentials
+	}
+	if err != nil {
+		return nil, ErrRestoreUserInternalError
+	}
+
+	output := &RestoreUserUseCaseOutputDTO{
+		ID: user.ID.String(),
+	}
+
+	return output, nil
+}
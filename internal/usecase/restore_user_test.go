@@ -0,0 +1,148 @@
+package usecase
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/sesaquecruz/go-auth-api/internal/entity"
+
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func Test_RestoreUserUseCase_NewRestoreUserUseCase(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	userFactory := entity.NewMockUserFactoryInterface(ctrl)
+	userRepository := entity.NewMockUserRepositoryInterface(ctrl)
+
+	restoreUserUseCase := NewRestoreUserUseCase(userFactory, userRepository, time.Hour)
+	assert.NotNil(t, restoreUserUseCase)
+	assert.Equal(t, userFactory, restoreUserUseCase.UserFactory)
+	assert.Equal(t, userRepository, restoreUserUseCase.UserRepository)
+	assert.Equal(t, time.Hour, restoreUserUseCase.GracePeriod)
+}
+
+func Test_RestoreUserUseCase_Execute_WhenUserIsDeleted(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	userFactory := entity.NewMockUserFactoryInterface(ctrl)
+	userRepository := entity.NewMockUserRepositoryInterface(ctrl)
+
+	email := "user@mail.com"
+	password := "12345"
+
+	ctx := context.Background()
+	user, err := entity.NewUserFactory().NewUser(email, password)
+	require.Nil(t, err)
+
+	deletedAt := entity.Now().Add(-time.Minute)
+	user.DeletedAt = &deletedAt
+	user.Version = 2
+
+	input := RestoreUserUseCaseInputDTO{Email: email, Password: password}
+	restoreUserUseCase := RestoreUserUseCase{UserFactory: userFactory, UserRepository: userRepository, GracePeriod: time.Hour}
+
+	userFactory.EXPECT().NewUser(email, password).Return(user, nil).Times(1)
+	userRepository.EXPECT().FindDeletedByEmail(ctx, email).Return(user, nil).Times(1)
+	userRepository.EXPECT().Restore(ctx, user.ID, user.Version, gomock.Any()).Return(nil).Times(1)
+
+	output, err := restoreUserUseCase.Execute(ctx, input)
+	assert.Nil(t, err)
+	assert.Equal(t, user.ID.String(), output.ID)
+}
+
+func Test_RestoreUserUseCase_Execute_WhenUserIsInvalid(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	userFactory := entity.NewMockUserFactoryInterface(ctrl)
+	userRepository := entity.NewMockUserRepositoryInterface(ctrl)
+
+	ctx := context.Background()
+	input := RestoreUserUseCaseInputDTO{Email: "user@mail", Password: "12345"}
+	restoreUserUseCase := RestoreUserUseCase{UserFactory: userFactory, UserRepository: userRepository, GracePeriod: time.Hour}
+
+	userFactory.EXPECT().NewUser(input.Email, input.Password).Return(nil, errors.New("")).Times(1)
+
+	output, err := restoreUserUseCase.Execute(ctx, input)
+	assert.Nil(t, output)
+	assert.ErrorIs(t, err, ErrRestoreUserInvalidData)
+}
+
+func Test_RestoreUserUseCase_Execute_WhenUserIsNotDeleted(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	userFactory := entity.NewMockUserFactoryInterface(ctrl)
+	userRepository := entity.NewMockUserRepositoryInterface(ctrl)
+
+	ctx := context.Background()
+	input := RestoreUserUseCaseInputDTO{Email: "user@mail.com", Password: "12345"}
+	restoreUserUseCase := RestoreUserUseCase{UserFactory: userFactory, UserRepository: userRepository, GracePeriod: time.Hour}
+
+	userFactory.EXPECT().NewUser(input.Email, input.Password).Return(&entity.User{}, nil).Times(1)
+	userRepository.EXPECT().FindDeletedByEmail(ctx, input.Email).Return(nil, sql.ErrNoRows).Times(1)
+
+	output, err := restoreUserUseCase.Execute(ctx, input)
+	assert.Nil(t, output)
+	assert.ErrorIs(t, err, ErrRestoreUserInvalidCredentials)
+}
+
+func Test_RestoreUserUseCase_Execute_WhenPasswordIsInvalid(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	userFactory := entity.NewMockUserFactoryInterface(ctrl)
+	userRepository := entity.NewMockUserRepositoryInterface(ctrl)
+
+	ctx := context.Background()
+	user, err := entity.NewUserFactory().NewUser("user@mail.com", "12345")
+	require.Nil(t, err)
+
+	deletedAt := entity.Now()
+	user.DeletedAt = &deletedAt
+
+	input := RestoreUserUseCaseInputDTO{Email: user.Email, Password: "54321"}
+	restoreUserUseCase := RestoreUserUseCase{UserFactory: userFactory, UserRepository: userRepository, GracePeriod: time.Hour}
+
+	userFactory.EXPECT().NewUser(input.Email, input.Password).Return(user, nil).Times(1)
+	userRepository.EXPECT().FindDeletedByEmail(ctx, input.Email).Return(user, nil).Times(1)
+	userRepository.EXPECT().Restore(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).Times(0)
+
+	output, err := restoreUserUseCase.Execute(ctx, input)
+	assert.Nil(t, output)
+	assert.ErrorIs(t, err, ErrRestoreUserInvalidCredentials)
+}
+
+func Test_RestoreUserUseCase_Execute_WhenGracePeriodExpired(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	userFactory := entity.NewMockUserFactoryInterface(ctrl)
+	userRepository := entity.NewMockUserRepositoryInterface(ctrl)
+
+	ctx := context.Background()
+	user, err := entity.NewUserFactory().NewUser("user@mail.com", "12345")
+	require.Nil(t, err)
+
+	deletedAt := entity.Now().Add(-2 * time.Hour)
+	user.DeletedAt = &deletedAt
+
+	input := RestoreUserUseCaseInputDTO{Email: user.Email, Password: "12345"}
+	restoreUserUseCase := RestoreUserUseCase{UserFactory: userFactory, UserRepository: userRepository, GracePeriod: time.Hour}
+
+	userFactory.EXPECT().NewUser(input.Email, input.Password).Return(user, nil).Times(1)
+	userRepository.EXPECT().FindDeletedByEmail(ctx, input.Email).Return(user, nil).Times(1)
+	userRepository.EXPECT().Restore(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).Times(0)
+
+	output, err := restoreUserUseCase.Execute(ctx, input)
+	assert.Nil(t, output)
+	assert.ErrorIs(t, err, ErrRestoreUserGracePeriodExpired)
+}
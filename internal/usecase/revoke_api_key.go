@@ -0,0 +1,51 @@
+package usecase
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+
+	"github.com/google/uuid"
+	"github.com/sesaquecruz/go-auth-api/internal/entity"
+)
+
+var (
+	ErrRevokeAPIKeyInvalidData   = errors.New("invalid data")
+	ErrRevokeAPIKeyNotExists     = errors.New("api key not exists")
+	ErrRevokeAPIKeyInternalError = errors.New("internal error")
+)
+
+type RevokeAPIKeyUseCaseInputDTO struct {
+	UserID string `json:"user_id"`
+	ID     string `json:"id"`
+}
+
+type RevokeAPIKeyUseCase struct {
+	APIKeyRepository entity.APIKeyRepositoryInterface
+}
+
+func NewRevokeAPIKeyUseCase(ar entity.APIKeyRepositoryInterface) *RevokeAPIKeyUseCase {
+	return &RevokeAPIKeyUseCase{APIKeyRepository: ar}
+}
+
+func (uc *RevokeAPIKeyUseCase) Execute(ctx context.Context, input RevokeAPIKeyUseCaseInputDTO) error {
+	userID, err := uuid.Parse(input.UserID)
+	if err != nil {
+		return ErrRevokeAPIKeyInvalidData
+	}
+
+	id, err := uuid.Parse(input.ID)
+	if err != nil {
+		return ErrRevokeAPIKeyInvalidData
+	}
+
+	err = uc.APIKeyRepository.Revoke(ctx, id, userID, entity.Now())
+	if err == sql.ErrNoRows {
+		return ErrRevokeAPIKeyNotExists
+	}
+	if err != nil {
+		return ErrRevokeAPIKeyInternalError
+	}
+
+	return nil
+}
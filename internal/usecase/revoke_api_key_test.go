@@ -0,0 +1,44 @@
+package usecase
+
+import (
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/sesaquecruz/go-auth-api/internal/entity"
+
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func Test_RevokeAPIKeyUseCase_NewRevokeAPIKeyUseCase(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	apiKeyRepository := entity.NewMockAPIKeyRepositoryInterface(ctrl)
+	revokeAPIKeyUseCase := NewRevokeAPIKeyUseCase(apiKeyRepository)
+	assert.NotNil(t, revokeAPIKeyUseCase)
+	assert.Equal(t, apiKeyRepository, revokeAPIKeyUseCase.APIKeyRepository)
+}
+
+func Test_RevokeAPIKeyUseCase_Execute(t *testing.T) {
+	f := newAPIKeyFixture(t)
+	revokeAPIKeyUseCase := NewRevokeAPIKeyUseCase(f.apiKeyRepository)
+
+	key, _, err := entity.NewAPIKey(f.user.ID, "ci", nil, time.Hour)
+	require.Nil(t, err)
+	require.Nil(t, f.apiKeyRepository.Save(f.ctx, *key))
+
+	err = revokeAPIKeyUseCase.Execute(f.ctx, RevokeAPIKeyUseCaseInputDTO{UserID: uuid.NewString(), ID: key.ID.String()})
+	assert.ErrorIs(t, err, ErrRevokeAPIKeyNotExists)
+
+	err = revokeAPIKeyUseCase.Execute(f.ctx, RevokeAPIKeyUseCaseInputDTO{UserID: f.user.ID.String(), ID: key.ID.String()})
+	assert.Nil(t, err)
+
+	err = revokeAPIKeyUseCase.Execute(f.ctx, RevokeAPIKeyUseCaseInputDTO{UserID: f.user.ID.String(), ID: key.ID.String()})
+	assert.ErrorIs(t, err, ErrRevokeAPIKeyNotExists)
+
+	err = revokeAPIKeyUseCase.Execute(f.ctx, RevokeAPIKeyUseCaseInputDTO{UserID: f.user.ID.String(), ID: "invalid"})
+	assert.ErrorIs(t, err, ErrRevokeAPIKeyInvalidData)
+}
@@ -0,0 +1,51 @@
+package usecase
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+
+	"github.com/google/uuid"
+	"github.com/sesaquecruz/go-auth-api/internal/entity"
+)
+
+var (
+	ErrRevokeSessionInvalidData   = errors.New("invalid data")
+	ErrRevokeSessionNotExists     = errors.New("session not exists")
+	ErrRevokeSessionInternalError = errors.New("internal error")
+)
+
+type RevokeSessionUseCaseInputDTO struct {
+	UserID string `json:"user_id"`
+	ID     string `json:"id"`
+}
+
+type RevokeSessionUseCase struct {
+	SessionRepository entity.SessionRepositoryInterface
+}
+
+func NewRevokeSessionUseCase(sr entity.SessionRepositoryInterface) *RevokeSessionUseCase {
+	return &RevokeSessionUseCase{SessionRepository: sr}
+}
+
+func (uc *RevokeSessionUseCase) Execute(ctx context.Context, input RevokeSessionUseCaseInputDTO) error {
+	userID, err := uuid.Parse(input.UserID)
+	if err != nil {
+		return ErrRevokeSessionInvalidData
+	}
+
+	id, err := uuid.Parse(input.ID)
+	if err != nil {
+		return ErrRevokeSessionInvalidData
+	}
+
+	err = uc.SessionRepository.Revoke(ctx, id, userID, entity.Now())
+	if err == sql.ErrNoRows {
+		return ErrRevokeSessionNotExists
+	}
+	if err != nil {
+		return ErrRevokeSessionInternalError
+	}
+
+	return nil
+}
@@ -0,0 +1,65 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/sesaquecruz/go-auth-api/internal/entity"
+	"github.com/sesaquecruz/go-auth-api/internal/infra/database/memory"
+
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func Test_RevokeSessionUseCase_NewRevokeSessionUseCase(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	sessionRepository := entity.NewMockSessionRepositoryInterface(ctrl)
+	revokeSessionUseCase := NewRevokeSessionUseCase(sessionRepository)
+	assert.NotNil(t, revokeSessionUseCase)
+	assert.Equal(t, sessionRepository, revokeSessionUseCase.SessionRepository)
+}
+
+func Test_RevokeSessionUseCase_Execute(t *testing.T) {
+	ctx := context.Background()
+	sessionRepository := memory.NewSessionRepository()
+	revokeSessionUseCase := NewRevokeSessionUseCase(sessionRepository)
+
+	session, err := entity.NewSession(uuid.New(), "", "", time.Hour)
+	require.Nil(t, err)
+	require.Nil(t, sessionRepository.Save(ctx, *session))
+
+	err = revokeSessionUseCase.Execute(ctx, RevokeSessionUseCaseInputDTO{UserID: uuid.NewString(), ID: session.ID.String()})
+	assert.ErrorIs(t, err, ErrRevokeSessionNotExists)
+
+	err = revokeSessionUseCase.Execute(ctx, RevokeSessionUseCaseInputDTO{UserID: session.UserID.String(), ID: session.ID.String()})
+	assert.Nil(t, err)
+
+	found, err := sessionRepository.FindById(ctx, session.ID)
+	require.Nil(t, err)
+	assert.NotNil(t, found.RevokedAt)
+
+	err = revokeSessionUseCase.Execute(ctx, RevokeSessionUseCaseInputDTO{UserID: session.UserID.String(), ID: session.ID.String()})
+	assert.ErrorIs(t, err, ErrRevokeSessionNotExists)
+
+	err = revokeSessionUseCase.Execute(ctx, RevokeSessionUseCaseInputDTO{UserID: session.UserID.String(), ID: "invalid"})
+	assert.ErrorIs(t, err, ErrRevokeSessionInvalidData)
+}
+
+func Test_RevokeSessionUseCase_Execute_WhenSessionCannotBeRevoked(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	sessionRepository := entity.NewMockSessionRepositoryInterface(ctrl)
+	revokeSessionUseCase := NewRevokeSessionUseCase(sessionRepository)
+
+	sessionRepository.EXPECT().Revoke(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).Return(errors.New("")).Times(1)
+
+	err := revokeSessionUseCase.Execute(context.Background(), RevokeSessionUseCaseInputDTO{UserID: uuid.NewString(), ID: uuid.NewString()})
+	assert.ErrorIs(t, err, ErrRevokeSessionInternalError)
+}
@@ -0,0 +1,125 @@
+package usecase
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+
+	"github.com/google/uuid"
+	"github.com/sesaquecruz/go-auth-api/internal/entity"
+)
+
+var (
+	ErrSetMemberRoleInvalidData           = errors.New("invalid data")
+	ErrSetMemberRoleOrganizationNotExists = errors.New("organization not exists")
+	ErrSetMemberRoleMemberNotExists       = errors.New("member not exists")
+	ErrSetMemberRoleForbidden             = errors.New("forbidden")
+	ErrSetMemberRoleLastOwner             = errors.New("last owner")
+	ErrSetMemberRoleInternalError         = errors.New("internal error")
+)
+
+type SetMemberRoleUseCaseInputDTO struct {
+	OrganizationID string `json:"organization_id"`
+	UserID         string `json:"user_id"`
+	MemberID       string `json:"member_id"`
+	Role           string `json:"role"`
+}
+
+// SetMemberRoleUseCase changes the role of a member. The user doing it must be able to manage both the
+// current and the new role, and the last owner of an organization can not be demoted.
+type SetMemberRoleUseCase struct {
+	OrganizationRepository entity.OrganizationRepositoryInterface
+	TransactionManager     entity.TransactionManagerInterface
+}
+
+func NewSetMemberRoleUseCase(or entity.OrganizationRepositoryInterface, tm entity.TransactionManagerInterface) *SetMemberRoleUseCase {
+	return &SetMemberRoleUseCase{
+		OrganizationRepository: or,
+		TransactionManager:     tm,
+	}
+}
+
+func (uc *SetMemberRoleUseCase) Execute(ctx context.Context, input SetMemberRoleUseCaseInputDTO) error {
+	organizationID, err := uuid.Parse(input.OrganizationID)
+	if err != nil {
+		return ErrSetMemberRoleInvalidData
+	}
+
+	userID, err := uuid.Parse(input.UserID)
+	if err != nil {
+		return ErrSetMemberRoleInvalidData
+	}
+
+	memberID, err := uuid.Parse(input.MemberID)
+	if err != nil {
+		return ErrSetMemberRoleInvalidData
+	}
+
+	role := entity.OrganizationRole(input.Role)
+	if !role.IsValid() {
+		return ErrSetMemberRoleInvalidData
+	}
+
+	err = uc.TransactionManager.Do(ctx, func(ctx context.Context) error {
+		return uc.set(ctx, organizationID, userID, memberID, role)
+	})
+	switch err {
+	case nil, ErrSetMemberRoleOrganizationNotExists, ErrSetMemberRoleMemberNotExists, ErrSetMemberRoleForbidden, ErrSetMemberRoleLastOwner:
+		return err
+	default:
+		return ErrSetMemberRoleInternalError
+	}
+}
+
+func (uc *SetMemberRoleUseCase) set(ctx context.Context, organizationID uuid.UUID, userID uuid.UUID, memberID uuid.UUID, role entity.OrganizationRole) error {
+	actor, err := uc.OrganizationRepository.FindMember(ctx, organizationID, userID)
+	if err == sql.ErrNoRows {
+		return ErrSetMemberRoleOrganizationNotExists
+	}
+	if err != nil {
+		return err
+	}
+
+	member, err := uc.OrganizationRepository.FindMember(ctx, organizationID, memberID)
+	if err == sql.ErrNoRows {
+		return ErrSetMemberRoleMemberNotExists
+	}
+	if err != nil {
+		return err
+	}
+	if !actor.Role.CanManage(member.Role) || !actor.Role.CanManage(role) {
+		return ErrSetMemberRoleForbidden
+	}
+	if member.Role == role {
+		return nil
+	}
+
+	if member.Role == entity.OrganizationRoleOwner {
+		last, err := isLastOwner(ctx, uc.OrganizationRepository, organizationID)
+		if err != nil {
+			return err
+		}
+		if last {
+			return ErrSetMemberRoleLastOwner
+		}
+	}
+
+	return uc.OrganizationRepository.SetMemberRole(ctx, organizationID, memberID, role)
+}
+
+// isLastOwner tells whether the organization has a single owner.
+func isLastOwner(ctx context.Context, or entity.OrganizationRepositoryInterface, organizationID uuid.UUID) (bool, error) {
+	members, err := or.ListMembers(ctx, organizationID)
+	if err != nil {
+		return false, err
+	}
+
+	owners := 0
+	for _, member := range members {
+		if member.Role == entity.OrganizationRoleOwner {
+			owners++
+		}
+	}
+
+	return owners <= 1, nil
+}
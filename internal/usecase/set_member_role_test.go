@@ -0,0 +1,95 @@
+package usecase
+
+import (
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/sesaquecruz/go-auth-api/internal/entity"
+
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+)
+
+func Test_SetMemberRoleUseCase_NewSetMemberRoleUseCase(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	organizationRepository := entity.NewMockOrganizationRepositoryInterface(ctrl)
+	transactionManager := entity.NewMockTransactionManagerInterface(ctrl)
+
+	setMemberRoleUseCase := NewSetMemberRoleUseCase(organizationRepository, transactionManager)
+	assert.NotNil(t, setMemberRoleUseCase)
+	assert.Equal(t, organizationRepository, setMemberRoleUseCase.OrganizationRepository)
+	assert.Equal(t, transactionManager, setMemberRoleUseCase.TransactionManager)
+}
+
+func Test_SetMemberRoleUseCase_Execute(t *testing.T) {
+	f := newOrganizationFixture(t)
+	admin := f.addMember(t, "admin@mail.com", entity.OrganizationRoleAdmin)
+	member := f.addMember(t, "member@mail.com", entity.OrganizationRoleMember)
+	setMemberRoleUseCase := NewSetMemberRoleUseCase(f.organizationRepository, f.transactionManager)
+
+	err := setMemberRoleUseCase.Execute(f.ctx, SetMemberRoleUseCaseInputDTO{
+		OrganizationID: f.organization.ID.String(),
+		UserID:         admin.ID.String(),
+		MemberID:       member.ID.String(),
+		Role:           "admin",
+	})
+	assert.Nil(t, err)
+	assert.Equal(t, entity.OrganizationRoleAdmin, f.role(t, member))
+
+	err = setMemberRoleUseCase.Execute(f.ctx, SetMemberRoleUseCaseInputDTO{
+		OrganizationID: f.organization.ID.String(),
+		UserID:         f.owner.ID.String(),
+		MemberID:       member.ID.String(),
+		Role:           "owner",
+	})
+	assert.Nil(t, err)
+	assert.Equal(t, entity.OrganizationRoleOwner, f.role(t, member))
+
+	// With two owners, one can step down.
+	err = setMemberRoleUseCase.Execute(f.ctx, SetMemberRoleUseCaseInputDTO{
+		OrganizationID: f.organization.ID.String(),
+		UserID:         f.owner.ID.String(),
+		MemberID:       f.owner.ID.String(),
+		Role:           "member",
+	})
+	assert.Nil(t, err)
+	assert.Equal(t, entity.OrganizationRoleMember, f.role(t, f.owner))
+}
+
+func Test_SetMemberRoleUseCase_Execute_WhenNotAllowed(t *testing.T) {
+	f := newOrganizationFixture(t)
+	admin := f.addMember(t, "admin@mail.com", entity.OrganizationRoleAdmin)
+	member := f.addMember(t, "member@mail.com", entity.OrganizationRoleMember)
+	outsider := f.addUser(t, "outsider@mail.com")
+	setMemberRoleUseCase := NewSetMemberRoleUseCase(f.organizationRepository, f.transactionManager)
+
+	tests := []struct {
+		actor    *entity.User
+		memberID uuid.UUID
+		role     string
+		expected error
+	}{
+		{admin, member.ID, "owner", ErrSetMemberRoleForbidden},
+		{admin, f.owner.ID, "member", ErrSetMemberRoleForbidden},
+		{member, admin.ID, "member", ErrSetMemberRoleForbidden},
+		{f.owner, f.owner.ID, "admin", ErrSetMemberRoleLastOwner},
+		{f.owner, outsider.ID, "admin", ErrSetMemberRoleMemberNotExists},
+		{outsider, member.ID, "admin", ErrSetMemberRoleOrganizationNotExists},
+		{f.owner, member.ID, "guest", ErrSetMemberRoleInvalidData},
+	}
+	for _, test := range tests {
+		err := setMemberRoleUseCase.Execute(f.ctx, SetMemberRoleUseCaseInputDTO{
+			OrganizationID: f.organization.ID.String(),
+			UserID:         test.actor.ID.String(),
+			MemberID:       test.memberID.String(),
+			Role:           test.role,
+		})
+		assert.ErrorIs(t, err, test.expected, test.actor.Email)
+	}
+
+	assert.Equal(t, entity.OrganizationRoleOwner, f.role(t, f.owner))
+	assert.Equal(t, entity.OrganizationRoleAdmin, f.role(t, admin))
+	assert.Equal(t, entity.OrganizationRoleMember, f.role(t, member))
+}
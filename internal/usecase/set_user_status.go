@@ -0,0 +1,59 @@
+package usecase
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+
+	"github.com/google/uuid"
+	"github.com/sesaquecruz/go-auth-api/internal/entity"
+)
+
+var (
+	ErrSetUserStatusInvalidData   = errors.New("invalid data")
+	ErrSetUserStatusUserNotExists = errors.New("user not exists")
+	ErrSetUserStatusInternalError = errors.New("internal error")
+)
+
+type SetUserStatusUseCaseInputDTO struct {
+	ID     string `json:"id"`
+	Status string `json:"status"`
+}
+
+type SetUserStatusUseCase struct {
+	UserRepository entity.UserRepositoryInterface
+}
+
+func NewSetUserStatusUseCase(ur entity.UserRepositoryInterface) *SetUserStatusUseCase {
+	return &SetUserStatusUseCase{UserRepository: ur}
+}
+
+func (uc *SetUserStatusUseCase) Execute(ctx context.Context, input SetUserStatusUseCaseInputDTO) error {
+	id, err := uuid.Parse(input.ID)
+	if err != nil {
+		return ErrSetUserStatusInvalidData
+	}
+
+	status := entity.UserStatus(input.Status)
+	if !status.IsValid() {
+		return ErrSetUserStatusInvalidData
+	}
+
+	user, err := uc.UserRepository.FindById(ctx, id)
+	if err == sql.ErrNoRows {
+		return ErrSetUserStatusUserNotExists
+	}
+	if err != nil {
+		return ErrSetUserStatusInternalError
+	}
+	if user.Status == status {
+		return nil
+	}
+
+	err = uc.UserRepository.SetStatus(ctx, id, status, entity.Now())
+	if err != nil {
+		return ErrSetUserStatusInternalError
+	}
+
+	return nil
+}
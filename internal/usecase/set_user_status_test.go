@@ -0,0 +1,90 @@
+package usecase
+
+import (
+	"context"
+	"database/sql"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/sesaquecruz/go-auth-api/internal/entity"
+
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+)
+
+func Test_SetUserStatusUseCase_NewSetUserStatusUseCase(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	userRepository := entity.NewMockUserRepositoryInterface(ctrl)
+	setUserStatusUseCase := NewSetUserStatusUseCase(userRepository)
+	assert.NotNil(t, setUserStatusUseCase)
+	assert.Equal(t, userRepository, setUserStatusUseCase.UserRepository)
+}
+
+func Test_SetUserStatusUseCase_Execute(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	userRepository := entity.NewMockUserRepositoryInterface(ctrl)
+	setUserStatusUseCase := SetUserStatusUseCase{UserRepository: userRepository}
+
+	ctx := context.Background()
+	user := &entity.User{ID: uuid.New(), Status: entity.UserStatusActive}
+
+	userRepository.EXPECT().FindById(ctx, user.ID).Return(user, nil).Times(1)
+	userRepository.EXPECT().SetStatus(ctx, user.ID, entity.UserStatusSuspended, gomock.Any()).Return(nil).Times(1)
+
+	err := setUserStatusUseCase.Execute(ctx, SetUserStatusUseCaseInputDTO{ID: user.ID.String(), Status: "suspended"})
+	assert.Nil(t, err)
+}
+
+func Test_SetUserStatusUseCase_Execute_WhenStatusIsUnchanged(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	userRepository := entity.NewMockUserRepositoryInterface(ctrl)
+	setUserStatusUseCase := SetUserStatusUseCase{UserRepository: userRepository}
+
+	ctx := context.Background()
+	user := &entity.User{ID: uuid.New(), Status: entity.UserStatusActive}
+
+	userRepository.EXPECT().FindById(ctx, user.ID).Return(user, nil).Times(1)
+	userRepository.EXPECT().SetStatus(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).Times(0)
+
+	err := setUserStatusUseCase.Execute(ctx, SetUserStatusUseCaseInputDTO{ID: user.ID.String(), Status: "active"})
+	assert.Nil(t, err)
+}
+
+func Test_SetUserStatusUseCase_Execute_WhenDataIsInvalid(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	userRepository := entity.NewMockUserRepositoryInterface(ctrl)
+	setUserStatusUseCase := SetUserStatusUseCase{UserRepository: userRepository}
+
+	ctx := context.Background()
+	for _, input := range []SetUserStatusUseCaseInputDTO{
+		{ID: "invalid", Status: "active"},
+		{ID: uuid.NewString(), Status: "deleted"},
+	} {
+		err := setUserStatusUseCase.Execute(ctx, input)
+		assert.ErrorIs(t, err, ErrSetUserStatusInvalidData)
+	}
+}
+
+func Test_SetUserStatusUseCase_Execute_WhenUserNotExists(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	userRepository := entity.NewMockUserRepositoryInterface(ctrl)
+	setUserStatusUseCase := SetUserStatusUseCase{UserRepository: userRepository}
+
+	ctx := context.Background()
+	id := uuid.New()
+
+	userRepository.EXPECT().FindById(ctx, id).Return(nil, sql.ErrNoRows).Times(1)
+
+	err := setUserStatusUseCase.Execute(ctx, SetUserStatusUseCaseInputDTO{ID: id.String(), Status: "active"})
+	assert.ErrorIs(t, err, ErrSetUserStatusUserNotExists)
+}
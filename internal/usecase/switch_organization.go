@@ -0,0 +1,62 @@
+package usecase
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+
+	"github.com/google/uuid"
+	"github.com/sesaquecruz/go-auth-api/internal/entity"
+)
+
+var (
+	ErrSwitchOrganizationInvalidData           = errors.New("invalid data")
+	ErrSwitchOrganizationOrganizationNotExists = errors.New("organization not exists")
+	ErrSwitchOrganizationInternalError         = errors.New("internal error")
+)
+
+type SwitchOrganizationUseCaseInputDTO struct {
+	OrganizationID string `json:"organization_id"`
+	UserID         string `json:"user_id"`
+}
+
+type SwitchOrganizationUseCaseOutputDTO struct {
+	OrganizationID string `json:"organization_id"`
+	Role           string `json:"role"`
+}
+
+// SwitchOrganizationUseCase returns the role of the user in the organization they make active.
+type SwitchOrganizationUseCase struct {
+	OrganizationRepository entity.OrganizationRepositoryInterface
+}
+
+func NewSwitchOrganizationUseCase(or entity.OrganizationRepositoryInterface) *SwitchOrganizationUseCase {
+	return &SwitchOrganizationUseCase{OrganizationRepository: or}
+}
+
+func (uc *SwitchOrganizationUseCase) Execute(ctx context.Context, input SwitchOrganizationUseCaseInputDTO) (*SwitchOrganizationUseCaseOutputDTO, error) {
+	organizationID, err := uuid.Parse(input.OrganizationID)
+	if err != nil {
+		return nil, ErrSwitchOrganizationInvalidData
+	}
+
+	userID, err := uuid.Parse(input.UserID)
+	if err != nil {
+		return nil, ErrSwitchOrganizationInvalidData
+	}
+
+	member, err := uc.OrganizationRepository.FindMember(ctx, organizationID, userID)
+	if err == sql.ErrNoRows {
+		return nil, ErrSwitchOrganizationOrganizationNotExists
+	}
+	if err != nil {
+		return nil, ErrSwitchOrganizationInternalError
+	}
+
+	output := &SwitchOrganizationUseCaseOutputDTO{
+		OrganizationID: member.OrganizationID.String(),
+		Role:           string(member.Role),
+	}
+
+	return output, nil
+}
@@ -0,0 +1,50 @@
+package usecase
+
+import (
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/sesaquecruz/go-auth-api/internal/entity"
+
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func Test_SwitchOrganizationUseCase_NewSwitchOrganizationUseCase(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	organizationRepository := entity.NewMockOrganizationRepositoryInterface(ctrl)
+	switchOrganizationUseCase := NewSwitchOrganizationUseCase(organizationRepository)
+	assert.NotNil(t, switchOrganizationUseCase)
+	assert.Equal(t, organizationRepository, switchOrganizationUseCase.OrganizationRepository)
+}
+
+func Test_SwitchOrganizationUseCase_Execute(t *testing.T) {
+	f := newOrganizationFixture(t)
+	switchOrganizationUseCase := NewSwitchOrganizationUseCase(f.organizationRepository)
+
+	output, err := switchOrganizationUseCase.Execute(f.ctx, SwitchOrganizationUseCaseInputDTO{OrganizationID: f.organization.ID.String(), UserID: f.owner.ID.String()})
+	require.Nil(t, err)
+	assert.Equal(t, f.organization.ID.String(), output.OrganizationID)
+	assert.Equal(t, "owner", output.Role)
+}
+
+func Test_SwitchOrganizationUseCase_Execute_WhenUserIsNotMember(t *testing.T) {
+	f := newOrganizationFixture(t)
+	outsider := f.addUser(t, "outsider@mail.com")
+	switchOrganizationUseCase := NewSwitchOrganizationUseCase(f.organizationRepository)
+
+	output, err := switchOrganizationUseCase.Execute(f.ctx, SwitchOrganizationUseCaseInputDTO{OrganizationID: f.organization.ID.String(), UserID: outsider.ID.String()})
+	assert.Nil(t, output)
+	assert.ErrorIs(t, err, ErrSwitchOrganizationOrganizationNotExists)
+
+	output, err = switchOrganizationUseCase.Execute(f.ctx, SwitchOrganizationUseCaseInputDTO{OrganizationID: f.organization.ID.String(), UserID: "invalid"})
+	assert.Nil(t, output)
+	assert.ErrorIs(t, err, ErrSwitchOrganizationInvalidData)
+
+	output, err = switchOrganizationUseCase.Execute(f.ctx, SwitchOrganizationUseCaseInputDTO{OrganizationID: uuid.NewString(), UserID: f.owner.ID.String()})
+	assert.Nil(t, output)
+	assert.ErrorIs(t, err, ErrSwitchOrganizationOrganizationNotExists)
+}
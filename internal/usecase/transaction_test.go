@@ -0,0 +1,21 @@
+package usecase
+
+import (
+	"context"
+
+	"github.com/golang/mock/gomock"
+	"github.com/sesaquecruz/go-auth-api/internal/entity"
+)
+
+// newTransactionManager returns a transaction manager that runs the unit of work with the caller's ctx,
+// so repository expectations on ctx still match.
+func newTransactionManager(ctrl *gomock.Controller) *entity.MockTransactionManagerInterface {
+	transactionManager := entity.NewMockTransactionManagerInterface(ctrl)
+	transactionManager.EXPECT().
+		Do(gomock.Any(), gomock.Any()).
+		DoAndReturn(func(ctx context.Context, fn func(ctx context.Context) error) error {
+			return fn(ctx)
+		}).
+		AnyTimes()
+	return transactionManager
+}
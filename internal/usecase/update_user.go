@@ -12,28 +12,44 @@ var (
 	ErrUpdateUserInvalidData      = errors.New("invalid data")
 	ErrUpdateUserUserNotExists    = errors.New("user not exists")
 	ErrUpdateUserEmailAlreadyUsed = errors.New("email already used")
+	ErrUpdateUserVersionConflict  = errors.New("version conflict")
 	ErrUpdateUserInternalError    = errors.New("internal error")
 )
 
+// Version is the one the caller last read. Zero means any version.
 type UpdateUserUseCaseInputDTO struct {
 	ID       string `json:"id"`
 	Email    string `json:"email"`
 	Password string `json:"password"`
+	Version  int64  `json:"-"`
 }
 
 type UpdateUserUseCaseOutputDTO struct {
-	ID string `json:"id"`
+	ID      string `json:"id"`
+	Version int64  `json:"-"`
+	// PasswordChanged tells that every session of the user was revoked, the one of the request included.
+	PasswordChanged bool `json:"-"`
 }
 
+// UpdateUserUseCase revokes every session of the user when the password changes.
 type UpdateUserUseCase struct {
-	UserFactory    entity.UserFactoryInterface
-	UserRepository entity.UserRepositoryInterface
+	UserFactory        entity.UserFactoryInterface
+	UserRepository     entity.UserRepositoryInterface
+	SessionRepository  entity.SessionRepositoryInterface
+	TransactionManager entity.TransactionManagerInterface
 }
 
-func NewUpdateUserUseCase(uf entity.UserFactoryInterface, ur entity.UserRepositoryInterface) *UpdateUserUseCase {
+func NewUpdateUserUseCase(
+	uf entity.UserFactoryInterface,
+	ur entity.UserRepositoryInterface,
+	sr entity.SessionRepositoryInterface,
+	tm entity.TransactionManagerInterface,
+) *UpdateUserUseCase {
 	return &UpdateUserUseCase{
-		UserFactory:    uf,
-		UserRepository: ur,
+		UserFactory:        uf,
+		UserRepository:     ur,
+		SessionRepository:  sr,
+		TransactionManager: tm,
 	}
 }
 
@@ -43,27 +59,72 @@ func (uc *UpdateUserUseCase) Execute(ctx context.Context, input UpdateUserUseCas
 		return nil, ErrUpdateUserInvalidData
 	}
 
-	_, err = uc.UserRepository.FindById(ctx, user.ID)
+	var passwordChanged bool
+	err = uc.TransactionManager.Do(ctx, func(ctx context.Context) error {
+		passwordChanged, err = uc.update(ctx, user, input)
+		return err
+	})
+	switch err {
+	case nil:
+	case ErrUpdateUserUserNotExists, ErrUpdateUserEmailAlreadyUsed, ErrUpdateUserVersionConflict, ErrUpdateUserInternalError:
+		return nil, err
+	default:
+		return nil, ErrUpdateUserInternalError
+	}
+
+	output := &UpdateUserUseCaseOutputDTO{
+		ID:              user.ID.String(),
+		Version:         user.Version + 1,
+		PasswordChanged: passwordChanged,
+	}
+
+	return output, nil
+}
+
+func (uc *UpdateUserUseCase) update(ctx context.Context, user *entity.User, input UpdateUserUseCaseInputDTO) (bool, error) {
+	stored, err := uc.UserRepository.FindById(ctx, user.ID)
 	if err != nil {
-		return nil, ErrUpdateUserUserNotExists
+		return false, ErrUpdateUserUserNotExists
+	}
+
+	if input.Version != 0 && input.Version != stored.Version {
+		return false, ErrUpdateUserVersionConflict
 	}
 
 	emailOwner, err := uc.UserRepository.FindByEmail(ctx, user.Email)
 	if err != nil && err != sql.ErrNoRows {
-		return nil, ErrUpdateUserInternalError
+		return false, ErrUpdateUserInternalError
 	}
 	if err == nil && user.ID != emailOwner.ID {
-		return nil, ErrUpdateUserEmailAlreadyUsed
+		return false, ErrUpdateUserEmailAlreadyUsed
+	}
+
+	user.Version = stored.Version
+	user.CreatedAt = stored.CreatedAt
+	user.UpdatedAt = entity.Now()
+	user.PasswordChangedAt = stored.PasswordChangedAt
+	passwordChanged := stored.VerifyPassword(input.Password) != nil
+	if passwordChanged {
+		user.PasswordChangedAt = user.UpdatedAt
 	}
 
 	err = uc.UserRepository.Update(ctx, *user)
+	if errors.Is(err, entity.ErrUserEmailAlreadyExists) {
+		return false, ErrUpdateUserEmailAlreadyUsed
+	}
+	if errors.Is(err, entity.ErrUserVersionConflict) {
+		return false, ErrUpdateUserVersionConflict
+	}
 	if err != nil {
-		return nil, ErrUpdateUserInternalError
+		return false, ErrUpdateUserInternalError
 	}
 
-	output := &UpdateUserUseCaseOutputDTO{
-		ID: user.ID.String(),
+	if passwordChanged {
+		err = uc.SessionRepository.RevokeByUser(ctx, user.ID, user.UpdatedAt)
+		if err != nil {
+			return false, ErrUpdateUserInternalError
+		}
 	}
 
-	return output, nil
+	return passwordChanged, nil
 }
@@ -5,6 +5,7 @@ import (
 	"database/sql"
 	"errors"
 	"testing"
+	"time"
 
 	"github.com/google/uuid"
 	"github.com/sesaquecruz/go-auth-api/internal/entity"
@@ -19,10 +20,14 @@ func Test_UpdateUserUseCase_NewUpdateUserUseCase(t *testing.T) {
 
 	userFactory := entity.NewMockUserFactoryInterface(ctrl)
 	userRepository := entity.NewMockUserRepositoryInterface(ctrl)
-	updateUserUseCase := NewUpdateUserUseCase(userFactory, userRepository)
+	sessionRepository := entity.NewMockSessionRepositoryInterface(ctrl)
+	transactionManager := entity.NewMockTransactionManagerInterface(ctrl)
+	updateUserUseCase := NewUpdateUserUseCase(userFactory, userRepository, sessionRepository, transactionManager)
 	assert.NotNil(t, updateUserUseCase)
 	assert.Equal(t, userFactory, updateUserUseCase.UserFactory)
 	assert.Equal(t, userRepository, updateUserUseCase.UserRepository)
+	assert.Equal(t, sessionRepository, updateUserUseCase.SessionRepository)
+	assert.Equal(t, transactionManager, updateUserUseCase.TransactionManager)
 }
 
 func Test_UpdateUserUseCase_Execute_WhenUserDataIsValid(t *testing.T) {
@@ -37,7 +42,13 @@ func Test_UpdateUserUseCase_Execute_WhenUserDataIsValid(t *testing.T) {
 
 	userFactory := entity.NewMockUserFactoryInterface(ctrl)
 	userRepository := entity.NewMockUserRepositoryInterface(ctrl)
-	updateUserUseCase := UpdateUserUseCase{UserFactory: userFactory, UserRepository: userRepository}
+	sessionRepository := entity.NewMockSessionRepositoryInterface(ctrl)
+	updateUserUseCase := UpdateUserUseCase{
+		UserFactory:        userFactory,
+		UserRepository:     userRepository,
+		SessionRepository:  sessionRepository,
+		TransactionManager: newTransactionManager(ctrl),
+	}
 
 	ctx := context.Background()
 	input := UpdateUserUseCaseInputDTO{
@@ -49,11 +60,19 @@ func Test_UpdateUserUseCase_Execute_WhenUserDataIsValid(t *testing.T) {
 	userFactory.EXPECT().GetUser(input.ID, input.Email, input.Password).Return(user, nil).Times(1)
 	userRepository.EXPECT().FindById(ctx, user.ID).Return(user, nil).Times(1)
 	userRepository.EXPECT().FindByEmail(ctx, input.Email).Return(user, nil).Times(1)
-	userRepository.EXPECT().Update(ctx, *user).Return(nil).Times(1)
+	userRepository.EXPECT().Update(ctx, gomock.Any()).
+		DoAndReturn(func(_ context.Context, updated entity.User) error {
+			assert.Equal(t, user.ID, updated.ID)
+			assert.False(t, updated.UpdatedAt.IsZero())
+			assert.Equal(t, updated.UpdatedAt, updated.PasswordChangedAt)
+			return nil
+		}).Times(1)
+	sessionRepository.EXPECT().RevokeByUser(ctx, user.ID, gomock.Any()).Return(nil).Times(1)
 
 	output, err := updateUserUseCase.Execute(ctx, input)
 	assert.Nil(t, err)
 	assert.Equal(t, user.ID.String(), output.ID)
+	assert.True(t, output.PasswordChanged)
 }
 
 func Test_UpdateUserUseCase_Execute_WhenUserDataIsInvalid(t *testing.T) {
@@ -68,7 +87,11 @@ func Test_UpdateUserUseCase_Execute_WhenUserDataIsInvalid(t *testing.T) {
 
 	userFactory := entity.NewMockUserFactoryInterface(ctrl)
 	userRepository := entity.NewMockUserRepositoryInterface(ctrl)
-	updateUserUseCase := UpdateUserUseCase{UserFactory: userFactory, UserRepository: userRepository}
+	updateUserUseCase := UpdateUserUseCase{
+		UserFactory:        userFactory,
+		UserRepository:     userRepository,
+		TransactionManager: newTransactionManager(ctrl),
+	}
 
 	ctx := context.Background()
 	input := UpdateUserUseCaseInputDTO{
@@ -96,7 +119,11 @@ func Test_UpdateUserUseCase_Execute_WhenUserIdIsInvalid(t *testing.T) {
 
 	userFactory := entity.NewMockUserFactoryInterface(ctrl)
 	userRepository := entity.NewMockUserRepositoryInterface(ctrl)
-	updateUserUseCase := UpdateUserUseCase{UserFactory: userFactory, UserRepository: userRepository}
+	updateUserUseCase := UpdateUserUseCase{
+		UserFactory:        userFactory,
+		UserRepository:     userRepository,
+		TransactionManager: newTransactionManager(ctrl),
+	}
 
 	ctx := context.Background()
 	input := UpdateUserUseCaseInputDTO{
@@ -125,7 +152,13 @@ func Test_UpdateUserUseCase_Execute_WhenUserEmailIsNew(t *testing.T) {
 
 	userFactory := entity.NewMockUserFactoryInterface(ctrl)
 	userRepository := entity.NewMockUserRepositoryInterface(ctrl)
-	updateUserUseCase := UpdateUserUseCase{UserFactory: userFactory, UserRepository: userRepository}
+	sessionRepository := entity.NewMockSessionRepositoryInterface(ctrl)
+	updateUserUseCase := UpdateUserUseCase{
+		UserFactory:        userFactory,
+		UserRepository:     userRepository,
+		SessionRepository:  sessionRepository,
+		TransactionManager: newTransactionManager(ctrl),
+	}
 
 	ctx := context.Background()
 	input := UpdateUserUseCaseInputDTO{
@@ -137,7 +170,8 @@ func Test_UpdateUserUseCase_Execute_WhenUserEmailIsNew(t *testing.T) {
 	userFactory.EXPECT().GetUser(input.ID, input.Email, input.Password).Return(user, nil).Times(1)
 	userRepository.EXPECT().FindById(ctx, user.ID).Return(user, nil).Times(1)
 	userRepository.EXPECT().FindByEmail(ctx, input.Email).Return(nil, sql.ErrNoRows).Times(1)
-	userRepository.EXPECT().Update(ctx, *user).Return(nil).Times(1)
+	userRepository.EXPECT().Update(ctx, gomock.Any()).Return(nil).Times(1)
+	sessionRepository.EXPECT().RevokeByUser(ctx, user.ID, gomock.Any()).Return(nil).Times(1)
 
 	output, err := updateUserUseCase.Execute(ctx, input)
 	assert.Nil(t, err)
@@ -162,7 +196,11 @@ func Test_UpdateUserUseCase_Execute_WhenUserEmailAlreadyUsed(t *testing.T) {
 
 	userFactory := entity.NewMockUserFactoryInterface(ctrl)
 	userRepository := entity.NewMockUserRepositoryInterface(ctrl)
-	updateUserUseCase := UpdateUserUseCase{UserFactory: userFactory, UserRepository: userRepository}
+	updateUserUseCase := UpdateUserUseCase{
+		UserFactory:        userFactory,
+		UserRepository:     userRepository,
+		TransactionManager: newTransactionManager(ctrl),
+	}
 
 	ctx := context.Background()
 	input := UpdateUserUseCaseInputDTO{
@@ -179,3 +217,195 @@ func Test_UpdateUserUseCase_Execute_WhenUserEmailAlreadyUsed(t *testing.T) {
 	assert.Nil(t, output)
 	assert.ErrorIs(t, err, ErrUpdateUserEmailAlreadyUsed)
 }
+
+func Test_UpdateUserUseCase_Execute_WhenUserEmailIsTakenConcurrently(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	user := &entity.User{
+		ID:       uuid.New(),
+		Email:    "user@mail.com",
+		Password: "12345",
+	}
+
+	userFactory := entity.NewMockUserFactoryInterface(ctrl)
+	userRepository := entity.NewMockUserRepositoryInterface(ctrl)
+	updateUserUseCase := UpdateUserUseCase{
+		UserFactory:        userFactory,
+		UserRepository:     userRepository,
+		TransactionManager: newTransactionManager(ctrl),
+	}
+
+	ctx := context.Background()
+	input := UpdateUserUseCaseInputDTO{
+		ID:       user.ID.String(),
+		Email:    user.Email,
+		Password: user.Password,
+	}
+
+	userFactory.EXPECT().GetUser(input.ID, input.Email, input.Password).Return(user, nil).Times(1)
+	userRepository.EXPECT().FindById(ctx, user.ID).Return(user, nil).Times(1)
+	userRepository.EXPECT().FindByEmail(ctx, input.Email).Return(nil, sql.ErrNoRows).Times(1)
+	userRepository.EXPECT().Update(ctx, gomock.Any()).Return(entity.ErrUserEmailAlreadyExists).Times(1)
+
+	output, err := updateUserUseCase.Execute(ctx, input)
+	assert.Nil(t, output)
+	assert.ErrorIs(t, err, ErrUpdateUserEmailAlreadyUsed)
+}
+
+func Test_UpdateUserUseCase_Execute_WhenPasswordIsUnchanged(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	stored, err := entity.NewUserFactory().NewUser("user@mail.com", "12345")
+	assert.Nil(t, err)
+	stored.PasswordChangedAt = stored.PasswordChangedAt.Add(-time.Hour)
+
+	user := &entity.User{ID: stored.ID, Email: "new@mail.com", Password: stored.Password}
+
+	userFactory := entity.NewMockUserFactoryInterface(ctrl)
+	userRepository := entity.NewMockUserRepositoryInterface(ctrl)
+	sessionRepository := entity.NewMockSessionRepositoryInterface(ctrl)
+	updateUserUseCase := UpdateUserUseCase{
+		UserFactory:        userFactory,
+		UserRepository:     userRepository,
+		SessionRepository:  sessionRepository,
+		TransactionManager: newTransactionManager(ctrl),
+	}
+
+	ctx := context.Background()
+	input := UpdateUserUseCaseInputDTO{
+		ID:       stored.ID.String(),
+		Email:    user.Email,
+		Password: "12345",
+	}
+
+	userFactory.EXPECT().GetUser(input.ID, input.Email, input.Password).Return(user, nil).Times(1)
+	userRepository.EXPECT().FindById(ctx, user.ID).Return(stored, nil).Times(1)
+	userRepository.EXPECT().FindByEmail(ctx, input.Email).Return(nil, sql.ErrNoRows).Times(1)
+	userRepository.EXPECT().Update(ctx, gomock.Any()).
+		DoAndReturn(func(_ context.Context, updated entity.User) error {
+			assert.Equal(t, stored.CreatedAt, updated.CreatedAt)
+			assert.Equal(t, stored.PasswordChangedAt, updated.PasswordChangedAt)
+			assert.Equal(t, stored.Version, updated.Version)
+			assert.True(t, updated.UpdatedAt.After(stored.UpdatedAt) || updated.UpdatedAt.Equal(stored.UpdatedAt))
+			return nil
+		}).Times(1)
+	sessionRepository.EXPECT().RevokeByUser(gomock.Any(), gomock.Any(), gomock.Any()).Times(0)
+
+	output, err := updateUserUseCase.Execute(ctx, input)
+	assert.Nil(t, err)
+	assert.Equal(t, stored.ID.String(), output.ID)
+	assert.Equal(t, stored.Version+1, output.Version)
+	assert.False(t, output.PasswordChanged)
+}
+
+func Test_UpdateUserUseCase_Execute_WhenVersionIsStale(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	stored := &entity.User{ID: uuid.New(), Email: "user@mail.com", Password: "12345", Version: 3}
+	user := &entity.User{ID: stored.ID, Email: stored.Email, Password: stored.Password}
+
+	userFactory := entity.NewMockUserFactoryInterface(ctrl)
+	userRepository := entity.NewMockUserRepositoryInterface(ctrl)
+	updateUserUseCase := UpdateUserUseCase{
+		UserFactory:        userFactory,
+		UserRepository:     userRepository,
+		TransactionManager: newTransactionManager(ctrl),
+	}
+
+	ctx := context.Background()
+	input := UpdateUserUseCaseInputDTO{
+		ID:       stored.ID.String(),
+		Email:    stored.Email,
+		Password: stored.Password,
+		Version:  2,
+	}
+
+	userFactory.EXPECT().GetUser(input.ID, input.Email, input.Password).Return(user, nil).Times(2)
+	userRepository.EXPECT().FindById(ctx, stored.ID).Return(stored, nil).Times(2)
+	userRepository.EXPECT().FindByEmail(ctx, input.Email).Return(stored, nil).Times(1)
+	userRepository.EXPECT().Update(ctx, gomock.Any()).Return(entity.ErrUserVersionConflict).Times(1)
+
+	output, err := updateUserUseCase.Execute(ctx, input)
+	assert.Nil(t, output)
+	assert.ErrorIs(t, err, ErrUpdateUserVersionConflict)
+
+	input.Version = stored.Version
+	output, err = updateUserUseCase.Execute(ctx, input)
+	assert.Nil(t, output)
+	assert.ErrorIs(t, err, ErrUpdateUserVersionConflict)
+}
+
+func Test_UpdateUserUseCase_Execute_WhenTransactionFails(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	user := &entity.User{
+		ID:       uuid.New(),
+		Email:    "user@mail.com",
+		Password: "12345",
+	}
+
+	userFactory := entity.NewMockUserFactoryInterface(ctrl)
+	userRepository := entity.NewMockUserRepositoryInterface(ctrl)
+	transactionManager := entity.NewMockTransactionManagerInterface(ctrl)
+	updateUserUseCase := UpdateUserUseCase{
+		UserFactory:        userFactory,
+		UserRepository:     userRepository,
+		TransactionManager: transactionManager,
+	}
+
+	ctx := context.Background()
+	input := UpdateUserUseCaseInputDTO{
+		ID:       user.ID.String(),
+		Email:    user.Email,
+		Password: user.Password,
+	}
+
+	userFactory.EXPECT().GetUser(input.ID, input.Email, input.Password).Return(user, nil).Times(1)
+	transactionManager.EXPECT().Do(ctx, gomock.Any()).Return(errors.New("commit failed")).Times(1)
+
+	output, err := updateUserUseCase.Execute(ctx, input)
+	assert.Nil(t, output)
+	assert.ErrorIs(t, err, ErrUpdateUserInternalError)
+}
+
+func Test_UpdateUserUseCase_Execute_WhenSessionsCannotBeRevoked(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	user := &entity.User{
+		ID:       uuid.New(),
+		Email:    "user@mail.com",
+		Password: "12345",
+	}
+
+	userFactory := entity.NewMockUserFactoryInterface(ctrl)
+	userRepository := entity.NewMockUserRepositoryInterface(ctrl)
+	sessionRepository := entity.NewMockSessionRepositoryInterface(ctrl)
+	updateUserUseCase := UpdateUserUseCase{
+		UserFactory:        userFactory,
+		UserRepository:     userRepository,
+		SessionRepository:  sessionRepository,
+		TransactionManager: newTransactionManager(ctrl),
+	}
+
+	ctx := context.Background()
+	input := UpdateUserUseCaseInputDTO{
+		ID:       user.ID.String(),
+		Email:    user.Email,
+		Password: user.Password,
+	}
+
+	userFactory.EXPECT().GetUser(input.ID, input.Email, input.Password).Return(user, nil).Times(1)
+	userRepository.EXPECT().FindById(ctx, user.ID).Return(user, nil).Times(1)
+	userRepository.EXPECT().FindByEmail(ctx, input.Email).Return(user, nil).Times(1)
+	userRepository.EXPECT().Update(ctx, gomock.Any()).Return(nil).Times(1)
+	sessionRepository.EXPECT().RevokeByUser(ctx, user.ID, gomock.Any()).Return(errors.New("")).Times(1)
+
+	output, err := updateUserUseCase.Execute(ctx, input)
+	assert.Nil(t, output)
+	assert.ErrorIs(t, err, ErrUpdateUserInternalError)
+}
@@ -0,0 +1,7 @@
+// Package migrations embeds the SQL migrations of every supported database driver.
+package migrations
+
+import "embed"
+
+//go:embed mysql/*.sql postgres/*.sql sqlite/*.sql
+var FS embed.FS
@@ -0,0 +1 @@
+ALTER TABLE `users` ADD UNIQUE INDEX `users_email_unique` (`email`);
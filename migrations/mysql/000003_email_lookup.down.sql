@@ -0,0 +1,4 @@
+ALTER TABLE `users` DROP INDEX `users_email_lookup_unique`;
+ALTER TABLE `users` DROP COLUMN `email_lookup`;
+DROP TABLE IF EXISTS `user_email_collisions`;
+ALTER TABLE `users` ADD UNIQUE INDEX `users_email_unique` (`email`);
@@ -0,0 +1,26 @@
+ALTER TABLE `users` MODIFY `email` VARCHAR(254) NOT NULL;
+ALTER TABLE `users` ADD COLUMN `email_lookup` VARCHAR(254) NULL;
+UPDATE `users` SET `email_lookup` = LOWER(TRIM(`email`));
+
+CREATE TABLE IF NOT EXISTS `user_email_collisions` (
+  `user_id` VARCHAR(36) PRIMARY KEY,
+  `email_lookup` VARCHAR(254) NOT NULL,
+  `detected_at` TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP
+);
+
+INSERT INTO `user_email_collisions` (`user_id`, `email_lookup`)
+SELECT `u`.`id`, `u`.`email_lookup`
+FROM `users` `u`
+JOIN (
+  SELECT `email_lookup`, MIN(`id`) AS `kept_id`
+  FROM `users`
+  GROUP BY `email_lookup`
+  HAVING COUNT(*) > 1
+) `d` ON `u`.`email_lookup` = `d`.`email_lookup` AND `u`.`id` <> `d`.`kept_id`;
+
+UPDATE `users` SET `email_lookup` = CONCAT('collision:', `id`)
+WHERE `id` IN (SELECT `user_id` FROM `user_email_collisions`);
+
+ALTER TABLE `users` MODIFY `email_lookup` VARCHAR(254) NOT NULL;
+ALTER TABLE `users` DROP INDEX `users_email_unique`;
+ALTER TABLE `users` ADD UNIQUE INDEX `users_email_lookup_unique` (`email_lookup`);
@@ -0,0 +1,6 @@
+ALTER TABLE `users`
+  DROP COLUMN `created_at`,
+  DROP COLUMN `updated_at`,
+  DROP COLUMN `password_changed_at`,
+  DROP COLUMN `last_login_at`,
+  DROP COLUMN `last_login_ip`;
@@ -0,0 +1,6 @@
+ALTER TABLE `users`
+  ADD COLUMN `created_at` DATETIME(6) NOT NULL DEFAULT CURRENT_TIMESTAMP(6),
+  ADD COLUMN `updated_at` DATETIME(6) NOT NULL DEFAULT CURRENT_TIMESTAMP(6),
+  ADD COLUMN `password_changed_at` DATETIME(6) NOT NULL DEFAULT CURRENT_TIMESTAMP(6),
+  ADD COLUMN `last_login_at` DATETIME(6) NULL,
+  ADD COLUMN `last_login_ip` VARCHAR(45) NOT NULL DEFAULT '';
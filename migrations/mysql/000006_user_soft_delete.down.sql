@@ -0,0 +1,2 @@
+DROP INDEX `users_deleted_at` ON `users`;
+ALTER TABLE `users` DROP COLUMN `deleted_at`;
@@ -0,0 +1,2 @@
+ALTER TABLE `users` ADD COLUMN `deleted_at` DATETIME(6) NULL;
+CREATE INDEX `users_deleted_at` ON `users` (`deleted_at`);
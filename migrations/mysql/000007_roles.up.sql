@@ -0,0 +1,22 @@
+CREATE TABLE IF NOT EXISTS `roles` (
+  `name` VARCHAR(64) PRIMARY KEY,
+  `description` VARCHAR(255) NOT NULL DEFAULT ''
+);
+
+CREATE TABLE IF NOT EXISTS `role_permissions` (
+  `role_name` VARCHAR(64) NOT NULL,
+  `permission` VARCHAR(128) NOT NULL,
+  PRIMARY KEY (`role_name`, `permission`),
+  CONSTRAINT `role_permissions_role_fk` FOREIGN KEY (`role_name`) REFERENCES `roles` (`name`) ON DELETE CASCADE
+);
+
+CREATE TABLE IF NOT EXISTS `user_roles` (
+  `user_id` VARCHAR(36) NOT NULL,
+  `role_name` VARCHAR(64) NOT NULL,
+  PRIMARY KEY (`user_id`, `role_name`),
+  CONSTRAINT `user_roles_user_fk` FOREIGN KEY (`user_id`) REFERENCES `users` (`id`) ON DELETE CASCADE,
+  CONSTRAINT `user_roles_role_fk` FOREIGN KEY (`role_name`) REFERENCES `roles` (`name`) ON DELETE CASCADE
+);
+
+INSERT INTO `roles` (`name`, `description`) VALUES ('admin', 'Manages user accounts');
+INSERT INTO `role_permissions` (`role_name`, `permission`) VALUES ('admin', 'users:admin');
@@ -0,0 +1,2 @@
+DROP INDEX `users_status_created_at_id` ON `users`;
+DROP INDEX `users_created_at_id` ON `users`;
@@ -0,0 +1,2 @@
+CREATE INDEX `users_created_at_id` ON `users` (`created_at`, `id`);
+CREATE INDEX `users_status_created_at_id` ON `users` (`status`, `created_at`, `id`);
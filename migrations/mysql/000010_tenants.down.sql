@@ -0,0 +1,14 @@
+CREATE INDEX `users_created_at_id` ON `users` (`created_at`, `id`);
+CREATE INDEX `users_status_created_at_id` ON `users` (`status`, `created_at`, `id`);
+DROP INDEX `users_tenant_status_created_at_id` ON `users`;
+DROP INDEX `users_tenant_created_at_id` ON `users`;
+
+-- Users of other tenants cannot outlive them.
+DELETE FROM `users` WHERE `tenant_id` <> '00000000-0000-0000-0000-000000000001';
+
+ALTER TABLE `users` ADD UNIQUE INDEX `users_email_lookup_unique` (`email_lookup`);
+ALTER TABLE `users` DROP FOREIGN KEY `users_tenant_fk`;
+ALTER TABLE `users` DROP INDEX `users_tenant_email_lookup_unique`;
+
+ALTER TABLE `users` DROP COLUMN `tenant_id`;
+DROP TABLE IF EXISTS `tenants`;
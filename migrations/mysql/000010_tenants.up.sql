@@ -0,0 +1,22 @@
+CREATE TABLE IF NOT EXISTS `tenants` (
+  `id` VARCHAR(36) PRIMARY KEY,
+  `slug` VARCHAR(63) NOT NULL,
+  `name` VARCHAR(100) NOT NULL,
+  `host` VARCHAR(253) NULL,
+  `created_at` DATETIME(6) NOT NULL DEFAULT CURRENT_TIMESTAMP(6),
+  UNIQUE INDEX `tenants_slug_unique` (`slug`),
+  UNIQUE INDEX `tenants_host_unique` (`host`)
+);
+
+INSERT INTO `tenants` (`id`, `slug`, `name`) VALUES ('00000000-0000-0000-0000-000000000001', 'default', 'Default');
+
+ALTER TABLE `users` ADD COLUMN `tenant_id` VARCHAR(36) NOT NULL DEFAULT '00000000-0000-0000-0000-000000000001';
+ALTER TABLE `users` ADD CONSTRAINT `users_tenant_fk` FOREIGN KEY (`tenant_id`) REFERENCES `tenants` (`id`);
+
+ALTER TABLE `users` DROP INDEX `users_email_lookup_unique`;
+ALTER TABLE `users` ADD UNIQUE INDEX `users_tenant_email_lookup_unique` (`tenant_id`, `email_lookup`);
+
+DROP INDEX `users_status_created_at_id` ON `users`;
+DROP INDEX `users_created_at_id` ON `users`;
+CREATE INDEX `users_tenant_created_at_id` ON `users` (`tenant_id`, `created_at`, `id`);
+CREATE INDEX `users_tenant_status_created_at_id` ON `users` (`tenant_id`, `status`, `created_at`, `id`);
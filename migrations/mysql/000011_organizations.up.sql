@@ -0,0 +1,32 @@
+CREATE TABLE IF NOT EXISTS `organizations` (
+  `id` VARCHAR(36) PRIMARY KEY,
+  `tenant_id` VARCHAR(36) NOT NULL,
+  `name` VARCHAR(100) NOT NULL,
+  `created_at` DATETIME(6) NOT NULL DEFAULT CURRENT_TIMESTAMP(6),
+  INDEX `organizations_tenant_id` (`tenant_id`),
+  CONSTRAINT `organizations_tenant_fk` FOREIGN KEY (`tenant_id`) REFERENCES `tenants` (`id`) ON DELETE CASCADE
+);
+
+CREATE TABLE IF NOT EXISTS `organization_members` (
+  `organization_id` VARCHAR(36) NOT NULL,
+  `user_id` VARCHAR(36) NOT NULL,
+  `role` VARCHAR(32) NOT NULL,
+  `created_at` DATETIME(6) NOT NULL DEFAULT CURRENT_TIMESTAMP(6),
+  PRIMARY KEY (`organization_id`, `user_id`),
+  INDEX `organization_members_user_id` (`user_id`),
+  CONSTRAINT `organization_members_organization_fk` FOREIGN KEY (`organization_id`) REFERENCES `organizations` (`id`) ON DELETE CASCADE,
+  CONSTRAINT `organization_members_user_fk` FOREIGN KEY (`user_id`) REFERENCES `users` (`id`) ON DELETE CASCADE
+);
+
+CREATE TABLE IF NOT EXISTS `organization_invitations` (
+  `id` VARCHAR(36) PRIMARY KEY,
+  `organization_id` VARCHAR(36) NOT NULL,
+  `email` VARCHAR(254) NOT NULL,
+  `role` VARCHAR(32) NOT NULL,
+  `invited_by` VARCHAR(36) NOT NULL,
+  `expires_at` DATETIME(6) NOT NULL,
+  `created_at` DATETIME(6) NOT NULL DEFAULT CURRENT_TIMESTAMP(6),
+  INDEX `organization_invitations_organization_id` (`organization_id`),
+  CONSTRAINT `organization_invitations_organization_fk` FOREIGN KEY (`organization_id`) REFERENCES `organizations` (`id`) ON DELETE CASCADE,
+  CONSTRAINT `organization_invitations_invited_by_fk` FOREIGN KEY (`invited_by`) REFERENCES `users` (`id`) ON DELETE CASCADE
+);
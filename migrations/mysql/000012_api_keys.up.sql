@@ -0,0 +1,14 @@
+CREATE TABLE IF NOT EXISTS `api_keys` (
+  `id` VARCHAR(36) PRIMARY KEY,
+  `user_id` VARCHAR(36) NOT NULL,
+  `name` VARCHAR(100) NOT NULL,
+  `prefix` VARCHAR(16) NOT NULL UNIQUE,
+  `secret_hash` VARCHAR(64) NOT NULL,
+  `scopes` VARCHAR(1000) NOT NULL DEFAULT '',
+  `expires_at` DATETIME(6) NOT NULL,
+  `last_used_at` DATETIME(6) NULL,
+  `revoked_at` DATETIME(6) NULL,
+  `created_at` DATETIME(6) NOT NULL DEFAULT CURRENT_TIMESTAMP(6),
+  INDEX `api_keys_user_id` (`user_id`),
+  CONSTRAINT `api_keys_user_fk` FOREIGN KEY (`user_id`) REFERENCES `users` (`id`) ON DELETE CASCADE
+);
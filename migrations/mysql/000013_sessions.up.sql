@@ -0,0 +1,12 @@
+CREATE TABLE IF NOT EXISTS `sessions` (
+  `id` VARCHAR(36) PRIMARY KEY,
+  `user_id` VARCHAR(36) NOT NULL,
+  `user_agent` VARCHAR(255) NOT NULL DEFAULT '',
+  `ip` VARCHAR(45) NOT NULL DEFAULT '',
+  `expires_at` DATETIME(6) NOT NULL,
+  `last_seen_at` DATETIME(6) NOT NULL,
+  `revoked_at` DATETIME(6) NULL,
+  `created_at` DATETIME(6) NOT NULL DEFAULT CURRENT_TIMESTAMP(6),
+  INDEX `sessions_user_id` (`user_id`),
+  CONSTRAINT `sessions_user_fk` FOREIGN KEY (`user_id`) REFERENCES `users` (`id`) ON DELETE CASCADE
+);
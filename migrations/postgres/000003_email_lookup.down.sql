@@ -0,0 +1,4 @@
+DROP INDEX IF EXISTS users_email_lookup_unique;
+ALTER TABLE users DROP COLUMN IF EXISTS email_lookup;
+DROP TABLE IF EXISTS user_email_collisions;
+CREATE UNIQUE INDEX IF NOT EXISTS users_email_unique ON users (LOWER(email));
@@ -0,0 +1,26 @@
+ALTER TABLE users ALTER COLUMN email TYPE VARCHAR(254);
+ALTER TABLE users ADD COLUMN email_lookup VARCHAR(254);
+UPDATE users SET email_lookup = LOWER(TRIM(email));
+
+CREATE TABLE IF NOT EXISTS user_email_collisions (
+  user_id UUID PRIMARY KEY,
+  email_lookup VARCHAR(254) NOT NULL,
+  detected_at TIMESTAMPTZ NOT NULL DEFAULT NOW()
+);
+
+INSERT INTO user_email_collisions (user_id, email_lookup)
+SELECT u.id, u.email_lookup
+FROM users u
+JOIN (
+  SELECT email_lookup, MIN(id::TEXT) AS kept_id
+  FROM users
+  GROUP BY email_lookup
+  HAVING COUNT(*) > 1
+) d ON u.email_lookup = d.email_lookup AND u.id::TEXT <> d.kept_id;
+
+UPDATE users SET email_lookup = 'collision:' || id::TEXT
+WHERE id IN (SELECT user_id FROM user_email_collisions);
+
+ALTER TABLE users ALTER COLUMN email_lookup SET NOT NULL;
+DROP INDEX IF EXISTS users_email_unique;
+CREATE UNIQUE INDEX IF NOT EXISTS users_email_lookup_unique ON users (email_lookup);
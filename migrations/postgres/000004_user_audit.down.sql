@@ -0,0 +1,6 @@
+ALTER TABLE users
+  DROP COLUMN IF EXISTS created_at,
+  DROP COLUMN IF EXISTS updated_at,
+  DROP COLUMN IF EXISTS password_changed_at,
+  DROP COLUMN IF EXISTS last_login_at,
+  DROP COLUMN IF EXISTS last_login_ip;
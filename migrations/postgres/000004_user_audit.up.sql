@@ -0,0 +1,6 @@
+ALTER TABLE users
+  ADD COLUMN created_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+  ADD COLUMN updated_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+  ADD COLUMN password_changed_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+  ADD COLUMN last_login_at TIMESTAMPTZ NULL,
+  ADD COLUMN last_login_ip VARCHAR(45) NOT NULL DEFAULT '';
@@ -0,0 +1 @@
+ALTER TABLE users ADD COLUMN status VARCHAR(32) NOT NULL DEFAULT 'active';
@@ -0,0 +1,3 @@
+DROP INDEX users_email_lookup_prefix;
+DROP INDEX users_status_created_at_id;
+DROP INDEX users_created_at_id;
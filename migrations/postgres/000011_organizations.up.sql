@@ -0,0 +1,27 @@
+CREATE TABLE IF NOT EXISTS organizations (
+  id UUID PRIMARY KEY,
+  tenant_id UUID NOT NULL REFERENCES tenants (id) ON DELETE CASCADE,
+  name VARCHAR(100) NOT NULL,
+  created_at TIMESTAMPTZ NOT NULL DEFAULT NOW()
+);
+CREATE INDEX organizations_tenant_id ON organizations (tenant_id);
+
+CREATE TABLE IF NOT EXISTS organization_members (
+  organization_id UUID NOT NULL REFERENCES organizations (id) ON DELETE CASCADE,
+  user_id UUID NOT NULL REFERENCES users (id) ON DELETE CASCADE,
+  role VARCHAR(32) NOT NULL,
+  created_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+  PRIMARY KEY (organization_id, user_id)
+);
+CREATE INDEX organization_members_user_id ON organization_members (user_id);
+
+CREATE TABLE IF NOT EXISTS organization_invitations (
+  id UUID PRIMARY KEY,
+  organization_id UUID NOT NULL REFERENCES organizations (id) ON DELETE CASCADE,
+  email VARCHAR(254) NOT NULL,
+  role VARCHAR(32) NOT NULL,
+  invited_by UUID NOT NULL REFERENCES users (id) ON DELETE CASCADE,
+  expires_at TIMESTAMPTZ NOT NULL,
+  created_at TIMESTAMPTZ NOT NULL DEFAULT NOW()
+);
+CREATE INDEX organization_invitations_organization_id ON organization_invitations (organization_id);
@@ -0,0 +1,24 @@
+ALTER TABLE users ADD COLUMN email_lookup VARCHAR(254) NOT NULL DEFAULT '';
+UPDATE users SET email_lookup = LOWER(TRIM(email));
+
+CREATE TABLE IF NOT EXISTS user_email_collisions (
+  user_id VARCHAR(36) PRIMARY KEY,
+  email_lookup VARCHAR(254) NOT NULL,
+  detected_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP
+);
+
+INSERT INTO user_email_collisions (user_id, email_lookup)
+SELECT u.id, u.email_lookup
+FROM users u
+JOIN (
+  SELECT email_lookup, MIN(id) AS kept_id
+  FROM users
+  GROUP BY email_lookup
+  HAVING COUNT(*) > 1
+) d ON u.email_lookup = d.email_lookup AND u.id <> d.kept_id;
+
+UPDATE users SET email_lookup = 'collision:' || id
+WHERE id IN (SELECT user_id FROM user_email_collisions);
+
+DROP INDEX IF EXISTS users_email_unique;
+CREATE UNIQUE INDEX IF NOT EXISTS users_email_lookup_unique ON users (email_lookup);
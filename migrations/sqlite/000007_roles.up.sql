@@ -0,0 +1,19 @@
+CREATE TABLE IF NOT EXISTS roles (
+  name VARCHAR(64) PRIMARY KEY,
+  description VARCHAR(255) NOT NULL DEFAULT ''
+);
+
+CREATE TABLE IF NOT EXISTS role_permissions (
+  role_name VARCHAR(64) NOT NULL REFERENCES roles (name) ON DELETE CASCADE,
+  permission VARCHAR(128) NOT NULL,
+  PRIMARY KEY (role_name, permission)
+);
+
+CREATE TABLE IF NOT EXISTS user_roles (
+  user_id VARCHAR(36) NOT NULL REFERENCES users (id) ON DELETE CASCADE,
+  role_name VARCHAR(64) NOT NULL REFERENCES roles (name) ON DELETE CASCADE,
+  PRIMARY KEY (user_id, role_name)
+);
+
+INSERT INTO roles (name, description) VALUES ('admin', 'Manages user accounts');
+INSERT INTO role_permissions (role_name, permission) VALUES ('admin', 'users:admin');
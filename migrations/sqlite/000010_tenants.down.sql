@@ -0,0 +1,13 @@
+DROP INDEX users_tenant_status_created_at_id;
+DROP INDEX users_tenant_created_at_id;
+CREATE INDEX users_created_at_id ON users (created_at, id);
+CREATE INDEX users_status_created_at_id ON users (status, created_at, id);
+
+-- Users of other tenants cannot outlive them.
+DELETE FROM users WHERE tenant_id <> '00000000-0000-0000-0000-000000000001';
+
+DROP INDEX users_tenant_email_lookup_unique;
+CREATE UNIQUE INDEX users_email_lookup_unique ON users (email_lookup);
+
+ALTER TABLE users DROP COLUMN tenant_id;
+DROP TABLE IF EXISTS tenants;
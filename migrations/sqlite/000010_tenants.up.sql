@@ -0,0 +1,21 @@
+CREATE TABLE IF NOT EXISTS tenants (
+  id VARCHAR(36) PRIMARY KEY,
+  slug VARCHAR(63) NOT NULL UNIQUE,
+  name VARCHAR(100) NOT NULL,
+  host VARCHAR(253) NULL UNIQUE,
+  created_at DATETIME NOT NULL DEFAULT CURRENT_TIMESTAMP
+);
+
+INSERT INTO tenants (id, slug, name) VALUES ('00000000-0000-0000-0000-000000000001', 'default', 'Default');
+
+-- SQLite cannot add a column with both a foreign key and a non-null default, and rebuilding users
+-- would break the foreign keys that reference it, so tenant_id is not declared as a foreign key here.
+ALTER TABLE users ADD COLUMN tenant_id VARCHAR(36) NOT NULL DEFAULT '00000000-0000-0000-0000-000000000001';
+
+DROP INDEX users_email_lookup_unique;
+CREATE UNIQUE INDEX users_tenant_email_lookup_unique ON users (tenant_id, email_lookup);
+
+DROP INDEX users_status_created_at_id;
+DROP INDEX users_created_at_id;
+CREATE INDEX users_tenant_created_at_id ON users (tenant_id, created_at, id);
+CREATE INDEX users_tenant_status_created_at_id ON users (tenant_id, status, created_at, id);
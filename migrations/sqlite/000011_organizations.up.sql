@@ -0,0 +1,27 @@
+CREATE TABLE IF NOT EXISTS organizations (
+  id VARCHAR(36) PRIMARY KEY,
+  tenant_id VARCHAR(36) NOT NULL REFERENCES tenants (id) ON DELETE CASCADE,
+  name VARCHAR(100) NOT NULL,
+  created_at DATETIME NOT NULL DEFAULT CURRENT_TIMESTAMP
+);
+CREATE INDEX organizations_tenant_id ON organizations (tenant_id);
+
+CREATE TABLE IF NOT EXISTS organization_members (
+  organization_id VARCHAR(36) NOT NULL REFERENCES organizations (id) ON DELETE CASCADE,
+  user_id VARCHAR(36) NOT NULL REFERENCES users (id) ON DELETE CASCADE,
+  role VARCHAR(32) NOT NULL,
+  created_at DATETIME NOT NULL DEFAULT CURRENT_TIMESTAMP,
+  PRIMARY KEY (organization_id, user_id)
+);
+CREATE INDEX organization_members_user_id ON organization_members (user_id);
+
+CREATE TABLE IF NOT EXISTS organization_invitations (
+  id VARCHAR(36) PRIMARY KEY,
+  organization_id VARCHAR(36) NOT NULL REFERENCES organizations (id) ON DELETE CASCADE,
+  email VARCHAR(254) NOT NULL,
+  role VARCHAR(32) NOT NULL,
+  invited_by VARCHAR(36) NOT NULL REFERENCES users (id) ON DELETE CASCADE,
+  expires_at DATETIME NOT NULL,
+  created_at DATETIME NOT NULL DEFAULT CURRENT_TIMESTAMP
+);
+CREATE INDEX organization_invitations_organization_id ON organization_invitations (organization_id);
@@ -0,0 +1,175 @@
+package authverify
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/lestrrat-go/jwx/jwt"
+)
+
+// Principal is who a token was issued to, and what it grants.
+type Principal struct {
+	UserID           string
+	TenantID         string
+	SessionID        string
+	OrganizationID   string
+	OrganizationRole string
+	Roles            []string
+	Permissions      []string
+	// Scopes limit what the token may be used for, whatever the roles of the user.
+	Scopes []string
+	// AuthTime is when the user last entered their password, zero when the token does not tell.
+	AuthTime  time.Time
+	ExpiresAt time.Time
+}
+
+type principalKey struct{}
+
+func (p *Principal) HasRole(role string) bool {
+	return contains(p.Roles, role)
+}
+
+func (p *Principal) HasPermission(permission string) bool {
+	return contains(p.Permissions, permission)
+}
+
+func (p *Principal) HasScope(scope string) bool {
+	return contains(p.Scopes, scope)
+}
+
+func NewContext(ctx context.Context, principal *Principal) context.Context {
+	return context.WithValue(ctx, principalKey{}, principal)
+}
+
+// FromContext returns the Principal Middleware put into ctx.
+func FromContext(ctx context.Context) (*Principal, bool) {
+	principal, ok := ctx.Value(principalKey{}).(*Principal)
+	return principal, ok && principal != nil
+}
+
+// RequireRole rejects requests whose Principal lacks role. It must run after Middleware.
+func RequireRole(role string) func(http.Handler) http.Handler {
+	return requirePrincipal(func(p *Principal) bool { return p.HasRole(role) })
+}
+
+// RequirePermission rejects requests whose Principal lacks permission. It must run after Middleware.
+func RequirePermission(permission string) func(http.Handler) http.Handler {
+	return requirePrincipal(func(p *Principal) bool { return p.HasPermission(permission) })
+}
+
+// RequireScopes rejects requests whose Principal lacks any of scopes with the insufficient_scope error of
+// RFC 6750, as the Auth API does. It must run after Middleware.
+func RequireScopes(scopes ...string) func(http.Handler) http.Handler {
+	challenge := fmt.Sprintf(`Bearer error="insufficient_scope", scope=%q`, strings.Join(scopes, " "))
+
+	return func(next http.Handler) http.Handler {
+		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			principal, ok := FromContext(r.Context())
+			if !ok {
+				writeMessage(w, http.StatusUnauthorized, "unauthorized")
+				return
+			}
+
+			for _, scope := range scopes {
+				if !principal.HasScope(scope) {
+					w.Header().Set("WWW-Authenticate", challenge)
+					writeMessage(w, http.StatusForbidden, "insufficient scope")
+					return
+				}
+			}
+
+			next.ServeHTTP(w, r)
+		})
+	}
+}
+
+func requirePrincipal(allowed func(*Principal) bool) func(http.Handler) http.Handler {
+	return func(next http.Handler) http.Handler {
+		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			principal, ok := FromContext(r.Context())
+			if !ok {
+				writeMessage(w, http.StatusUnauthorized, "unauthorized")
+				return
+			}
+			if !allowed(principal) {
+				writeMessage(w, http.StatusForbidden, "forbidden")
+				return
+			}
+
+			next.ServeHTTP(w, r)
+		})
+	}
+}
+
+func principalOf(t jwt.Token) (*Principal, error) {
+	if t.Subject() == "" {
+		return nil, ErrInvalidToken
+	}
+
+	claims := t.PrivateClaims()
+	return &Principal{
+		UserID:           t.Subject(),
+		TenantID:         stringClaim(claims, "tid"),
+		SessionID:        stringClaim(claims, "sid"),
+		OrganizationID:   stringClaim(claims, "oid"),
+		OrganizationRole: stringClaim(claims, "org_role"),
+		Roles:            stringsClaim(claims, "roles"),
+		Permissions:      stringsClaim(claims, "permissions"),
+		Scopes:           strings.Fields(stringClaim(claims, "scope")),
+		AuthTime:         timeClaim(claims, "auth_time"),
+		ExpiresAt:        t.Expiration(),
+	}, nil
+}
+
+func stringClaim(claims map[string]interface{}, name string) string {
+	value, _ := claims[name].(string)
+	return value
+}
+
+func stringsClaim(claims map[string]interface{}, name string) []string {
+	values := make([]string, 0)
+	if list, ok := claims[name].([]interface{}); ok {
+		for _, value := range list {
+			if str, ok := value.(string); ok {
+				values = append(values, str)
+			}
+		}
+	}
+	return values
+}
+
+func timeClaim(claims map[string]interface{}, name string) time.Time {
+	switch value := claims[name].(type) {
+	case float64:
+		return time.Unix(int64(value), 0).UTC()
+	case int64:
+		return time.Unix(value, 0).UTC()
+	case json.Number:
+		seconds, err := value.Int64()
+		if err == nil {
+			return time.Unix(seconds, 0).UTC()
+		}
+	}
+	return time.Time{}
+}
+
+func contains(values []string, value string) bool {
+	for _, v := range values {
+		if v == value {
+			return true
+		}
+	}
+	return false
+}
+
+func writeMessage(w http.ResponseWriter, status int, message string) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(status)
+	json.NewEncoder(w).Encode(struct {
+		Message string `json:"message"`
+	}{message})
+}
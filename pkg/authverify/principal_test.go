@@ -0,0 +1,99 @@
+package authverify
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func Test_Principal_HasRoleAndPermission(t *testing.T) {
+	principal := &Principal{Roles: []string{"admin"}, Permissions: []string{"users:read"}, Scopes: []string{"profile:read"}}
+	assert.True(t, principal.HasRole("admin"))
+	assert.False(t, principal.HasRole("support"))
+	assert.True(t, principal.HasPermission("users:read"))
+	assert.False(t, principal.HasPermission("users:admin"))
+	assert.True(t, principal.HasScope("profile:read"))
+	assert.False(t, principal.HasScope("profile:write"))
+}
+
+func Test_FromContext(t *testing.T) {
+	_, ok := FromContext(context.Background())
+	assert.False(t, ok)
+
+	_, ok = FromContext(NewContext(context.Background(), nil))
+	assert.False(t, ok)
+
+	principal := &Principal{UserID: "user"}
+	found, ok := FromContext(NewContext(context.Background(), principal))
+	assert.True(t, ok)
+	assert.Equal(t, principal, found)
+}
+
+func Test_RequireRoleAndPermission(t *testing.T) {
+	next := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusOK)
+	})
+	principal := &Principal{Roles: []string{"admin"}, Permissions: []string{"users:read"}}
+
+	tests := []struct {
+		name      string
+		handler   http.Handler
+		principal *Principal
+		status    int
+	}{
+		{"with role", RequireRole("admin")(next), principal, http.StatusOK},
+		{"without role", RequireRole("support")(next), principal, http.StatusForbidden},
+		{"with permission", RequirePermission("users:read")(next), principal, http.StatusOK},
+		{"without permission", RequirePermission("users:admin")(next), principal, http.StatusForbidden},
+		{"without principal", RequireRole("admin")(next), nil, http.StatusUnauthorized},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			req := httptest.NewRequest(http.MethodGet, "/", nil)
+			if tt.principal != nil {
+				req = req.WithContext(NewContext(req.Context(), tt.principal))
+			}
+			rr := httptest.NewRecorder()
+			tt.handler.ServeHTTP(rr, req)
+
+			assert.Equal(t, tt.status, rr.Code)
+		})
+	}
+}
+
+func Test_RequireScopes(t *testing.T) {
+	next := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusOK)
+	})
+	principal := &Principal{Scopes: []string{"profile:read"}}
+
+	tests := []struct {
+		name      string
+		scopes    []string
+		principal *Principal
+		status    int
+		challenge string
+	}{
+		{"with scope", []string{"profile:read"}, principal, http.StatusOK, ""},
+		{"without scope", []string{"profile:read", "profile:write"}, principal, http.StatusForbidden, `Bearer error="insufficient_scope", scope="profile:read profile:write"`},
+		{"without principal", []string{"profile:read"}, nil, http.StatusUnauthorized, ""},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			req := httptest.NewRequest(http.MethodGet, "/", nil)
+			if tt.principal != nil {
+				req = req.WithContext(NewContext(req.Context(), tt.principal))
+			}
+			rr := httptest.NewRecorder()
+			RequireScopes(tt.scopes...)(next).ServeHTTP(rr, req)
+
+			assert.Equal(t, tt.status, rr.Code)
+			assert.Equal(t, tt.challenge, rr.Header().Get("WWW-Authenticate"))
+		})
+	}
+}
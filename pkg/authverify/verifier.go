@@ -0,0 +1,252 @@
+// Package authverify lets other Go services authenticate requests by the tokens of the Auth API, which must sign
+// them with an RSA key (JWT_PRIVATE_KEY_FILE) for its key set to be published at /.well-known/jwks.json.
+package authverify
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/lestrrat-go/jwx/jwa"
+	"github.com/lestrrat-go/jwx/jwk"
+	"github.com/lestrrat-go/jwx/jws"
+	"github.com/lestrrat-go/jwx/jwt"
+)
+
+var (
+	ErrNoToken      = errors.New("no token found")
+	ErrInvalidToken = errors.New("invalid token")
+	ErrRevokedToken = errors.New("revoked token")
+)
+
+// defaultMinRefreshInterval lets the key set be fetched again as often as its Cache-Control header allows,
+// down to this interval, unless Config.RefreshInterval is set.
+const defaultMinRefreshInterval = 5 * time.Minute
+
+// unknownKeyRefreshInterval limits how often a token signed by a key missing from the cached set, as after
+// a key rotation, makes the key set be fetched again.
+const unknownKeyRefreshInterval = time.Minute
+
+type Config struct {
+	// JWKSURL is the address of the key set, as https://auth.example.com/.well-known/jwks.json.
+	JWKSURL string
+	// Issuer and Audience, when set, must be the "iss" and "aud" claims of tokens.
+	Issuer   string
+	Audience string
+	// IntrospectionURL, when set, is asked whether each token is still valid, which catches tokens of logged out
+	// sessions and suspended users before they expire, at the cost of a request. It is meant to be the
+	// introspection endpoint, as https://auth.example.com/api/v1/auth/introspect.
+	IntrospectionURL string
+	// Tenant is sent as the X-Tenant header of introspection requests, for tokens of other tenants than the
+	// default one.
+	Tenant string
+	// RefreshInterval, when set, is how often the key set is fetched again, instead of following its
+	// Cache-Control header.
+	RefreshInterval time.Duration
+	// AcceptableSkew is how far clocks may drift when checking the expiration of tokens.
+	AcceptableSkew time.Duration
+	// HTTPClient makes the requests for the key set and introspection, http.DefaultClient when nil.
+	HTTPClient *http.Client
+}
+
+type Verifier struct {
+	config Config
+	keys   *jwk.AutoRefresh
+
+	mu          sync.Mutex
+	lastRefresh time.Time
+}
+
+// New returns a Verifier that keeps the key set cached and refreshed until ctx is done.
+func New(ctx context.Context, config Config) (*Verifier, error) {
+	if config.JWKSURL == "" {
+		return nil, errors.New("jwks url is required")
+	}
+	if config.HTTPClient == nil {
+		config.HTTPClient = http.DefaultClient
+	}
+
+	options := []jwk.AutoRefreshOption{jwk.WithHTTPClient(config.HTTPClient)}
+	if config.RefreshInterval > 0 {
+		options = append(options, jwk.WithRefreshInterval(config.RefreshInterval))
+	} else {
+		options = append(options, jwk.WithMinRefreshInterval(defaultMinRefreshInterval))
+	}
+
+	keys := jwk.NewAutoRefresh(ctx)
+	keys.Configure(config.JWKSURL, options...)
+
+	return &Verifier{config: config, keys: keys}, nil
+}
+
+// Verify checks the signature, expiration, issuer and audience of token, and with introspection that it was
+// not revoked. Errors other than ErrInvalidToken and ErrRevokedToken mean the check could not be made.
+func (v *Verifier) Verify(ctx context.Context, token string) (*Principal, error) {
+	message, err := jws.ParseString(token)
+	if err != nil || len(message.Signatures()) != 1 {
+		return nil, ErrInvalidToken
+	}
+	headers := message.Signatures()[0].ProtectedHeaders()
+
+	key, err := v.lookupKey(ctx, headers.KeyID())
+	if err != nil {
+		return nil, err
+	}
+
+	// The algorithm comes from the key, so tokens cannot pick a weaker one.
+	alg := jwa.SignatureAlgorithm(key.Algorithm())
+	if alg == "" {
+		alg = jwa.RS256
+	}
+	if headers.Algorithm() != alg {
+		return nil, ErrInvalidToken
+	}
+
+	var rawKey interface{}
+	err = key.Raw(&rawKey)
+	if err != nil {
+		return nil, err
+	}
+
+	t, err := jwt.ParseString(token, jwt.WithVerify(alg, rawKey))
+	if err != nil {
+		return nil, ErrInvalidToken
+	}
+
+	options := []jwt.ValidateOption{jwt.WithAcceptableSkew(v.config.AcceptableSkew)}
+	if v.config.Issuer != "" {
+		options = append(options, jwt.WithClaimValue(jwt.IssuerKey, v.config.Issuer))
+	}
+	if v.config.Audience != "" {
+		options = append(options, jwt.WithAudience(v.config.Audience))
+	}
+	if t.Expiration().IsZero() || jwt.Validate(t, options...) != nil {
+		return nil, ErrInvalidToken
+	}
+
+	principal, err := principalOf(t)
+	if err != nil {
+		return nil, err
+	}
+
+	if v.config.IntrospectionURL != "" {
+		err = v.introspect(ctx, token, principal.UserID)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return principal, nil
+}
+
+// Middleware puts the Principal of the bearer token of each request into its context, for FromContext, and
+// rejects requests without a valid one.
+func (v *Verifier) Middleware(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		token := TokenFromRequest(r)
+		if token == "" {
+			writeMessage(w, http.StatusUnauthorized, "unauthorized")
+			return
+		}
+
+		principal, err := v.Verify(r.Context(), token)
+		if errors.Is(err, ErrInvalidToken) || errors.Is(err, ErrRevokedToken) {
+			writeMessage(w, http.StatusUnauthorized, "unauthorized")
+			return
+		}
+		if err != nil {
+			writeMessage(w, http.StatusInternalServerError, "internal error")
+			return
+		}
+
+		next.ServeHTTP(w, r.WithContext(NewContext(r.Context(), principal)))
+	})
+}
+
+// TokenFromRequest returns the token of the "Authorization: Bearer" header of r, or an empty string.
+func TokenFromRequest(r *http.Request) string {
+	scheme, token, ok := strings.Cut(r.Header.Get("Authorization"), " ")
+	if !ok || !strings.EqualFold(scheme, "Bearer") {
+		return ""
+	}
+	return strings.TrimSpace(token)
+}
+
+func (v *Verifier) lookupKey(ctx context.Context, kid string) (jwk.Key, error) {
+	keys, err := v.keys.Fetch(ctx, v.config.JWKSURL)
+	if err != nil {
+		return nil, fmt.Errorf("fetching jwks: %w", err)
+	}
+
+	key, ok := findKey(keys, kid)
+	if !ok && v.refreshAllowed() {
+		keys, err = v.keys.Refresh(ctx, v.config.JWKSURL)
+		if err != nil {
+			return nil, fmt.Errorf("fetching jwks: %w", err)
+		}
+		key, ok = findKey(keys, kid)
+	}
+	if !ok {
+		return nil, ErrInvalidToken
+	}
+
+	return key, nil
+}
+
+func (v *Verifier) refreshAllowed() bool {
+	v.mu.Lock()
+	defer v.mu.Unlock()
+
+	if time.Since(v.lastRefresh) < unknownKeyRefreshInterval {
+		return false
+	}
+	v.lastRefresh = time.Now()
+	return true
+}
+
+// introspect asks whether token is still valid. The answer must name userID, so that an endpoint letting
+// requests through without looking at their token, as the forward auth endpoint does for public paths, is
+// not taken for a valid token.
+func (v *Verifier) introspect(ctx context.Context, token string, userID string) error {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, v.config.IntrospectionURL, nil)
+	if err != nil {
+		return err
+	}
+	req.Header.Set("Authorization", "Bearer "+token)
+	if v.config.Tenant != "" {
+		req.Header.Set("X-Tenant", v.config.Tenant)
+	}
+
+	res, err := v.config.HTTPClient.Do(req)
+	if err != nil {
+		return fmt.Errorf("introspecting token: %w", err)
+	}
+	defer res.Body.Close()
+
+	switch res.StatusCode {
+	case http.StatusOK:
+		if res.Header.Get("X-User-Id") != userID {
+			return errors.New("introspecting token: response not about the token user")
+		}
+		return nil
+	case http.StatusUnauthorized, http.StatusForbidden:
+		return ErrRevokedToken
+	default:
+		return fmt.Errorf("introspecting token: unexpected status %d", res.StatusCode)
+	}
+}
+
+// findKey looks a key up by its ID, or takes the only one of the set for tokens without an ID.
+func findKey(keys jwk.Set, kid string) (jwk.Key, bool) {
+	if kid != "" {
+		return keys.LookupKeyID(kid)
+	}
+	if keys.Len() != 1 {
+		return nil, false
+	}
+	return keys.Get(0)
+}
@@ -0,0 +1,299 @@
+package authverify
+
+import (
+	"context"
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/x509"
+	"encoding/json"
+	"encoding/pem"
+	"net/http"
+	"net/http/httptest"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/sesaquecruz/go-auth-api/internal/infra/signing"
+
+	"github.com/go-chi/jwtauth"
+	"github.com/lestrrat-go/jwx/jwk"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type issuer struct {
+	jwtAuth *jwtauth.JWTAuth
+	keys    jwk.Set
+}
+
+func newIssuer(t *testing.T) *issuer {
+	privateKey, err := rsa.GenerateKey(rand.Reader, 2048)
+	require.Nil(t, err)
+
+	privateKeyPEM := pem.EncodeToMemory(&pem.Block{Type: "RSA PRIVATE KEY", Bytes: x509.MarshalPKCS1PrivateKey(privateKey)})
+	jwtAuth, keys, err := signing.NewJWTAuth(nil, privateKeyPEM)
+	require.Nil(t, err)
+
+	return &issuer{jwtAuth: jwtAuth, keys: keys}
+}
+
+func (i *issuer) token(t *testing.T, claims map[string]interface{}) string {
+	payload := map[string]interface{}{
+		"sub":         "user",
+		"tid":         "tenant",
+		"sid":         "session",
+		"iss":         "https://auth.example.com",
+		"aud":         "api",
+		"exp":         time.Now().Add(time.Minute).Unix(),
+		"roles":       []string{"admin"},
+		"permissions": []string{"users:read"},
+	}
+	for name, value := range claims {
+		if value == nil {
+			delete(payload, name)
+		} else {
+			payload[name] = value
+		}
+	}
+
+	_, token, err := i.jwtAuth.Encode(payload)
+	require.Nil(t, err)
+	return token
+}
+
+func newJWKSServer(t *testing.T, keys jwk.Set, fetches *int32) *httptest.Server {
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		atomic.AddInt32(fetches, 1)
+		w.Header().Set("Content-Type", "application/json")
+		json.NewEncoder(w).Encode(keys)
+	}))
+	t.Cleanup(ts.Close)
+	return ts
+}
+
+func newVerifier(t *testing.T, config Config) *Verifier {
+	ctx, cancel := context.WithCancel(context.Background())
+	t.Cleanup(cancel)
+
+	v, err := New(ctx, config)
+	require.Nil(t, err)
+	return v
+}
+
+func Test_New_WithoutJWKSURL(t *testing.T) {
+	v, err := New(context.Background(), Config{})
+	assert.Nil(t, v)
+	assert.NotNil(t, err)
+}
+
+func Test_Verifier_Verify(t *testing.T) {
+	issuer := newIssuer(t)
+	var fetches int32
+	ts := newJWKSServer(t, issuer.keys, &fetches)
+	v := newVerifier(t, Config{JWKSURL: ts.URL, Issuer: "https://auth.example.com", Audience: "api"})
+
+	exp := time.Now().Add(time.Minute).Truncate(time.Second)
+	authTime := time.Now().Add(-time.Minute).Truncate(time.Second)
+	principal, err := v.Verify(context.Background(), issuer.token(t, map[string]interface{}{
+		"exp":       exp.Unix(),
+		"oid":       "organization",
+		"org_role":  "owner",
+		"scope":     "profile:read profile:write",
+		"auth_time": authTime.Unix(),
+	}))
+	require.Nil(t, err)
+	assert.Equal(t, &Principal{
+		UserID:           "user",
+		TenantID:         "tenant",
+		SessionID:        "session",
+		OrganizationID:   "organization",
+		OrganizationRole: "owner",
+		Roles:            []string{"admin"},
+		Permissions:      []string{"users:read"},
+		Scopes:           []string{"profile:read", "profile:write"},
+		AuthTime:         authTime.UTC(),
+		ExpiresAt:        exp.UTC(),
+	}, principal)
+
+	// The key set is cached.
+	_, err = v.Verify(context.Background(), issuer.token(t, nil))
+	require.Nil(t, err)
+	assert.Equal(t, int32(1), atomic.LoadInt32(&fetches))
+}
+
+func Test_Verifier_Verify_WhenTokenIsInvalid(t *testing.T) {
+	issuer := newIssuer(t)
+	var fetches int32
+	ts := newJWKSServer(t, issuer.keys, &fetches)
+	v := newVerifier(t, Config{JWKSURL: ts.URL, Issuer: "https://auth.example.com", Audience: "api"})
+
+	// Without a key ID, the token is checked with the only key of the set.
+	_, hs256Token, err := jwtauth.New("HS256", []byte("secret"), nil).Encode(map[string]interface{}{"sub": "user"})
+	require.Nil(t, err)
+
+	tests := map[string]string{
+		"malformed":      "invalid",
+		"expired":        issuer.token(t, map[string]interface{}{"exp": time.Now().Add(-time.Minute).Unix()}),
+		"no expiration":  issuer.token(t, map[string]interface{}{"exp": nil}),
+		"other issuer":   issuer.token(t, map[string]interface{}{"iss": "https://other.example.com"}),
+		"no issuer":      issuer.token(t, map[string]interface{}{"iss": nil}),
+		"other audience": issuer.token(t, map[string]interface{}{"aud": "other"}),
+		"no subject":     issuer.token(t, map[string]interface{}{"sub": nil}),
+		"other key":      newIssuer(t).token(t, nil),
+		"other alg":      hs256Token,
+	}
+
+	for name, token := range tests {
+		principal, err := v.Verify(context.Background(), token)
+		assert.Nil(t, principal, name)
+		assert.ErrorIs(t, err, ErrInvalidToken, name)
+	}
+
+	// Unknown keys refresh the key set, at most once a minute.
+	assert.Equal(t, int32(2), atomic.LoadInt32(&fetches))
+}
+
+func Test_Verifier_Verify_WhenKeysAreRotated(t *testing.T) {
+	issuer := newIssuer(t)
+	rotated := newIssuer(t)
+
+	keys := jwk.NewSet()
+	key, _ := issuer.keys.Get(0)
+	keys.Add(key)
+
+	var fetches int32
+	ts := newJWKSServer(t, keys, &fetches)
+	v := newVerifier(t, Config{JWKSURL: ts.URL})
+
+	_, err := v.Verify(context.Background(), issuer.token(t, nil))
+	require.Nil(t, err)
+
+	key, _ = rotated.keys.Get(0)
+	keys.Add(key)
+
+	_, err = v.Verify(context.Background(), rotated.token(t, nil))
+	assert.Nil(t, err)
+	assert.Equal(t, int32(2), atomic.LoadInt32(&fetches))
+}
+
+func Test_Verifier_Verify_WithIntrospection(t *testing.T) {
+	issuer := newIssuer(t)
+	var fetches int32
+	ts := newJWKSServer(t, issuer.keys, &fetches)
+	token := issuer.token(t, nil)
+
+	statuses := []int{http.StatusOK, http.StatusUnauthorized, http.StatusForbidden, http.StatusInternalServerError}
+	var calls int32
+	introspection := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, http.MethodGet, r.Method)
+		assert.Equal(t, "Bearer "+token, r.Header.Get("Authorization"))
+		assert.Equal(t, "acme", r.Header.Get("X-Tenant"))
+		w.Header().Set("X-User-Id", "user")
+		w.WriteHeader(statuses[atomic.AddInt32(&calls, 1)-1])
+	}))
+	defer introspection.Close()
+
+	v := newVerifier(t, Config{JWKSURL: ts.URL, IntrospectionURL: introspection.URL, Tenant: "acme"})
+
+	principal, err := v.Verify(context.Background(), token)
+	assert.Nil(t, err)
+	assert.NotNil(t, principal)
+
+	for range statuses[1:3] {
+		_, err = v.Verify(context.Background(), token)
+		assert.ErrorIs(t, err, ErrRevokedToken)
+	}
+
+	_, err = v.Verify(context.Background(), token)
+	assert.NotNil(t, err)
+	assert.NotErrorIs(t, err, ErrRevokedToken)
+	assert.NotErrorIs(t, err, ErrInvalidToken)
+}
+
+func Test_Verifier_Verify_WithIntrospectionOfAnotherUser(t *testing.T) {
+	issuer := newIssuer(t)
+	var fetches int32
+	ts := newJWKSServer(t, issuer.keys, &fetches)
+
+	// A public path of the forward auth endpoint answers 200 without looking at the token.
+	users := []string{"", "other"}
+	var calls int32
+	introspection := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if user := users[atomic.AddInt32(&calls, 1)-1]; user != "" {
+			w.Header().Set("X-User-Id", user)
+		}
+		w.WriteHeader(http.StatusOK)
+	}))
+	defer introspection.Close()
+
+	v := newVerifier(t, Config{JWKSURL: ts.URL, IntrospectionURL: introspection.URL})
+
+	for range users {
+		principal, err := v.Verify(context.Background(), issuer.token(t, nil))
+		assert.Nil(t, principal)
+		assert.NotNil(t, err)
+		assert.NotErrorIs(t, err, ErrInvalidToken)
+	}
+}
+
+func Test_Verifier_Middleware(t *testing.T) {
+	issuer := newIssuer(t)
+	var fetches int32
+	ts := newJWKSServer(t, issuer.keys, &fetches)
+	v := newVerifier(t, Config{JWKSURL: ts.URL})
+
+	var principal *Principal
+	handler := v.Middleware(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		principal, _ = FromContext(r.Context())
+		w.WriteHeader(http.StatusOK)
+	}))
+
+	tests := []struct {
+		name   string
+		header string
+		status int
+	}{
+		{"bearer token", "Bearer " + issuer.token(t, nil), http.StatusOK},
+		{"lower case scheme", "bearer " + issuer.token(t, nil), http.StatusOK},
+		{"no token", "", http.StatusUnauthorized},
+		{"other scheme", "ApiKey " + issuer.token(t, nil), http.StatusUnauthorized},
+		{"invalid token", "Bearer invalid", http.StatusUnauthorized},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			principal = nil
+
+			req := httptest.NewRequest(http.MethodGet, "/", nil)
+			if tt.header != "" {
+				req.Header.Set("Authorization", tt.header)
+			}
+			rr := httptest.NewRecorder()
+			handler.ServeHTTP(rr, req)
+
+			assert.Equal(t, tt.status, rr.Code)
+			if tt.status == http.StatusOK {
+				require.NotNil(t, principal)
+				assert.Equal(t, "user", principal.UserID)
+			} else {
+				assert.Nil(t, principal)
+			}
+		})
+	}
+}
+
+func Test_Verifier_Middleware_WhenKeysCannotBeFetched(t *testing.T) {
+	issuer := newIssuer(t)
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusServiceUnavailable)
+	}))
+	defer ts.Close()
+	v := newVerifier(t, Config{JWKSURL: ts.URL})
+
+	req := httptest.NewRequest(http.MethodGet, "/", nil)
+	req.Header.Set("Authorization", "Bearer "+issuer.token(t, nil))
+	rr := httptest.NewRecorder()
+	v.Middleware(http.NotFoundHandler()).ServeHTTP(rr, req)
+
+	assert.Equal(t, http.StatusInternalServerError, rr.Code)
+}